@@ -150,6 +150,13 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithBlockHeight(req.Header.Height)
 	}
 
+	// the block span is the parent of the spans of the block's phases and txs
+	app.startBlockSpan(req.Header.Height)
+	app.deliverState.ctx = app.deliverState.ctx.WithContext(app.blockContext())
+
+	ctx, span := startSpan(app.deliverState.ctx, "BeginBlock")
+	defer span.End()
+
 	// add block gas meter
 	var gasMeter sdk.GasMeter
 	if maxGas := app.getMaximumBlockGas(app.deliverState.ctx); maxGas > 0 {
@@ -174,7 +181,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		res = app.beginBlocker(app.deliverState.ctx.WithContext(ctx.Context()), req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 	// set the signed validators for addition to context in deliverTx
@@ -198,8 +205,11 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
+	ctx, span := startSpan(app.deliverState.ctx, "EndBlock")
+	defer span.End()
+
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		res = app.endBlocker(ctx, req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
@@ -303,6 +313,10 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 // height.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "commit")
+	defer app.endBlockSpan()
+
+	_, span := startSpan(app.deliverState.ctx, "Commit")
+	defer span.End()
 
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
@@ -314,6 +328,9 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	// Drop the cached responses of latest-height queries.
+	app.grpcQueryRouter.cache.invalidateLatest()
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -417,14 +434,15 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	}()
 
 	// when a client did not provide a query height, manually inject the latest
-	if req.Height == 0 {
+	latest := req.Height == 0
+	if latest {
 		req.Height = app.LastBlockHeight()
 	}
 
 	// handle gRPC routes first rather than calling splitPath because '/' characters
 	// are used as part of gRPC paths
 	if grpcHandler := app.grpcQueryRouter.Route(req.Path); grpcHandler != nil {
-		return app.handleQueryGRPC(grpcHandler, req)
+		return app.handleQueryGRPC(grpcHandler, req, latest)
 	}
 
 	path := splitPath(req.Path)
@@ -574,7 +592,11 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 	}
 }
 
-func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
+func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery, latest bool) abci.ResponseQuery {
+	if cache := app.grpcQueryRouter.cache; !req.Prove && cache.cacheable(req.Path) {
+		return app.handleCachedQueryGRPC(cache, handler, req, latest)
+	}
+
 	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResultWithDebug(err, app.trace)
@@ -590,6 +612,31 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 	return res
 }
 
+// handleCachedQueryGRPC serves a gRPC query through the query cache. latest
+// must be true if the client did not provide a query height, in which case
+// req.Height holds the last committed height.
+func (app *BaseApp) handleCachedQueryGRPC(cache *queryCache, handler GRPCQueryHandler, req abci.RequestQuery, latest bool) abci.ResponseQuery {
+	key := queryCacheKey(queryCacheABCI, req.Path, req.Height, req.Data)
+	if cached, ok := cache.get(req.Path, key); ok {
+		return cached.(abci.ResponseQuery)
+	}
+
+	ctx, err := app.createQueryContext(req.Height, false)
+	if err != nil {
+		return sdkerrors.QueryResultWithDebug(err, app.trace)
+	}
+
+	res, err := handler(ctx, req)
+	if err != nil {
+		res = sdkerrors.QueryResultWithDebug(gRPCErrorToSDKError(err), app.trace)
+		res.Height = req.Height
+		return res
+	}
+
+	cache.add(key, res, latest)
+	return res
+}
+
 func gRPCErrorToSDKError(err error) error {
 	status, ok := grpcstatus.FromError(err)
 	if !ok {
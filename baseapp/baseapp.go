@@ -12,6 +12,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -138,6 +140,9 @@ type abciData struct {
 
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
+
+	// span of the block being executed, started on BeginBlock and ended on Commit
+	blockSpan trace.Span
 }
 
 type baseappVersions struct {
@@ -573,6 +578,12 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
+	// the logs of the tx are correlated with it, except when it is rechecked
+	// so that rechecking the mempool costs nothing more
+	if mode != runTxModeReCheck {
+		ctx = ctx.WithLogger(txLogger{Logger: ctx.Logger(), height: ctx.BlockHeight(), txHash: txHash(txBytes)})
+	}
+
 	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
 
 	if mode == runTxModeReCheck {
@@ -586,6 +597,46 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 	return ctx
 }
 
+// txLogger adds the height and the hash of a tx to the logs of its context. The
+// tx is hashed when a log is written, not when the logger is created, so that
+// the txs logging nothing are not hashed.
+type txLogger struct {
+	log.Logger
+	height int64
+	txHash txHash
+}
+
+func (l txLogger) Debug(msg string, keyVals ...interface{}) {
+	l.Logger.Debug(msg, l.keyVals(keyVals)...)
+}
+
+func (l txLogger) Info(msg string, keyVals ...interface{}) {
+	l.Logger.Info(msg, l.keyVals(keyVals)...)
+}
+
+func (l txLogger) Error(msg string, keyVals ...interface{}) {
+	l.Logger.Error(msg, l.keyVals(keyVals)...)
+}
+
+func (l txLogger) With(keyVals ...interface{}) log.Logger {
+	return txLogger{Logger: l.Logger.With(keyVals...), height: l.height, txHash: l.txHash}
+}
+
+func (l txLogger) keyVals(keyVals []interface{}) []interface{} {
+	return append([]interface{}{"height", l.height, "tx_hash", l.txHash}, keyVals...)
+}
+
+// txHash formats the hash of a tx when it is logged.
+type txHash []byte
+
+func (h txHash) String() string {
+	return fmt.Sprintf("%X", tmhash.Sum(h))
+}
+
+func (h txHash) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
 // cacheTxContext returns a new context based off of the provided context with
 // a branched multi-store.
 func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context, sdk.CacheMultiStore) {
@@ -621,6 +672,23 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	if mode == runTxModeDeliver {
+		var span trace.Span
+		ctx, span = startSpan(ctx, "DeliverTx")
+		if span.IsRecording() {
+			span.SetAttributes(attribute.String("tx_hash", fmt.Sprintf("%X", tmhash.Sum(txBytes))))
+		}
+
+		// deferred first so that the recovered error and gas are recorded
+		defer func() {
+			span.SetAttributes(
+				attribute.Int64("gas_wanted", int64(gInfo.GasWanted)),
+				attribute.Int64("gas_used", int64(gInfo.GasUsed)),
+			)
+			endSpan(span, err)
+		}()
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
@@ -681,7 +749,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+		txGoCtx := ctx.Context()
+		anteCtx, span := startSpan(anteCtx, "AnteHandler")
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
+		endSpan(span, err)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -691,6 +762,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			// the instantiated gas meter in the AnteHandler, so we update the context
 			// prior to returning.
 			ctx = newCtx.WithMultiStore(ms)
+
+			// the messages are not children of the AnteHandler span
+			if span.SpanContext().IsValid() && trace.SpanFromContext(ctx.Context()) == span {
+				ctx = ctx.WithContext(txGoCtx)
+			}
 		}
 
 		events := ctx.EventManager().Events()
@@ -755,10 +831,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err          error
 		)
 
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		msgCtx, span := startSpan(ctx, msgTypeURL, attribute.Int("msg_index", i))
+		msgCtx = msgCtx.WithLogger(msgCtx.Logger().With("msg_index", i, "msg_type_url", msgTypeURL))
+
 		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
-			msgResult, err = handler(ctx, msg)
-			eventMsgName = sdk.MsgTypeURL(msg)
+			msgResult, err = handler(msgCtx, msg)
+			eventMsgName = msgTypeURL
 		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
 			// legacy sdk.Msg routing
 			// Assuming that the app developer has migrated all their Msgs to
@@ -769,14 +849,20 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			eventMsgName = legacyMsg.Type()
 			handler := app.router.Route(ctx, msgRoute)
 			if handler == nil {
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+				err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+				endSpan(span, err)
+				return nil, err
 			}
 
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 		} else {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
+			endSpan(span, err)
+			return nil, err
 		}
 
+		endSpan(span, err)
+
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -792,7 +878,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		// separate each result.
 		events = events.AppendEvents(msgEvents)
 
-		txMsgData.Data = append(txMsgData.Data, &sdk.MsgData{MsgType: sdk.MsgTypeURL(msg), Data: msgResult.Data})
+		txMsgData.Data = append(txMsgData.Data, &sdk.MsgData{MsgType: msgTypeURL, Data: msgResult.Data})
 		msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), msgResult.Log, msgEvents))
 	}
 
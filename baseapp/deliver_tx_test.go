@@ -3,6 +3,7 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -1838,3 +1840,64 @@ func TestSetLoader(t *testing.T) {
 		})
 	}
 }
+
+func TestTxLoggerFields(t *testing.T) {
+	var buf bytes.Buffer
+	loggerOpt := func(bapp *BaseApp) { bapp.logger = log.NewTMJSONLogger(log.NewSyncWriter(&buf)) }
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx.Logger().Info("ante")
+			return ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.Logger().Info("handler")
+			return &sdk.Result{}, nil
+		}))
+	}
+	app := setupBaseApp(t, loggerOpt, anteOpt, routerOpt)
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	txBytes, err := cdc.Marshal(newTxCounter(0, 0, 1))
+	require.NoError(t, err)
+
+	buf.Reset()
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	var logs []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		logs = append(logs, entry)
+	}
+
+	txHash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	require.Len(t, logs, 3)
+	for i, entry := range logs {
+		require.Equal(t, float64(1), entry["height"])
+		require.Equal(t, txHash, entry["tx_hash"])
+
+		if i == 0 {
+			require.Equal(t, "ante", entry["_msg"])
+			require.NotContains(t, entry, "msg_index")
+			continue
+		}
+
+		require.Equal(t, "handler", entry["_msg"])
+		require.Equal(t, float64(i-1), entry["msg_index"])
+		require.Equal(t, sdk.MsgTypeURL(msgCounter{}), entry["msg_type_url"])
+	}
+
+	// the tx is not hashed when it is rechecked
+	buf.Reset()
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck}).IsOK())
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "ante", entry["_msg"])
+	require.NotContains(t, entry, "tx_hash")
+}
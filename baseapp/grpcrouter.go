@@ -7,6 +7,8 @@ import (
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/proto"
@@ -22,6 +24,7 @@ type GRPCQueryRouter struct {
 	routes            map[string]GRPCQueryHandler
 	interfaceRegistry codectypes.InterfaceRegistry
 	serviceData       []serviceData
+	cache             *queryCache
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -79,10 +82,14 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 			)
 		}
 
-		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery, err error) {
+			spanCtx, span := tracer.Start(ctx.Context(), fqName, trace.WithAttributes(attribute.Int64("height", req.Height)))
+			defer func() { endSpan(span, err) }()
+			ctx = ctx.WithContext(spanCtx)
+
 			// call the method handler from the service description with the handler object,
 			// a wrapped sdk.Context with proto-unmarshaled data from the ABCI request data
-			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
+			queryRes, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
 				err := protoCodec.Unmarshal(req.Data, i)
 				if err != nil {
 					return err
@@ -97,7 +104,7 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 			}
 
 			// proto marshal the result bytes
-			resBytes, err := protoCodec.Marshal(res)
+			resBytes, err := protoCodec.Marshal(queryRes)
 			if err != nil {
 				return abci.ResponseQuery{}, err
 			}
@@ -127,3 +134,19 @@ func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.In
 		reflection.NewReflectionServiceServer(interfaceRegistry),
 	)
 }
+
+// SetCacheSize enables the query response cache with room for size entries.
+// A non-positive size disables the cache.
+func (qrt *GRPCQueryRouter) SetCacheSize(size int) {
+	if size <= 0 {
+		qrt.cache = nil
+		return
+	}
+
+	cache, err := newQueryCache(size)
+	if err != nil {
+		panic(err)
+	}
+
+	qrt.cache = cache
+}
@@ -0,0 +1,116 @@
+package baseapp
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// queryCache is a height-keyed LRU cache of gRPC query responses. Entries are
+// keyed by the full method name, the request bytes and the block height the
+// query was run at. Since the state at a committed height never changes,
+// entries remain valid until they are evicted. Entries created by queries
+// that did not set a height (i.e. latest-height queries) are additionally
+// dropped on Commit, as they are unlikely to be requested again once a new
+// height is available.
+//
+// Only methods of services named "Query" are cached, so that services with
+// side effects, like the tx service, are never served from the cache. Cached
+// responses are shared between callers and must not be mutated.
+type queryCache struct {
+	mtx sync.Mutex
+	lru *simplelru.LRU
+	// latest holds the keys of the entries created by latest-height queries.
+	latest map[string]struct{}
+}
+
+const (
+	queryCacheABCI = "abci:"
+	queryCacheGRPC = "grpc:"
+)
+
+func newQueryCache(size int) (*queryCache, error) {
+	c := &queryCache{latest: make(map[string]struct{})}
+
+	lru, err := simplelru.NewLRU(size, func(key, _ interface{}) {
+		// called by the LRU with the mutex held
+		delete(c.latest, key.(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.lru = lru
+	return c, nil
+}
+
+// cacheable returns true if the responses of the given method can be cached.
+func (c *queryCache) cacheable(method string) bool {
+	if c == nil {
+		return false
+	}
+
+	// method is of the form /{package}.{service}/{method}
+	i := strings.LastIndexByte(method, '/')
+	return i > 0 && strings.HasSuffix(method[:i], ".Query")
+}
+
+// queryCacheKey returns the cache key of a request to method at height. ABCI
+// and gRPC server responses are cached in a different form, so the transport
+// is part of the key.
+func queryCacheKey(transport, method string, height int64, reqBz []byte) string {
+	return transport + method + "/" + strconv.FormatInt(height, 10) + "/" + string(reqBz)
+}
+
+// get returns the cached response for key, if any, and records a hit or miss.
+func (c *queryCache) get(method, key string) (interface{}, bool) {
+	c.mtx.Lock()
+	res, ok := c.lru.Get(key)
+	c.mtx.Unlock()
+
+	labels := []metrics.Label{telemetry.NewLabel("method", method)}
+	if ok {
+		telemetry.IncrCounterWithLabels([]string{"query", "cache", "hit"}, 1, labels)
+	} else {
+		telemetry.IncrCounterWithLabels([]string{"query", "cache", "miss"}, 1, labels)
+	}
+
+	return res, ok
+}
+
+// add caches the response for key. latest must be true if the query did not
+// specify a height.
+func (c *queryCache) add(key string, res interface{}, latest bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.lru.Add(key, res)
+	if latest {
+		c.latest[key] = struct{}{}
+	}
+
+	telemetry.SetGauge(float32(c.lru.Len()), "query", "cache", "size")
+}
+
+// invalidateLatest removes the entries created by latest-height queries. It is
+// called on Commit.
+func (c *queryCache) invalidateLatest() {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	// entries are deleted from latest by the eviction callback
+	for key := range c.latest {
+		c.lru.Remove(key)
+	}
+
+	telemetry.SetGauge(float32(c.lru.Len()), "query", "cache", "size")
+}
@@ -0,0 +1,100 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+// countingQueryServer counts the SayHello calls reaching the query service.
+type countingQueryServer struct {
+	testdata.QueryImpl
+	calls int
+}
+
+func (q *countingQueryServer) SayHello(ctx context.Context, req *testdata.SayHelloRequest) (*testdata.SayHelloResponse, error) {
+	q.calls++
+	return q.QueryImpl.SayHello(ctx, req)
+}
+
+func TestGRPCQueryCache(t *testing.T) {
+	server := &countingQueryServer{}
+	grpcQueryOpt := func(bapp *BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), server)
+	}
+
+	app := setupBaseApp(t, grpcQueryOpt, SetQueryCacheSize(10))
+
+	commit := func() {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.Commit()
+	}
+
+	app.InitChain(abci.RequestInitChain{})
+	commit()
+
+	query := func(name string, height int64) {
+		req := testdata.SayHelloRequest{Name: name}
+		reqBz, err := req.Marshal()
+		require.NoError(t, err)
+
+		resQuery := app.Query(abci.RequestQuery{
+			Data:   reqBz,
+			Path:   "/testdata.Query/SayHello",
+			Height: height,
+		})
+		require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+		if height != 0 {
+			require.Equal(t, height, resQuery.Height)
+		}
+
+		var res testdata.SayHelloResponse
+		require.NoError(t, res.Unmarshal(resQuery.Value))
+		require.Equal(t, "Hello "+name+"!", res.Greeting)
+	}
+
+	// identical latest-height queries are served from the cache
+	query("foo", 0)
+	query("foo", 0)
+	require.Equal(t, 1, server.calls)
+
+	// so are the queries at the same explicit height
+	query("foo", 1)
+	require.Equal(t, 1, server.calls)
+
+	// different requests are not
+	query("bar", 0)
+	require.Equal(t, 2, server.calls)
+
+	// latest-height entries are dropped on commit
+	commit()
+	query("foo", 0)
+	require.Equal(t, 3, server.calls)
+
+	// explicit-height entries are not dropped on commit
+	query("foo", 2)
+	require.Equal(t, 3, server.calls)
+	query("baz", 1)
+	require.Equal(t, 4, server.calls)
+	commit()
+	query("baz", 1)
+	require.Equal(t, 4, server.calls)
+}
+
+func TestQueryCacheCacheable(t *testing.T) {
+	var nilCache *queryCache
+	require.False(t, nilCache.cacheable("/cosmos.bank.v1beta1.Query/Balance"))
+
+	cache, err := newQueryCache(1)
+	require.NoError(t, err)
+	require.True(t, cache.cacheable("/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, cache.cacheable("/cosmos.tx.v1beta1.Service/BroadcastTx"))
+	require.False(t, cache.cacheable("/cosmos.base.tendermint.v1beta1.Service/GetLatestBlock"))
+	require.False(t, cache.cacheable("Query"))
+}
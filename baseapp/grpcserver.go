@@ -7,6 +7,8 @@ import (
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -24,7 +26,10 @@ func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRou
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
-	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	interceptor := func(grpcCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		grpcCtx, span := tracer.Start(grpcCtx, info.FullMethod)
+		defer func() { endSpan(span, err) }()
+
 		// If there's some metadata in the context, retrieve it.
 		md, ok := metadata.FromIncomingContext(grpcCtx)
 		if !ok {
@@ -45,6 +50,31 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		// Look up the query cache, resolving the height first so that
+		// latest-height queries share the entries of the last committed height.
+		cache := app.grpcQueryRouter.cache
+		latest := height == 0
+		var cacheKey string
+		if cache.cacheable(info.FullMethod) {
+			if latest {
+				height = app.LastBlockHeight()
+			}
+
+			reqBz, err := protoCodec.Marshal(req)
+			if err != nil {
+				return nil, err
+			}
+
+			cacheKey = queryCacheKey(queryCacheGRPC, info.FullMethod, height, reqBz)
+			if cached, ok := cache.get(info.FullMethod, cacheKey); ok {
+				span.SetAttributes(attribute.Int64("height", height), attribute.Bool("cached", true))
+				md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+				grpc.SetHeader(grpcCtx, md)
+
+				return cached, nil
+			}
+		}
+
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
 		sdkCtx, err := app.createQueryContext(height, false)
@@ -57,13 +87,21 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
 		}
 
+		span.SetAttributes(attribute.Int64("height", height))
+		sdkCtx = sdkCtx.WithContext(trace.ContextWithSpan(sdkCtx.Context(), span))
+
 		// Attach the sdk.Context into the gRPC's context.Context.
 		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
 
 		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
 		grpc.SetHeader(grpcCtx, md)
 
-		return handler(grpcCtx, req)
+		resp, err = handler(grpcCtx, req)
+		if err == nil && cacheKey != "" {
+			cache.add(cacheKey, resp, latest)
+		}
+
+		return resp, err
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -73,6 +73,13 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetQueryCacheSize provides a BaseApp option function that enables the gRPC
+// query response cache with the given number of entries. A non-positive size
+// disables the cache.
+func SetQueryCacheSize(size int) func(*BaseApp) {
+	return func(app *BaseApp) { app.grpcQueryRouter.SetCacheSize(size) }
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
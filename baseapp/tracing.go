@@ -0,0 +1,68 @@
+package baseapp
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// tracer creates the spans of the blocks, transactions and queries processed by
+// the BaseApp. It reports to the global tracer provider, which is a no-op unless
+// tracing is enabled in the telemetry configuration.
+var tracer = otel.Tracer("github.com/cosmos/cosmos-sdk/baseapp")
+
+// startBlockSpan starts the root span of the block of the given height, ending
+// the one of the previous block if it was not committed.
+func (app *BaseApp) startBlockSpan(height int64) {
+	app.endBlockSpan()
+
+	_, app.blockSpan = tracer.Start(
+		context.Background(), "Block",
+		trace.WithAttributes(attribute.Int64("height", height)),
+	)
+}
+
+// endBlockSpan ends the span of the current block, if any.
+func (app *BaseApp) endBlockSpan() {
+	if app.blockSpan != nil {
+		app.blockSpan.End()
+		app.blockSpan = nil
+	}
+}
+
+// blockContext returns the go context holding the span of the current block.
+func (app *BaseApp) blockContext() context.Context {
+	if app.blockSpan == nil {
+		return context.Background()
+	}
+
+	return trace.ContextWithSpan(context.Background(), app.blockSpan)
+}
+
+// startSpan starts a span with the given name as a child of the span held by
+// the context, returning the context holding the new span. Nothing is started
+// unless the parent span is recorded, so that transactions checked or simulated
+// outside of a block are not traced.
+func startSpan(ctx sdk.Context, name string, attrs ...attribute.KeyValue) (sdk.Context, trace.Span) {
+	if !trace.SpanFromContext(ctx.Context()).IsRecording() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	spanCtx, span := tracer.Start(ctx.Context(), name, trace.WithAttributes(attrs...))
+	return ctx.WithContext(spanCtx), span
+}
+
+// endSpan ends the given span, recording the error it failed with, if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
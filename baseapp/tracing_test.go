@@ -0,0 +1,109 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type tracedAnteDecorator struct {
+	anteHandler sdk.AnteHandler
+}
+
+func (d tracedAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx, err := d.anteHandler(ctx, tx, simulate)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(tp)
+	defer tp.Shutdown(context.Background()) //nolint:errcheck
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(
+			tracedAnteDecorator{anteHandler: anteHandlerTxTest(t, capKey1, []byte("ante-key"))},
+		))
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key"))))
+	}
+	app := setupBaseApp(t, anteOpt, routerOpt)
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	// transactions checked outside of a block are not traced
+	txBytes, err := cdc.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	require.Empty(t, exporter.GetSpans())
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	failingTx := newTxCounter(1, 1)
+	failingTx.setFailOnHandler(true)
+	txBytes, err = cdc.Marshal(failingTx)
+	require.NoError(t, err)
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	spans := exporter.GetSpans()
+	names := make([]string, len(spans))
+	byName := make(map[string][]tracetest.SpanStub)
+	for i, span := range spans {
+		names[i] = span.Name
+		byName[span.Name] = append(byName[span.Name], span)
+	}
+
+	msgName := sdk.MsgTypeURL(msgCounter{})
+	require.Equal(t, []string{
+		"BeginBlock",
+		"baseapp.tracedAnteDecorator", "AnteHandler", msgName, "DeliverTx",
+		"baseapp.tracedAnteDecorator", "AnteHandler", msgName, "DeliverTx",
+		"EndBlock",
+		"Commit",
+		"Block",
+	}, names)
+
+	// every span belongs to the trace of the block
+	block := byName["Block"][0]
+	for _, span := range spans {
+		require.Equal(t, block.SpanContext.TraceID(), span.SpanContext.TraceID())
+	}
+
+	// the phases and transactions are children of the block, the ante
+	// decorators of the AnteHandler and the messages of their transaction
+	for _, name := range []string{"BeginBlock", "DeliverTx", "EndBlock", "Commit"} {
+		for _, span := range byName[name] {
+			require.Equal(t, block.SpanContext.SpanID(), span.Parent.SpanID(), name)
+		}
+	}
+	for i, deliverTx := range byName["DeliverTx"] {
+		require.Equal(t, deliverTx.SpanContext.SpanID(), byName["AnteHandler"][i].Parent.SpanID())
+		require.Equal(t, byName["AnteHandler"][i].SpanContext.SpanID(), byName["baseapp.tracedAnteDecorator"][i].Parent.SpanID())
+		require.Equal(t, deliverTx.SpanContext.SpanID(), byName[msgName][i].Parent.SpanID())
+	}
+
+	// the failing message and its transaction are recorded as errors
+	require.Equal(t, "Unset", byName[msgName][0].Status.Code.String())
+	require.Equal(t, "Error", byName[msgName][1].Status.Code.String())
+	require.Equal(t, "Error", byName["DeliverTx"][1].Status.Code.String())
+}
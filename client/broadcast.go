@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tendermint/tendermint/mempool"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -31,8 +35,11 @@ func (ctx Context) BroadcastTx(txBytes []byte) (res *sdk.TxResponse, err error)
 	case flags.BroadcastBlock:
 		res, err = ctx.BroadcastTxCommit(txBytes)
 
+	case flags.BroadcastWait:
+		res, err = ctx.BroadcastTxWait(txBytes)
+
 	default:
-		return nil, fmt.Errorf("unsupported return type %s; supported types: sync, async, block", ctx.BroadcastMode)
+		return nil, fmt.Errorf("unsupported return type %s; supported types: sync, async, block, wait", ctx.BroadcastMode)
 	}
 
 	return res, err
@@ -137,6 +144,104 @@ func (ctx Context) BroadcastTxAsync(txBytes []byte) (*sdk.TxResponse, error) {
 	return sdk.NewResponseFormatBroadcastTx(res), err
 }
 
+// BroadcastTxWait broadcasts transaction bytes to a Tendermint node
+// synchronously, then waits for the transaction to be committed in a block for
+// at most the broadcast timeout of the context, and returns the response of its
+// execution, including its events. The node is notified of the commit through
+// an event subscription when its RPC client supports it, e.g. when started
+// with a websocket connection, and polled otherwise. An error is returned if
+// the transaction is not committed before the timeout, although it may still
+// be committed later.
+func (ctx Context) BroadcastTxWait(txBytes []byte) (*sdk.TxResponse, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := ctx.BroadcastTimeout
+	if timeout <= 0 {
+		timeout = flags.DefaultBroadcastTimeout
+	}
+
+	goCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	hash := tmtypes.Tx(txBytes).Hash()
+
+	// Subscribe before broadcasting so that the commit of the tx is not missed.
+	var committed <-chan coretypes.ResultEvent
+	if events, ok := node.(subscriptionClient); ok && events.IsRunning() {
+		subscriber := fmt.Sprintf("broadcast-wait-%X", hash)
+		query := fmt.Sprintf("%s='%s' AND %s='%X'", tmtypes.EventTypeKey, tmtypes.EventTx, tmtypes.TxHashKey, hash)
+
+		committed, err = events.Subscribe(goCtx, subscriber, query)
+		if err == nil {
+			defer events.Unsubscribe(context.Background(), subscriber, query) //nolint:errcheck
+		}
+	}
+
+	res, err := ctx.BroadcastTxSync(txBytes)
+	if err != nil || res.Code != 0 {
+		return res, err
+	}
+
+	ticker := time.NewTicker(broadcastWaitPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-committed:
+		case <-ticker.C:
+		case <-goCtx.Done():
+			return res, fmt.Errorf("timed out after %s waiting for tx %X to be committed", timeout, hash)
+		}
+
+		txRes, err := ctx.queryCommittedTx(goCtx, node, hash)
+		switch {
+		case err == nil:
+			return txRes, nil
+		case !strings.Contains(err.Error(), "not found"):
+			return res, err
+		}
+	}
+}
+
+// broadcastWaitPollInterval is the interval the node is polled at while
+// waiting for a tx to be committed.
+const broadcastWaitPollInterval = time.Second
+
+// subscriptionClient is a Tendermint RPC client which can subscribe to events
+// once started.
+type subscriptionClient interface {
+	rpcclient.EventsClient
+	IsRunning() bool
+}
+
+// queryCommittedTx returns the response of the execution of a committed tx.
+func (ctx Context) queryCommittedTx(goCtx context.Context, node rpcclient.Client, hash []byte) (*sdk.TxResponse, error) {
+	resTx, err := node.Tx(goCtx, hash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := node.Block(goCtx, &resTx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ctx.TxConfig.TxDecoder()(resTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := tx.(interface{ AsAny() *codectypes.Any })
+	if !ok {
+		return nil, fmt.Errorf("expecting a type implementing AsAny, got: %T", tx)
+	}
+
+	return sdk.NewResponseResultTx(resTx, p.AsAny(), resBlock.Block.Time.Format(time.RFC3339)), nil
+}
+
 // TxServiceBroadcast is a helper function to broadcast a Tx with the correct gRPC types
 // from the tx service. Calls `clientCtx.BroadcastTx` under the hood.
 func TxServiceBroadcast(grpcCtx context.Context, clientCtx Context, req *tx.BroadcastTxRequest) (*tx.BroadcastTxResponse, error) {
@@ -145,6 +250,10 @@ func TxServiceBroadcast(grpcCtx context.Context, clientCtx Context, req *tx.Broa
 	}
 
 	clientCtx = clientCtx.WithBroadcastMode(normalizeBroadcastMode(req.Mode))
+	if req.Timeout != nil {
+		clientCtx = clientCtx.WithBroadcastTimeout(*req.Timeout)
+	}
+
 	resp, err := clientCtx.BroadcastTx(req.TxBytes)
 	if err != nil {
 		return nil, err
@@ -165,6 +274,8 @@ func normalizeBroadcastMode(mode tx.BroadcastMode) string {
 		return "block"
 	case tx.BroadcastMode_BROADCAST_MODE_SYNC:
 		return "sync"
+	case tx.BroadcastMode_BROADCAST_MODE_WAIT:
+		return "wait"
 	default:
 		return "unspecified"
 	}
@@ -33,6 +33,10 @@ func (c MockClient) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*ctypes
 	return nil, c.err
 }
 
+func (c MockClient) IsRunning() bool {
+	return false
+}
+
 func CreateContextWithErrorAndMode(err error, mode string) Context {
 	return Context{
 		Client:        MockClient{err: err},
@@ -52,6 +56,7 @@ func TestBroadcastError(t *testing.T) {
 		flags.BroadcastAsync,
 		flags.BroadcastBlock,
 		flags.BroadcastSync,
+		flags.BroadcastWait,
 	}
 
 	txBytes := []byte{0xA, 0xB}
@@ -210,6 +210,11 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithBroadcastMode(bMode)
 	}
 
+	if clientCtx.BroadcastTimeout == 0 || flagSet.Changed(flags.FlagBroadcastTimeout) {
+		timeout, _ := flagSet.GetDuration(flags.FlagBroadcastTimeout)
+		clientCtx = clientCtx.WithBroadcastTimeout(timeout)
+	}
+
 	if !clientCtx.SkipConfirm || flagSet.Changed(flags.FlagSkipConfirmation) {
 		skipConfirm, _ := flagSet.GetBool(flags.FlagSkipConfirmation)
 		clientCtx = clientCtx.WithSkipConfirmation(skipConfirm)
@@ -17,7 +17,7 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|remote)
 keyring-backend = "{{ .KeyringBackend }}"
 # CLI output format (text|json)
 output = "{{ .Output }}"
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -40,6 +41,7 @@ type Context struct {
 	KeyringDir        string
 	From              string
 	BroadcastMode     string
+	BroadcastTimeout  time.Duration
 	FromName          string
 	SignModeStr       string
 	UseLedger         bool
@@ -211,6 +213,13 @@ func (ctx Context) WithBroadcastMode(mode string) Context {
 	return ctx
 }
 
+// WithBroadcastTimeout returns a copy of the context with an updated time to
+// wait for a tx to be committed in the wait broadcasting mode.
+func (ctx Context) WithBroadcastTimeout(timeout time.Duration) Context {
+	ctx.BroadcastTimeout = timeout
+	return ctx
+}
+
 // WithSignModeStr returns a copy of the context with an updated SignMode
 // value.
 func (ctx Context) WithSignModeStr(signModeStr string) Context {
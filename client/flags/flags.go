@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
@@ -30,6 +31,13 @@ const (
 	// BroadcastAsync defines a tx broadcasting mode where the client returns
 	// immediately.
 	BroadcastAsync = "async"
+	// BroadcastWait defines a tx broadcasting mode where the client waits for
+	// a CheckTx execution response, then for the tx to be committed in a block.
+	BroadcastWait = "wait"
+
+	// DefaultBroadcastTimeout is the time to wait for a tx to be committed in
+	// the wait broadcasting mode.
+	DefaultBroadcastTimeout = time.Minute
 
 	// SignModeDirect is the value of the --sign-mode flag for SIGN_MODE_DIRECT
 	SignModeDirect = "direct"
@@ -37,6 +45,16 @@ const (
 	SignModeLegacyAminoJSON = "amino-json"
 	// SignModeEIP191 is the value of the --sign-mode flag for SIGN_MODE_EIP_191
 	SignModeEIP191 = "eip-191"
+
+	// FeeStrategyLow is the value of the --fee-strategy flag paying the low gas
+	// price suggested by the node's fee estimator.
+	FeeStrategyLow = "low"
+	// FeeStrategyMedium is the value of the --fee-strategy flag paying the medium
+	// gas price suggested by the node's fee estimator.
+	FeeStrategyMedium = "medium"
+	// FeeStrategyHigh is the value of the --fee-strategy flag paying the high gas
+	// price suggested by the node's fee estimator.
+	FeeStrategyHigh = "high"
 )
 
 // List of CLI flags
@@ -56,7 +74,9 @@ const (
 	FlagFees             = "fees"
 	FlagGas              = "gas"
 	FlagGasPrices        = "gas-prices"
+	FlagFeeStrategy      = "fee-strategy"
 	FlagBroadcastMode    = "broadcast-mode"
+	FlagBroadcastTimeout = "broadcast-timeout"
 	FlagDryRun           = "dry-run"
 	FlagGenerateOnly     = "generate-only"
 	FlagOffline          = "offline"
@@ -103,15 +123,17 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
 	cmd.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 	cmd.Flags().String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom)")
+	cmd.Flags().String(FlagFeeStrategy, "", "Determine the transaction fee from the gas prices paid in recent blocks, as suggested by the node (low|medium|high)")
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 	cmd.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	cmd.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
-	cmd.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
+	cmd.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block|wait)")
+	cmd.Flags().Duration(FlagBroadcastTimeout, DefaultBroadcastTimeout, "Time to wait for the transaction to be committed in the wait broadcasting mode")
 	cmd.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
 	cmd.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
-	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
 	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 	cmd.Flags().String(FlagFeeAccount, "", "Fee account pays fees for the transaction instead of deducting from the signer")
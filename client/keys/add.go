@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 
 	bip39 "github.com/cosmos/go-bip39"
@@ -31,6 +32,8 @@ const (
 	flagNoSort      = "nosort"
 	flagHDPath      = "hd-path"
 
+	flagMultisigWeights = "multisig-weights"
+
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
 )
@@ -58,6 +61,12 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+With --multisig-weights, a weighted multisig key is created instead, whose signatures
+are valid if the sum of the weights of the signers reaches the threshold. The keys of
+a multisig may be multisig keys themselves. Example:
+
+    keys add treasury --multisig "ceo,board1,board2,board3" --multisig-weights "3,1,1,1" --multisig-threshold 5
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -65,6 +74,7 @@ Example:
 	f := cmd.Flags()
 	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	f.UintSlice(flagMultisigWeights, nil, "Weights of the keys passed to --multisig, in the same order, making --multisig-threshold the minimum sum of the weights of the signers")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
@@ -144,8 +154,13 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		if len(multisigKeys) != 0 {
 			pks := make([]cryptotypes.PubKey, len(multisigKeys))
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
-				return err
+			multisigWeights, _ := cmd.Flags().GetUintSlice(flagMultisigWeights)
+			if len(multisigWeights) == 0 {
+				if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+					return err
+				}
+			} else if len(multisigWeights) != len(multisigKeys) {
+				return fmt.Errorf("got %d weights for %d keys", len(multisigWeights), len(multisigKeys))
 			}
 
 			for i, keyname := range multisigKeys {
@@ -158,12 +173,50 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 			}
 
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
-				sort.Slice(pks, func(i, j int) bool {
-					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
+				// sort the weights along with their keys
+				order := make([]int, len(pks))
+				for i := range order {
+					order[i] = i
+				}
+				sort.Slice(order, func(i, j int) bool {
+					return bytes.Compare(pks[order[i]].Address(), pks[order[j]].Address()) < 0
 				})
+
+				sortedPks := make([]cryptotypes.PubKey, len(pks))
+				for i, j := range order {
+					sortedPks[i] = pks[j]
+				}
+				if len(multisigWeights) != 0 {
+					sortedWeights := make([]uint, len(multisigWeights))
+					for i, j := range order {
+						sortedWeights[i] = multisigWeights[j]
+					}
+					multisigWeights = sortedWeights
+				}
+				pks = sortedPks
+			}
+
+			var pk cryptotypes.PubKey
+			if len(multisigWeights) == 0 {
+				pk = multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			} else {
+				if multisigThreshold <= 0 || int64(multisigThreshold) > math.MaxUint32 {
+					return fmt.Errorf("invalid threshold %d", multisigThreshold)
+				}
+
+				weights := make([]uint32, len(multisigWeights))
+				for i, w := range multisigWeights {
+					if w > math.MaxUint32 {
+						return fmt.Errorf("invalid weight %d", w)
+					}
+					weights[i] = uint32(w)
+				}
+
+				if pk, err = multisig.NewWeightedMultisigPubKey(uint32(multisigThreshold), pks, weights); err != nil {
+					return err
+				}
 			}
 
-			pk := multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
 			info, err := kb.SaveMultisig(name, pk)
 			if err != nil {
 				return err
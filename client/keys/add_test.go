@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -273,3 +274,49 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", info.GetName())
 }
+
+func Test_runAddCmdMultisigWeights(t *testing.T) {
+	kbHome := t.TempDir()
+	kb := newTestKeys(t, kbHome)
+
+	_, err := execKeysCmd(t, AddKeyCommand(), kbHome, "", "weighted",
+		fmt.Sprintf("--%s=%s", flagMultisig, "keyname1,keyname2"),
+		fmt.Sprintf("--%s=%s", flagMultisigWeights, "3"),
+		fmt.Sprintf("--%s=%d", flagMultiSigThreshold, 3),
+	)
+	require.EqualError(t, err, "got 1 weights for 2 keys")
+
+	_, err = execKeysCmd(t, AddKeyCommand(), kbHome, "", "weighted",
+		fmt.Sprintf("--%s=%s", flagMultisig, "keyname1,keyname2"),
+		fmt.Sprintf("--%s=%s", flagMultisigWeights, "3,1"),
+		fmt.Sprintf("--%s=%d", flagMultiSigThreshold, 5),
+	)
+	require.Error(t, err)
+
+	// the threshold may exceed the number of keys
+	_, err = execKeysCmd(t, AddKeyCommand(), kbHome, "", "weighted",
+		fmt.Sprintf("--%s=%s", flagMultisig, "keyname1,keyname2"),
+		fmt.Sprintf("--%s=%s", flagMultisigWeights, "3,1"),
+		fmt.Sprintf("--%s=%d", flagMultiSigThreshold, 4),
+	)
+	require.NoError(t, err)
+
+	info, err := kb.Key("weighted")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeMulti, info.GetType())
+
+	pk, ok := info.GetPubKey().(*multisig.WeightedMultisigPubKey)
+	require.True(t, ok)
+	require.Equal(t, uint(4), pk.GetThreshold())
+
+	// the weights are sorted along with the keys
+	key1, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	for i, member := range pk.GetPubKeys() {
+		if member.Equals(key1.GetPubKey()) {
+			require.Equal(t, uint32(3), pk.GetWeights()[i])
+		} else {
+			require.Equal(t, uint32(1), pk.GetWeights()[i])
+		}
+	}
+}
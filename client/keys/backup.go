@@ -0,0 +1,167 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagFromBackend = "from"
+	flagToBackend   = "to"
+)
+
+// BackupCommand writes all keys of the keyring to an encrypted archive.
+func BackupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup <file>",
+		Short: "Back up all keys of the keyring to an encrypted archive",
+		Long: `Write all keys of the keyring to an ASCII-armored archive encrypted with a
+passphrase, including the private keys of the local keys and the references to
+Ledger, offline and multisig keys. The archive is read back with 'keys restore'.
+The encryption key is derived from the passphrase with the function chosen with
+--kdf.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			kdf, err := getKDFParams(cmd)
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to encrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+
+			repeated, err := input.GetPassword("Repeat the passphrase:", buf)
+			if err != nil {
+				return err
+			}
+
+			if passphrase != repeated {
+				return errors.New("passphrases don't match")
+			}
+
+			armored, err := keyring.Backup(clientCtx.Keyring, passphrase, kdf)
+			if err != nil {
+				return err
+			}
+
+			return os.WriteFile(args[0], []byte(armored), 0o600)
+		},
+	}
+
+	addKDFFlags(cmd)
+
+	return cmd
+}
+
+// RestoreCommand imports all keys of an encrypted archive into the keyring.
+func RestoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <file>",
+		Short: "Restore all keys of an archive written by 'keys backup'",
+		Long: `Import all keys of an encrypted archive written by 'keys backup' into the keyring.
+No key is imported if the name or address of any key of the archive is already
+in the keyring.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the backup:", buf)
+			if err != nil {
+				return err
+			}
+
+			infos, err := keyring.Restore(clientCtx.Keyring, string(bz), passphrase)
+			if err != nil {
+				return err
+			}
+
+			printInfos(cmd.OutOrStdout(), infos, clientCtx.OutputFormat)
+
+			return nil
+		},
+	}
+}
+
+// MigrateBackendCommand copies all keys of a keyring backend into another.
+func MigrateBackendCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-backend",
+		Short: "Copy all keys of a keyring backend into another",
+		Long: `Copy all keys of the keyring of a backend into the keyring of another backend in
+the same keyring directory, including the private keys of the local keys and the
+references to Ledger, offline and multisig keys. The keys are left in the source
+keyring, to be deleted once the copy is checked. No key is copied if the name or
+address of any key is already in the destination keyring.
+
+Example:
+$ keys migrate-backend --from file --to os
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromBackend, _ := cmd.Flags().GetString(flagFromBackend)
+			toBackend, _ := cmd.Flags().GetString(flagToBackend)
+
+			if fromBackend == "" || toBackend == "" {
+				return fmt.Errorf("both --%s and --%s must be set", flagFromBackend, flagToBackend)
+			}
+			if fromBackend == toBackend {
+				return errors.New("the source and destination backends must differ")
+			}
+
+			from, err := keyring.New(sdk.KeyringServiceName(), fromBackend, clientCtx.KeyringDir, clientCtx.Input, clientCtx.KeyringOptions...)
+			if err != nil {
+				return err
+			}
+
+			to, err := keyring.New(sdk.KeyringServiceName(), toBackend, clientCtx.KeyringDir, clientCtx.Input, clientCtx.KeyringOptions...)
+			if err != nil {
+				return err
+			}
+
+			infos, err := keyring.MigrateBackend(from, to)
+			if err != nil {
+				return err
+			}
+
+			printInfos(cmd.OutOrStdout(), infos, clientCtx.OutputFormat)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagFromBackend, "", "The keyring backend to copy the keys from (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagToBackend, "", "The keyring backend to copy the keys to (os|file|kwallet|pass|test)")
+
+	return cmd
+}
@@ -0,0 +1,126 @@
+package keys
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// execKeysCmd runs a keys command over the test keyring of kbHome, reading
+// the given user input.
+func execKeysCmd(t *testing.T, cmd *cobra.Command, kbHome, userInput string, args ...string) (string, error) {
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	cmd.SetArgs(append(args,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	))
+
+	mockIn, mockOut := testutil.ApplyMockIO(cmd)
+	mockIn.Reset(userInput)
+	mockInBuf := bufio.NewReader(mockIn)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockInBuf)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithInput(mockInBuf)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	err = cmd.ExecuteContext(ctx)
+
+	return mockOut.String(), err
+}
+
+func newTestKeys(t *testing.T, kbHome string) keyring.Keyring {
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullBIP44Path()
+	_, err = kb.NewAccount("keyname1", testdata.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("keyname2", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	return kb
+}
+
+func Test_runBackupRestoreCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	kb := newTestKeys(t, kbHome)
+	file := filepath.Join(t.TempDir(), "keys.backup")
+
+	_, err := execKeysCmd(t, BackupCommand(), kbHome, "12345678\n87654321\n", file)
+	require.EqualError(t, err, "passphrases don't match")
+
+	_, err = execKeysCmd(t, BackupCommand(), kbHome, "12345678\n12345678\n", file)
+	require.NoError(t, err)
+
+	restoreHome := t.TempDir()
+	_, err = execKeysCmd(t, RestoreCommand(), restoreHome, "87654321\n", file)
+	require.Error(t, err)
+
+	out, err := execKeysCmd(t, RestoreCommand(), restoreHome, "12345678\n", file)
+	require.NoError(t, err)
+	require.True(t, strings.Contains(out, "keyname1"))
+	require.True(t, strings.Contains(out, "keyname2"))
+
+	restored, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, restoreHome, nil)
+	require.NoError(t, err)
+
+	for _, name := range []string{"keyname1", "keyname2"} {
+		expected, err := kb.Key(name)
+		require.NoError(t, err)
+		info, err := restored.Key(name)
+		require.NoError(t, err)
+		require.Equal(t, expected.GetAddress(), info.GetAddress())
+	}
+
+	// Restoring twice conflicts with the restored keys.
+	_, err = execKeysCmd(t, RestoreCommand(), restoreHome, "12345678\n", file)
+	require.Error(t, err)
+}
+
+func Test_runMigrateBackendCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	kb := newTestKeys(t, kbHome)
+
+	_, err := execKeysCmd(t, MigrateBackendCommand(), kbHome, "", "--from=test")
+	require.Error(t, err)
+	_, err = execKeysCmd(t, MigrateBackendCommand(), kbHome, "", "--from=test", "--to=test")
+	require.Error(t, err)
+
+	// The file backend prompts for a new passphrase twice.
+	out, err := execKeysCmd(t, MigrateBackendCommand(), kbHome, "12345678\n12345678\n", "--from=test", "--to=file")
+	require.NoError(t, err)
+	require.True(t, strings.Contains(out, "keyname1"))
+
+	migrated, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, strings.NewReader("12345678\n"))
+	require.NoError(t, err)
+
+	expected, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	info, err := migrated.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, expected.GetAddress(), info.GetAddress())
+
+	// The keys are left in the source keyring.
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+}
@@ -0,0 +1,244 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagAccounts = "accounts"
+	flagIndices  = "indices"
+	flagDiscover = "discover"
+	flagGapLimit = "gap-limit"
+
+	// defaultGapLimit is the number of consecutive unused addresses after
+	// which the discovery of the addresses of an account stops, as in BIP44.
+	defaultGapLimit = 20
+)
+
+// DerivedKeyOutput is a key derived from a mnemonic by the derive command.
+type DerivedKeyOutput struct {
+	Account uint32 `json:"account" yaml:"account"`
+	Index   uint32 `json:"index" yaml:"index"`
+	Path    string `json:"path" yaml:"path"`
+	Address string `json:"address" yaml:"address"`
+}
+
+// DeriveKeysCommand derives the addresses of a range of accounts and indices
+// of a mnemonic.
+func DeriveKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive",
+		Short: "Derive the addresses of a range of accounts and indices of a mnemonic",
+		Long: `Derive the addresses of the HD paths m/44'/<coin-type>'/<account>'/0/<index> of a
+BIP39 mnemonic, for the --accounts accounts from --account and the --indices indices
+from --index, without storing any key.
+
+With --discover, the chain is queried to find the addresses which were ever used,
+i.e. whose account exists, as in the account discovery of BIP44: the addresses of
+each account from --account are derived until --gap-limit consecutive addresses are
+unused, and the discovery stops at the first account without any used address. Only
+the used addresses are shown, to be recovered with 'keys add --recover --account
+<account> --index <index>'.
+
+Example:
+$ keys derive --accounts 2 --indices 5
+$ keys derive --discover --node tcp://localhost:26657
+`,
+		Args: cobra.NoArgs,
+		RunE: runDeriveCmd,
+	}
+
+	f := cmd.Flags()
+	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase")
+	f.Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
+	f.Uint32(flagAccount, 0, "First account number for HD derivation")
+	f.Uint32(flagAccounts, 1, "Number of accounts to derive")
+	f.Uint32(flagIndex, 0, "First address index number for HD derivation")
+	f.Uint32(flagIndices, 10, "Number of address indices to derive per account")
+	f.Bool(flagDiscover, false, "Query the chain for the used addresses of the accounts of the mnemonic")
+	f.Uint32(flagGapLimit, defaultGapLimit, "Number of consecutive unused addresses after which --discover stops scanning an account")
+	f.String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Key signing algorithm to derive keys for")
+	f.String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+
+	return cmd
+}
+
+func runDeriveCmd(cmd *cobra.Command, _ []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	buf := bufio.NewReader(clientCtx.Input)
+
+	keyringAlgos, _ := clientCtx.Keyring.SupportedAlgorithms()
+	algoStr, _ := cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+	algo, err := keyring.NewSigningAlgoFromString(algoStr, keyringAlgos)
+	if err != nil {
+		return err
+	}
+
+	discover, _ := cmd.Flags().GetBool(flagDiscover)
+	if discover && clientCtx.AccountRetriever == nil {
+		return errors.New("the client has no account retriever to discover used addresses")
+	}
+
+	mnemonic, err := input.GetString("Enter your bip39 mnemonic", buf)
+	if err != nil {
+		return err
+	}
+
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return errors.New("invalid mnemonic")
+	}
+
+	var bip39Passphrase string
+	if interactive, _ := cmd.Flags().GetBool(flagInteractive); interactive {
+		bip39Passphrase, err = input.GetString("Enter your bip39 passphrase", buf)
+		if err != nil {
+			return err
+		}
+	}
+
+	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
+	deriveKey := func(account, index uint32) (DerivedKeyOutput, error) {
+		path := hd.CreateHDPath(coinType, account, index).String()
+
+		derivedPriv, err := algo.Derive()(mnemonic, bip39Passphrase, path)
+		if err != nil {
+			return DerivedKeyOutput{}, err
+		}
+
+		addr := sdk.AccAddress(algo.Generate()(derivedPriv).PubKey().Address())
+
+		return DerivedKeyOutput{Account: account, Index: index, Path: path, Address: addr.String()}, nil
+	}
+
+	firstAccount, _ := cmd.Flags().GetUint32(flagAccount)
+	firstIndex, _ := cmd.Flags().GetUint32(flagIndex)
+
+	var keys []DerivedKeyOutput
+	if discover {
+		gapLimit, _ := cmd.Flags().GetUint32(flagGapLimit)
+		if gapLimit == 0 {
+			return fmt.Errorf("--%s must be positive", flagGapLimit)
+		}
+
+		keys, err = discoverKeys(clientCtx, deriveKey, firstAccount, firstIndex, gapLimit)
+		if err != nil {
+			return err
+		}
+	} else {
+		accounts, _ := cmd.Flags().GetUint32(flagAccounts)
+		indices, _ := cmd.Flags().GetUint32(flagIndices)
+
+		for account := firstAccount; account-firstAccount < accounts; account++ {
+			for index := firstIndex; index-firstIndex < indices; index++ {
+				key, err := deriveKey(account, index)
+				if err != nil {
+					return err
+				}
+
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return printDerivedKeys(cmd, keys, clientCtx.OutputFormat)
+}
+
+// discoverKeys returns the derived keys whose account exists on chain,
+// scanning the indices of each account until gapLimit consecutive addresses
+// are unused and stopping at the first account without used addresses.
+func discoverKeys(
+	clientCtx client.Context, deriveKey func(account, index uint32) (DerivedKeyOutput, error),
+	firstAccount, firstIndex, gapLimit uint32,
+) ([]DerivedKeyOutput, error) {
+	var keys []DerivedKeyOutput
+	for account := firstAccount; ; account++ {
+		found := false
+
+		for index, gap := firstIndex, uint32(0); gap < gapLimit; index++ {
+			key, err := deriveKey(account, index)
+			if err != nil {
+				return nil, err
+			}
+
+			used, err := isAddressUsed(clientCtx, key.Address)
+			if err != nil {
+				return nil, err
+			}
+
+			if used {
+				keys = append(keys, key)
+				found = true
+				gap = 0
+			} else {
+				gap++
+			}
+		}
+
+		if !found {
+			return keys, nil
+		}
+	}
+}
+
+// isAddressUsed returns whether the account of the address exists.
+func isAddressUsed(clientCtx client.Context, address string) (bool, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false, err
+	}
+
+	err = clientCtx.AccountRetriever.EnsureExists(clientCtx, addr)
+	switch {
+	case err == nil:
+		return true, nil
+	case status.Code(err) == codes.NotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to query the account of %s: %w", address, err)
+	}
+}
+
+func printDerivedKeys(cmd *cobra.Command, keys []DerivedKeyOutput, output string) error {
+	if keys == nil {
+		keys = []DerivedKeyOutput{}
+	}
+
+	var (
+		out []byte
+		err error
+	)
+
+	switch output {
+	case OutputFormatText:
+		out, err = yaml.Marshal(keys)
+	case OutputFormatJSON:
+		out, err = KeysCdc.MarshalJSON(keys)
+	default:
+		return fmt.Errorf("unsupported output format %s", output)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), string(out))
+
+	return nil
+}
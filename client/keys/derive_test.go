@@ -0,0 +1,142 @@
+package keys
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// usedAccountRetriever is an account retriever for which only the accounts of
+// the used addresses exist.
+type usedAccountRetriever struct {
+	client.AccountRetriever
+	used    map[string]bool
+	queries int
+	err     error
+}
+
+func (ar *usedAccountRetriever) EnsureExists(_ client.Context, addr sdk.AccAddress) error {
+	ar.queries++
+	if ar.err != nil {
+		return ar.err
+	}
+
+	if !ar.used[addr.String()] {
+		return status.Errorf(codes.NotFound, "account %s not found", addr)
+	}
+
+	return nil
+}
+
+func execDeriveCmd(t *testing.T, ar client.AccountRetriever, userInput string, args ...string) ([]DerivedKeyOutput, error) {
+	kbHome := t.TempDir()
+	cmd := DeriveKeysCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	cmd.SetArgs(append(args,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON),
+	))
+
+	mockIn, mockOut := testutil.ApplyMockIO(cmd)
+	mockIn.Reset(userInput)
+	mockInBuf := bufio.NewReader(mockIn)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockInBuf)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithInput(mockInBuf).
+		WithAccountRetriever(ar)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		return nil, err
+	}
+
+	var keys []DerivedKeyOutput
+	require.NoError(t, KeysCdc.UnmarshalJSON([]byte(strings.TrimSpace(mockOut.String())), &keys))
+
+	return keys, nil
+}
+
+// derivedAddress returns the address of the test mnemonic for the account and
+// index.
+func derivedAddress(t *testing.T, account, index uint32) string {
+	kb := keyring.NewInMemory()
+	info, err := kb.NewAccount("key", testdata.TestMnemonic, "", hd.CreateHDPath(sdk.GetConfig().GetCoinType(), account, index).String(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	return info.GetAddress().String()
+}
+
+func Test_runDeriveCmd(t *testing.T) {
+	_, err := execDeriveCmd(t, nil, "invalid mnemonic\n")
+	require.Error(t, err)
+
+	keys, err := execDeriveCmd(t, nil, testdata.TestMnemonic+"\n", "--account=1", "--accounts=2", "--index=3", "--indices=2")
+	require.NoError(t, err)
+	require.Len(t, keys, 4)
+
+	i := 0
+	for account := uint32(1); account < 3; account++ {
+		for index := uint32(3); index < 5; index++ {
+			require.Equal(t, account, keys[i].Account)
+			require.Equal(t, index, keys[i].Index)
+			require.Equal(t, fmt.Sprintf("m/44'/118'/%d'/0/%d", account, index), keys[i].Path)
+			require.Equal(t, derivedAddress(t, account, index), keys[i].Address)
+			i++
+		}
+	}
+}
+
+func Test_runDeriveCmdDiscover(t *testing.T) {
+	_, err := execDeriveCmd(t, nil, testdata.TestMnemonic+"\n", "--discover")
+	require.Error(t, err)
+
+	ar := &usedAccountRetriever{used: map[string]bool{
+		derivedAddress(t, 0, 0): true,
+		derivedAddress(t, 0, 4): true,
+		derivedAddress(t, 1, 2): true,
+		// beyond the gap limit of account 1
+		derivedAddress(t, 1, 8): true,
+		// after account 2 without used addresses
+		derivedAddress(t, 3, 0): true,
+	}}
+
+	keys, err := execDeriveCmd(t, ar, testdata.TestMnemonic+"\n", "--discover", "--gap-limit=5")
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	require.Equal(t, DerivedKeyOutput{Account: 0, Index: 0, Path: "m/44'/118'/0'/0/0", Address: derivedAddress(t, 0, 0)}, keys[0])
+	require.Equal(t, uint32(4), keys[1].Index)
+	require.Equal(t, uint32(1), keys[2].Account)
+	require.Equal(t, uint32(2), keys[2].Index)
+
+	// account 0 scans 10 indices, account 1 8 indices and account 2 5 indices
+	require.Equal(t, 10+8+5, ar.queries)
+
+	keys, err = execDeriveCmd(t, &usedAccountRetriever{}, testdata.TestMnemonic+"\n", "--discover")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	_, err = execDeriveCmd(t, &usedAccountRetriever{err: errors.New("connection refused")}, testdata.TestMnemonic+"\n", "--discover")
+	require.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
@@ -22,6 +23,10 @@ func ExportKeyCommand() *cobra.Command {
 		Use:   "export <name>",
 		Short: "Export private keys",
 		Long: `Export a private key from the local keyring in ASCII-armored encrypted format.
+The encryption key is derived from the passphrase with bcrypt by default, or with
+the stronger Argon2id or scrypt functions chosen with --kdf, whose costs are set
+with --kdf-params. Keys armored with Argon2id or scrypt cannot be imported by
+older versions.
 
 When both the --unarmored-hex and --unsafe flags are selected, cryptographic
 private key material is exported in an INSECURE fashion that is designed to
@@ -45,12 +50,24 @@ and export your keys in ASCII-armored encrypted format.`,
 				return fmt.Errorf("the flags %s and %s must be used together", flagUnsafe, flagUnarmoredHex)
 			}
 
+			kdf, err := getKDFParams(cmd)
+			if err != nil {
+				return err
+			}
+
 			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
 			if err != nil {
 				return err
 			}
 
-			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+			var armored string
+			if exporter, ok := clientCtx.Keyring.(keyring.KDFExporter); ok {
+				armored, err = exporter.ExportPrivKeyArmorWithKDF(args[0], encryptPassword, kdf)
+			} else if kdf.KDF == crypto.KDFBcrypt {
+				armored, err = clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+			} else {
+				err = fmt.Errorf("the keyring does not support the %s key derivation", kdf.KDF)
+			}
 			if err != nil {
 				return err
 			}
@@ -63,6 +80,7 @@ and export your keys in ASCII-armored encrypted format.`,
 
 	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
 	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
+	addKDFFlags(cmd)
 
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -111,3 +112,23 @@ func Test_runExportCmd(t *testing.T) {
 		})
 	}
 }
+
+func Test_runExportCmdKDF(t *testing.T) {
+	kbHome := t.TempDir()
+	newTestKeys(t, kbHome)
+
+	_, err := execKeysCmd(t, ExportKeyCommand(), kbHome, "12345678\n", "keyname1", "--kdf=wrong")
+	require.Error(t, err)
+	_, err = execKeysCmd(t, ExportKeyCommand(), kbHome, "12345678\n", "keyname1", "--kdf=scrypt", "--kdf-params=n=1000")
+	require.Error(t, err)
+	_, err = execKeysCmd(t, ExportKeyCommand(), kbHome, "12345678\n", "keyname1", "--kdf=scrypt", "--kdf-params=time=1")
+	require.Error(t, err)
+
+	out, err := execKeysCmd(t, ExportKeyCommand(), kbHome, "12345678\n", "keyname1", "--kdf=argon2id", "--kdf-params=time=1,memory=64,threads=1")
+	require.NoError(t, err)
+	require.Contains(t, out, "kdf: argon2id")
+
+	priv, _, err := crypto.UnarmorDecryptPrivKey(out, "12345678")
+	require.NoError(t, err)
+	require.NotNil(t, priv)
+}
@@ -0,0 +1,77 @@
+package keys
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+const (
+	flagKDF       = "kdf"
+	flagKDFParams = "kdf-params"
+)
+
+// addKDFFlags adds the flags choosing the key derivation function of the
+// armors written by a command.
+func addKDFFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagKDF, crypto.KDFBcrypt, "The function deriving the encryption key from the passphrase (bcrypt|argon2id|scrypt)")
+	cmd.Flags().String(flagKDFParams, "", "The costs of the key derivation overriding its defaults, e.g. time=3,memory=65536,threads=4 for argon2id or n=32768,r=8,p=1 for scrypt")
+}
+
+// getKDFParams returns the key derivation function chosen by the flags of a
+// command.
+func getKDFParams(cmd *cobra.Command) (crypto.KDFParams, error) {
+	kdf, _ := cmd.Flags().GetString(flagKDF)
+	costs, _ := cmd.Flags().GetString(flagKDFParams)
+
+	var params crypto.KDFParams
+	switch kdf {
+	case crypto.KDFBcrypt:
+		params = crypto.BcryptKDFParams()
+	case crypto.KDFArgon2id:
+		params = crypto.DefaultArgon2idParams()
+	case crypto.KDFScrypt:
+		params = crypto.DefaultScryptParams()
+	default:
+		return params, fmt.Errorf("unrecognized KDF type: %s", kdf)
+	}
+
+	if costs == "" {
+		return params, params.Validate()
+	}
+
+	for _, cost := range strings.Split(costs, ",") {
+		kv := strings.SplitN(strings.TrimSpace(cost), "=", 2)
+		if len(kv) != 2 {
+			return params, fmt.Errorf("invalid KDF cost %q, expected <name>=<value>", cost)
+		}
+
+		v, err := strconv.ParseUint(kv[1], 10, 32)
+		if err != nil {
+			return params, fmt.Errorf("invalid KDF cost %q: %w", cost, err)
+		}
+
+		switch {
+		case kdf == crypto.KDFArgon2id && kv[0] == "time":
+			params.Time = uint32(v)
+		case kdf == crypto.KDFArgon2id && kv[0] == "memory":
+			params.Memory = uint32(v)
+		case kdf == crypto.KDFArgon2id && kv[0] == "threads" && v <= 255:
+			params.Threads = uint8(v)
+		case kdf == crypto.KDFScrypt && kv[0] == "n":
+			params.N = int(v)
+		case kdf == crypto.KDFScrypt && kv[0] == "r":
+			params.R = int(v)
+		case kdf == crypto.KDFScrypt && kv[0] == "p":
+			params.P = int(v)
+		default:
+			return params, fmt.Errorf("invalid %s cost %q", kdf, cost)
+		}
+	}
+
+	return params, params.Validate()
+}
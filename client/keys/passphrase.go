@@ -0,0 +1,70 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChangePassphraseCommand changes the passphrase of the file keyring backend.
+func ChangePassphraseCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "change-passphrase",
+		Short: "Change the passphrase of the file keyring backend",
+		Long: `Re-encrypt all keys of the file keyring backend with a new passphrase. The
+keyring is rewritten in a new directory which then replaces the current one, so
+that the keyring is left untouched if the command fails.
+
+Example:
+$ keys change-passphrase --keyring-backend file
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+			if backend != keyring.BackendFile {
+				return fmt.Errorf("only the %s keyring backend is encrypted with a passphrase", keyring.BackendFile)
+			}
+
+			oldPassphrase, err := input.GetPassword("Enter the current keyring passphrase:", buf)
+			if err != nil {
+				return err
+			}
+
+			newPassphrase, err := input.GetPassword("Enter the new keyring passphrase:", buf)
+			if err != nil {
+				return err
+			}
+
+			repeated, err := input.GetPassword("Repeat the new passphrase:", buf)
+			if err != nil {
+				return err
+			}
+
+			if newPassphrase != repeated {
+				return errors.New("passphrases don't match")
+			}
+
+			if err := keyring.ChangeFilePassphrase(sdk.KeyringServiceName(), clientCtx.KeyringDir, oldPassphrase, newPassphrase); err != nil {
+				return err
+			}
+
+			cmd.PrintErrln("The keyring passphrase was changed.")
+
+			return nil
+		},
+	}
+}
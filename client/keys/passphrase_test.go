@@ -0,0 +1,59 @@
+package keys
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runChangePassphraseCmd(t *testing.T) {
+	// Only the file backend has a passphrase.
+	_, err := execKeysCmd(t, ChangePassphraseCommand(), t.TempDir(), "12345678\n87654321\n87654321\n")
+	require.Error(t, err)
+
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, strings.NewReader("12345678\n12345678\n"))
+	require.NoError(t, err)
+	info, err := kb.NewAccount("keyname1", testdata.TestMnemonic, "", sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	execCmd := func(userInput string) error {
+		cmd := ChangePassphraseCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		cmd.SetArgs([]string{
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendFile),
+		})
+
+		mockIn, _ := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(userInput)
+
+		clientCtx := client.Context{}.
+			WithKeyringDir(kbHome).
+			WithInput(bufio.NewReader(mockIn))
+
+		return cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	}
+
+	require.EqualError(t, execCmd("12345678\n87654321\n12345678\n"), "passphrases don't match")
+	require.Error(t, execCmd("87654321\n87654321\n87654321\n"))
+	require.NoError(t, execCmd("12345678\n87654321\n87654321\n"))
+
+	kb, err = keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, strings.NewReader("87654321\n"))
+	require.NoError(t, err)
+	got, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), got.GetAddress())
+}
@@ -23,10 +23,14 @@ The keyring supports the following backends:
     file        Uses encrypted file-based keystore within the app's configuration directory.
                 This keyring will request a password each time it is accessed, which may occur
                 multiple times in a single command resulting in repeated password prompts.
+                Its password is changed with 'keys change-passphrase'.
     kwallet     Uses KDE Wallet Manager as a credentials management application.
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Forwards key listing and signing to a remote signer, e.g. one served by
+                'keys serve-signer', over gRPC with mutual TLS. It is configured by the
+                keyring-remote/signer.json file of the keyring directory.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -47,11 +51,19 @@ The pass backend requires GnuPG: https://gnupg.org/
 		DeleteKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		ServeSignerCommand(),
+		BackupCommand(),
+		RestoreCommand(),
+		MigrateBackendCommand(),
+		ChangePassphraseCommand(),
+		SplitCommand(),
+		CombineCommand(),
+		DeriveKeysCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
@@ -0,0 +1,109 @@
+package keys
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/security"
+)
+
+const (
+	flagListen      = "listen"
+	flagTLSCert     = "tls-cert"
+	flagTLSKey      = "tls-key"
+	flagTLSClientCA = "tls-client-ca"
+
+	defaultSignerListenAddr = "127.0.0.1:26680"
+)
+
+// ServeSignerCommand serves the keys of the keyring to remote keyring clients.
+func ServeSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-signer",
+		Short: "Serve the keys of the keyring to clients of the remote keyring backend",
+		Long: `Run a signer daemon serving the keys of the keyring over gRPC with mutual TLS to the
+clients using the remote keyring backend, so that the private keys never leave this
+host. Clients can list the keys and sign with them, but not create, delete, import
+or export them. Only the clients presenting a certificate signed by one of the CAs
+of --tls-client-ca are served.
+
+A client is configured by the keyring-remote/signer.json file of its keyring
+directory, e.g.:
+
+{
+  "address": "signer.example.com:26680",
+  "ca_file": "ca.pem",
+  "cert_file": "client.pem",
+  "key_file": "client-key.pem"
+}
+
+where relative paths are resolved against the keyring-remote directory.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Keyring == nil {
+				return errors.New("no keyring to serve")
+			}
+
+			listen, _ := cmd.Flags().GetString(flagListen)
+			certFile, _ := cmd.Flags().GetString(flagTLSCert)
+			keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+			clientCAFile, _ := cmd.Flags().GetString(flagTLSClientCA)
+
+			if certFile == "" || keyFile == "" || clientCAFile == "" {
+				return fmt.Errorf("--%s, --%s and --%s are required", flagTLSCert, flagTLSKey, flagTLSClientCA)
+			}
+
+			tlsCfg, err := security.NewTLSConfig(config.TLSConfig{
+				CertFile:     certFile,
+				KeyFile:      keyFile,
+				ClientCAFile: clientCAFile,
+			})
+			if err != nil {
+				return err
+			}
+
+			lis, err := net.Listen("tcp", listen)
+			if err != nil {
+				return err
+			}
+
+			srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsCfg)))
+			keyring.RegisterRemoteSignerServer(srv, keyring.NewRemoteSignerServer(clientCtx.Keyring))
+
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				srv.GracefulStop()
+			}()
+
+			cmd.PrintErrf("serving keys on %s\n", lis.Addr())
+
+			return srv.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flagListen, defaultSignerListenAddr, "The address to listen on")
+	cmd.Flags().String(flagTLSCert, "", "The PEM encoded certificate of the signer")
+	cmd.Flags().String(flagTLSKey, "", "The PEM encoded private key of the certificate of the signer")
+	cmd.Flags().String(flagTLSClientCA, "", "The PEM encoded bundle of the CAs the certificates of the clients are verified against")
+
+	return cmd
+}
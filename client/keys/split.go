@@ -0,0 +1,205 @@
+package keys
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/shamir"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagThreshold = "threshold"
+	flagShares    = "shares"
+)
+
+// SplitCommand splits a mnemonic or a private key into shares.
+func SplitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split [name]",
+		Short: "Split a mnemonic or a private key into M-of-N shares",
+		Long: `Split a BIP39 mnemonic, or the private key of a local secp256k1 key of the keyring
+if a name is given, into shares with Shamir's secret sharing, so that any --threshold
+of the --shares shares recover the mnemonic or the key with 'keys combine', while
+fewer shares reveal nothing about it.
+
+The shares are printed one per line, each as words of the BIP39 English wordlist with
+a checksum. They are not BIP39 mnemonics and are only read back by 'keys combine'.
+Splitting a mnemonic recovers all its accounts, while splitting a key only recovers
+that key.
+
+Example:
+$ keys split --threshold 2 --shares 3
+$ keys split mykey --threshold 3 --shares 5
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			threshold, _ := cmd.Flags().GetInt(flagThreshold)
+			count, _ := cmd.Flags().GetInt(flagShares)
+
+			var (
+				secretType shamir.SecretType
+				secret     []byte
+			)
+
+			if len(args) == 0 {
+				mnemonic, err := input.GetString("Enter your bip39 mnemonic", buf)
+				if err != nil {
+					return err
+				}
+
+				secretType = shamir.SecretMnemonic
+				if secret, err = shamir.MnemonicEntropy(mnemonic); err != nil {
+					return fmt.Errorf("invalid mnemonic: %w", err)
+				}
+			} else {
+				info, err := clientCtx.Keyring.Key(args[0])
+				if err != nil {
+					return err
+				}
+
+				if info.GetAlgo() != hd.Secp256k1Type {
+					return fmt.Errorf("only %s keys can be split, got %s", hd.Secp256k1Type, info.GetAlgo())
+				}
+
+				privHex, err := keyring.NewUnsafe(clientCtx.Keyring).UnsafeExportPrivKeyHex(args[0])
+				if err != nil {
+					return err
+				}
+
+				secretType = shamir.SecretSecp256k1
+				if secret, err = hex.DecodeString(privHex); err != nil {
+					return err
+				}
+			}
+
+			shares, err := shamir.Split(secretType, secret, threshold, count)
+			if err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("**Important** write each share in a safe place and give them to different custodians.\n")
+			cmd.PrintErrf("Any %d of the %d shares recover the %s.\n\n", threshold, count, secretType)
+
+			for _, share := range shares {
+				fmt.Fprintln(cmd.OutOrStdout(), share.String())
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagThreshold, 2, "The number of shares required to recover the mnemonic or the key")
+	cmd.Flags().Int(flagShares, 3, "The number of shares to split the mnemonic or the key into")
+
+	return cmd
+}
+
+// CombineCommand recovers a key into the keyring from shares written by
+// SplitCommand.
+func CombineCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "combine <name>",
+		Short: "Recover a key from shares written by 'keys split'",
+		Long: `Recover a key into the keyring from shares of a mnemonic or a private key written
+by 'keys split', prompting for shares until the threshold of the shares is reached.
+The account of a recovered mnemonic is derived with the HD path given by the flags,
+as with 'keys add --recover'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+			name := args[0]
+
+			if _, err := clientCtx.Keyring.Key(name); err == nil {
+				return fmt.Errorf("cannot overwrite key: %s", name)
+			}
+
+			var shares []shamir.Share
+			for len(shares) == 0 || len(shares) < int(shares[0].Threshold) {
+				words, err := input.GetString(fmt.Sprintf("Enter share %d", len(shares)+1), buf)
+				if err != nil {
+					return err
+				}
+
+				share, err := shamir.ParseShare(words)
+				if err != nil {
+					return fmt.Errorf("invalid share %d: %w", len(shares)+1, err)
+				}
+
+				shares = append(shares, share)
+			}
+
+			secretType, secret, err := shamir.Combine(shares)
+			if err != nil {
+				return err
+			}
+
+			var info keyring.Info
+			switch secretType {
+			case shamir.SecretMnemonic:
+				mnemonic, err := bip39.NewMnemonic(secret)
+				if err != nil {
+					return err
+				}
+
+				coinType, _ := cmd.Flags().GetUint32(flagCoinType)
+				account, _ := cmd.Flags().GetUint32(flagAccount)
+				index, _ := cmd.Flags().GetUint32(flagIndex)
+				hdPath, _ := cmd.Flags().GetString(flagHDPath)
+
+				if len(hdPath) == 0 {
+					hdPath = hd.CreateHDPath(coinType, account, index).String()
+				}
+
+				info, err = clientCtx.Keyring.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase, hdPath, hd.Secp256k1)
+				if err != nil {
+					return err
+				}
+
+			case shamir.SecretSecp256k1:
+				// the keyring only imports armored private keys, this armor is
+				// decrypted right away and never written
+				armor := crypto.EncryptArmorPrivKey(&secp256k1.PrivKey{Key: secret}, DefaultKeyPass, string(hd.Secp256k1Type))
+				if err := clientCtx.Keyring.ImportPrivKey(name, armor, DefaultKeyPass); err != nil {
+					return err
+				}
+
+				if info, err = clientCtx.Keyring.Key(name); err != nil {
+					return err
+				}
+
+			default:
+				return fmt.Errorf("unknown secret type %s", secretType)
+			}
+
+			return printCreate(cmd, info, false, "", clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config)")
+	cmd.Flags().Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
+	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
+	cmd.Flags().Uint32(flagIndex, 0, "Address index number for HD derivation")
+
+	return cmd
+}
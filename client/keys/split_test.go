@@ -0,0 +1,98 @@
+package keys
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runSplitCombineMnemonicCmd(t *testing.T) {
+	_, err := execKeysCmd(t, SplitCommand(), t.TempDir(), "invalid mnemonic\n", "--threshold=2", "--shares=3")
+	require.Error(t, err)
+
+	_, err = execKeysCmd(t, SplitCommand(), t.TempDir(), testdata.TestMnemonic+"\n", "--threshold=1", "--shares=3")
+	require.Error(t, err)
+
+	out, err := execKeysCmd(t, SplitCommand(), t.TempDir(), testdata.TestMnemonic+"\n", "--threshold=2", "--shares=3")
+	require.NoError(t, err)
+	shares := splitOutputShares(out)
+	require.Len(t, shares, 3)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+	expected, err := kb.NewAccount("expected", testdata.TestMnemonic, "", sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	// An invalid share is rejected.
+	kbHome := t.TempDir()
+	corrupted := strings.Fields(shares[1])
+	corrupted[3], corrupted[4] = corrupted[4], corrupted[3]
+	_, err = execKeysCmd(t, CombineCommand(), kbHome, shares[0]+"\n"+strings.Join(corrupted, " ")+"\n", "recovered")
+	require.Error(t, err)
+
+	// The same share twice is rejected.
+	_, err = execKeysCmd(t, CombineCommand(), kbHome, shares[0]+"\n"+shares[0]+"\n", "recovered")
+	require.Error(t, err)
+
+	_, err = execKeysCmd(t, CombineCommand(), kbHome, shares[2]+"\n"+shares[0]+"\n", "recovered")
+	require.NoError(t, err)
+
+	recovered, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+	info, err := recovered.Key("recovered")
+	require.NoError(t, err)
+	require.Equal(t, expected.GetAddress(), info.GetAddress())
+
+	_, err = execKeysCmd(t, CombineCommand(), kbHome, shares[1]+"\n"+shares[2]+"\n", "recovered")
+	require.Error(t, err)
+}
+
+func Test_runSplitCombineKeyCmd(t *testing.T) {
+	kbHome := t.TempDir()
+	kb := newTestKeys(t, kbHome)
+
+	_, err := execKeysCmd(t, SplitCommand(), kbHome, "", "unknown")
+	require.Error(t, err)
+
+	out, err := execKeysCmd(t, SplitCommand(), kbHome, "", "keyname2", "--threshold=3", "--shares=5")
+	require.NoError(t, err)
+	shares := splitOutputShares(out)
+	require.Len(t, shares, 5)
+
+	recoverHome := t.TempDir()
+	_, err = execKeysCmd(t, CombineCommand(), recoverHome, shares[4]+"\n"+shares[1]+"\n", "recovered")
+	require.Error(t, err)
+
+	_, err = execKeysCmd(t, CombineCommand(), recoverHome, shares[4]+"\n"+shares[1]+"\n"+shares[3]+"\n", "recovered")
+	require.NoError(t, err)
+
+	recovered, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, recoverHome, nil)
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	sig, pub, err := recovered.Sign("recovered", msg)
+	require.NoError(t, err)
+	expected, err := kb.Key("keyname2")
+	require.NoError(t, err)
+	require.True(t, expected.GetPubKey().Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+}
+
+// splitOutputShares returns the shares printed by the split command, skipping
+// its notice.
+func splitOutputShares(out string) []string {
+	var shares []string
+	for _, line := range strings.Split(out, "\n") {
+		if len(strings.Fields(line)) > 20 && !strings.Contains(line, ".") {
+			shares = append(shares, line)
+		}
+	}
+
+	return shares
+}
@@ -31,6 +31,7 @@ type Factory struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeStrategy        string
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -79,6 +80,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	feeStrategy, _ := flagSet.GetString(flags.FlagFeeStrategy)
+	f = f.WithFeeStrategy(feeStrategy)
+
 	return f
 }
 
@@ -91,6 +95,7 @@ func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) FeeStrategy() string                       { return f.feeStrategy }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
 
@@ -144,6 +149,13 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithFeeStrategy returns a copy of the Factory with an updated fee strategy,
+// the gas price suggested by the node's fee estimator to pay, if any.
+func (f Factory) WithFeeStrategy(feeStrategy string) Factory {
+	f.feeStrategy = feeStrategy
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -257,6 +269,11 @@ func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) erro
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: f.Gas()})
 	}
 
+	f, err := applyFeeStrategy(clientCtx, f)
+	if err != nil {
+		return err
+	}
+
 	unsignedTx, err := f.BuildUnsignedTx(msgs...)
 	if err != nil {
 		return err
@@ -0,0 +1,282 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultSequenceRetries is the number of times a SequenceManager rebroadcasts
+// a transaction rejected for a wrong sequence, after resyncing the sequence of
+// its signer.
+const DefaultSequenceRetries = 3
+
+// expectedSequenceRegex matches the sequence expected by the node in the log of
+// a transaction rejected for a wrong sequence.
+var expectedSequenceRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// SequenceManager signs and broadcasts transactions on behalf of clients
+// sending many transactions from the same keys, e.g. bots, without querying
+// the account of the signer before each transaction. It tracks the account
+// number and the next sequence of each signer locally: the sequence is
+// advanced for each transaction accepted in the mempool, and resynced through
+// the AccountRetriever when a transaction is rejected for a wrong sequence,
+// e.g. because another client used the same key.
+//
+// The transactions of a signer are signed and broadcast one at a time, in
+// sequence order, while those of different signers may be broadcast in
+// parallel. A SequenceManager is safe for concurrent use.
+type SequenceManager struct {
+	retriever client.AccountRetriever
+	retries   int
+
+	mtx     sync.Mutex
+	signers map[string]*signerSequence
+}
+
+// signerSequence is the account number and next sequence of a signer.
+type signerSequence struct {
+	mtx           sync.Mutex
+	synced        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+// NewSequenceManager returns a SequenceManager querying accounts through the
+// given AccountRetriever.
+func NewSequenceManager(retriever client.AccountRetriever) *SequenceManager {
+	return &SequenceManager{
+		retriever: retriever,
+		retries:   DefaultSequenceRetries,
+		signers:   make(map[string]*signerSequence),
+	}
+}
+
+// WithRetries sets the number of times a transaction rejected for a wrong
+// sequence is rebroadcast.
+func (m *SequenceManager) WithRetries(retries int) *SequenceManager {
+	m.retries = retries
+	return m
+}
+
+// Sequence returns the account number and the next sequence of the given
+// signer, querying its account if it is not tracked yet.
+func (m *SequenceManager) Sequence(clientCtx client.Context, addr sdk.AccAddress) (accNum, seq uint64, err error) {
+	s := m.signer(addr)
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := m.sync(clientCtx, addr, s, false); err != nil {
+		return 0, 0, err
+	}
+
+	return s.accountNumber, s.sequence, nil
+}
+
+// Resync queries the account of the given signer on its next use.
+func (m *SequenceManager) Resync(addr sdk.AccAddress) {
+	s := m.signer(addr)
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.synced = false
+}
+
+// BroadcastTx builds a transaction of the given messages with the account
+// number and next sequence of the signer of the client context, then signs
+// and broadcasts it as BroadcastTx does, without prompting for confirmation.
+// The sequence of the signer is advanced if the transaction passes CheckTx.
+// If the transaction is rejected for a wrong sequence, the sequence is
+// resynced and the transaction rebroadcast.
+func (m *SequenceManager) BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	from := clientCtx.GetFromAddress()
+	if from.Empty() {
+		return nil, errors.New("no signer set in the client context")
+	}
+
+	if clientCtx.GenerateOnly || clientCtx.Simulate || clientCtx.Offline {
+		return nil, errors.New("cannot broadcast transactions in generate-only, dry-run or offline mode")
+	}
+
+	s := m.signer(from)
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		if err := m.sync(clientCtx, from, s, false); err != nil {
+			return nil, err
+		}
+
+		res, err := m.broadcastTx(clientCtx, txf.WithAccountNumber(s.accountNumber).WithSequence(s.sequence), msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		// A transaction which passed CheckTx consumed its sequence, even if it
+		// then failed in DeliverTx.
+		if res.Code == 0 || res.Height > 0 {
+			s.sequence++
+			return res, nil
+		}
+
+		if res.Codespace != sdkerrors.ErrWrongSequence.Codespace() || res.Code != sdkerrors.ErrWrongSequence.ABCICode() {
+			return res, nil
+		}
+
+		if attempt >= m.retries {
+			s.synced = false
+			return res, nil
+		}
+
+		// The account queried may lag behind the sequence expected by the
+		// mempool, so the sequence reported in the log is preferred.
+		if err := m.sync(clientCtx, from, s, true); err != nil {
+			return nil, err
+		}
+
+		if expected, ok := expectedSequence(res.RawLog); ok && expected > s.sequence {
+			s.sequence = expected
+		}
+	}
+}
+
+// broadcastTx builds, signs and broadcasts a transaction with the given
+// factory.
+func (m *SequenceManager) broadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txf, err := applyFeeStrategy(clientCtx, txf)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	if err := Sign(txf, clientCtx.GetFromName(), tx, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return clientCtx.BroadcastTx(txBytes)
+}
+
+// signer returns the sequence tracked for the given signer.
+func (m *SequenceManager) signer(addr sdk.AccAddress) *signerSequence {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	s, ok := m.signers[addr.String()]
+	if !ok {
+		s = &signerSequence{}
+		m.signers[addr.String()] = s
+	}
+
+	return s
+}
+
+// sync queries the account number and sequence of a signer if they are not
+// tracked yet or if force is set. The lock of the signer must be held.
+func (m *SequenceManager) sync(clientCtx client.Context, addr sdk.AccAddress, s *signerSequence, force bool) error {
+	if s.synced && !force {
+		return nil
+	}
+
+	accNum, seq, err := m.retriever.GetAccountNumberSequence(clientCtx, addr)
+	if err != nil {
+		return err
+	}
+
+	s.accountNumber, s.sequence, s.synced = accNum, seq, true
+
+	return nil
+}
+
+// expectedSequence parses the sequence expected by the node from the log of a
+// transaction rejected for a wrong sequence.
+func expectedSequence(log string) (uint64, bool) {
+	matches := expectedSequenceRegex.FindStringSubmatch(log)
+	if matches == nil {
+		return 0, false
+	}
+
+	seq, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return seq, true
+}
+
+// SignerPool broadcasts transactions in parallel from a pool of signer keys,
+// each transaction being signed by the first signer not busy with another
+// one. The sequences of the signers are tracked by a SequenceManager. A
+// SignerPool is safe for concurrent use.
+type SignerPool struct {
+	manager *SequenceManager
+	idle    chan client.Context
+}
+
+// NewSignerPool returns a pool of the given keys of the keyring of the client
+// context, broadcasting transactions through the given SequenceManager.
+func NewSignerPool(clientCtx client.Context, manager *SequenceManager, keyNames ...string) (*SignerPool, error) {
+	if len(keyNames) == 0 {
+		return nil, errors.New("no signer key given")
+	}
+
+	p := &SignerPool{
+		manager: manager,
+		idle:    make(chan client.Context, len(keyNames)),
+	}
+
+	for _, name := range keyNames {
+		fromAddr, fromName, _, err := client.GetFromFields(clientCtx.Keyring, name, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signer %s: %w", name, err)
+		}
+
+		p.idle <- clientCtx.WithFrom(name).WithFromAddress(fromAddr).WithFromName(fromName)
+	}
+
+	return p, nil
+}
+
+// Size returns the number of signers of the pool.
+func (p *SignerPool) Size() int {
+	return cap(p.idle)
+}
+
+// BroadcastTx waits for a signer of the pool to be idle, then builds the
+// messages it signs with buildMsgs and broadcasts them through the
+// SequenceManager of the pool.
+func (p *SignerPool) BroadcastTx(txf Factory, buildMsgs func(signer sdk.AccAddress) ([]sdk.Msg, error)) (*sdk.TxResponse, error) {
+	clientCtx := <-p.idle
+	defer func() { p.idle <- clientCtx }()
+
+	msgs, err := buildMsgs(clientCtx.GetFromAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	return p.manager.BroadcastTx(clientCtx, txf, msgs...)
+}
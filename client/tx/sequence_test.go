@@ -0,0 +1,246 @@
+package tx_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type SequenceManagerTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func (s *SequenceManagerTestSuite) SetupSuite() {
+	s.T().Log("setting up sequence manager test suite")
+
+	s.cfg = network.DefaultConfig()
+	s.cfg.NumValidators = 1
+
+	s.network = network.New(s.T(), s.cfg)
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	for _, name := range []string{"seq", "resync", "pool0", "pool1", "pool2"} {
+		info, _, err := val.ClientCtx.Keyring.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		s.Require().NoError(err)
+
+		out, err := bankcli.MsgSendExec(
+			val.ClientCtx,
+			val.Address,
+			info.GetAddress(),
+			sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000))),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		)
+		s.Require().NoError(err)
+
+		var res sdk.TxResponse
+		s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+		s.Require().Equal(uint32(0), res.Code, res.RawLog)
+	}
+}
+
+func (s *SequenceManagerTestSuite) TearDownSuite() {
+	s.T().Log("tearing down sequence manager test suite")
+	s.network.Cleanup()
+}
+
+// signerContext returns the client context of the validator signing with the
+// given key and broadcasting in sync mode.
+func (s *SequenceManagerTestSuite) signerContext(name string) client.Context {
+	val := s.network.Validators[0]
+
+	info, err := val.ClientCtx.Keyring.Key(name)
+	s.Require().NoError(err)
+
+	return val.ClientCtx.
+		WithFrom(name).
+		WithFromName(name).
+		WithFromAddress(info.GetAddress()).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithSkipConfirmation(true)
+}
+
+func (s *SequenceManagerTestSuite) txFactory() tx.Factory {
+	val := s.network.Validators[0]
+
+	return tx.Factory{}.
+		WithTxConfig(val.ClientCtx.TxConfig).
+		WithKeybase(val.ClientCtx.Keyring).
+		WithChainID(val.ClientCtx.ChainID).
+		WithGas(flags.DefaultGasLimit).
+		WithFees(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String())
+}
+
+func (s *SequenceManagerTestSuite) sendMsg(from sdk.AccAddress) sdk.Msg {
+	return banktypes.NewMsgSend(from, s.network.Validators[0].Address, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1))))
+}
+
+func (s *SequenceManagerTestSuite) TestBroadcastTx() {
+	clientCtx := s.signerContext("seq")
+	manager := tx.NewSequenceManager(clientCtx.AccountRetriever)
+
+	accNum, initSeq, err := manager.Sequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+
+	// Transactions broadcast back to back, without waiting for blocks, get
+	// consecutive sequences.
+	for i := 0; i < 5; i++ {
+		res, err := manager.BroadcastTx(clientCtx, s.txFactory(), s.sendMsg(clientCtx.GetFromAddress()))
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(0), res.Code, res.RawLog)
+	}
+
+	gotNum, gotSeq, err := manager.Sequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+	s.Require().Equal(accNum, gotNum)
+	s.Require().Equal(initSeq+5, gotSeq)
+
+	// A transaction failing CheckTx for another reason does not consume its
+	// sequence.
+	res, err := manager.BroadcastTx(clientCtx, s.txFactory().WithFees(""), s.sendMsg(clientCtx.GetFromAddress()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdkerrors.ErrInsufficientFee.ABCICode(), res.Code)
+
+	_, gotSeq, err = manager.Sequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+	s.Require().Equal(initSeq+5, gotSeq)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	_, chainSeq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+	s.Require().Equal(initSeq+5, chainSeq)
+}
+
+func (s *SequenceManagerTestSuite) TestBroadcastTxResync() {
+	val := s.network.Validators[0]
+	clientCtx := s.signerContext("resync")
+	manager := tx.NewSequenceManager(clientCtx.AccountRetriever)
+	noRetryManager := tx.NewSequenceManager(clientCtx.AccountRetriever).WithRetries(0)
+
+	_, initSeq, err := manager.Sequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+	_, _, err = noRetryManager.Sequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+
+	// Send a transaction from the same key behind the managers' back.
+	out, err := bankcli.MsgSendExec(
+		val.ClientCtx,
+		clientCtx.GetFromAddress(),
+		val.Address,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+	var outRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &outRes))
+	s.Require().Equal(uint32(0), outRes.Code, outRes.RawLog)
+
+	// Without retries, the wrong sequence is returned and resynced on the next
+	// transaction.
+	res, err := noRetryManager.BroadcastTx(clientCtx, s.txFactory(), s.sendMsg(clientCtx.GetFromAddress()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdkerrors.ErrWrongSequence.ABCICode(), res.Code)
+
+	_, seq, err := noRetryManager.Sequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+	s.Require().Equal(initSeq+1, seq)
+
+	// With retries, the transaction is rebroadcast with the right sequence.
+	res, err = manager.BroadcastTx(clientCtx, s.txFactory(), s.sendMsg(clientCtx.GetFromAddress()))
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), res.Code, res.RawLog)
+
+	_, seq, err = manager.Sequence(clientCtx, clientCtx.GetFromAddress())
+	s.Require().NoError(err)
+	s.Require().Equal(initSeq+2, seq)
+}
+
+func (s *SequenceManagerTestSuite) TestSignerPool() {
+	clientCtx := s.signerContext("pool0")
+	manager := tx.NewSequenceManager(clientCtx.AccountRetriever)
+
+	_, err := tx.NewSignerPool(clientCtx, manager)
+	s.Require().Error(err)
+	_, err = tx.NewSignerPool(clientCtx, manager, "unknown")
+	s.Require().Error(err)
+
+	pool, err := tx.NewSignerPool(clientCtx, manager, "pool0", "pool1", "pool2")
+	s.Require().NoError(err)
+	s.Require().Equal(3, pool.Size())
+
+	initSeqs := make(map[string]uint64)
+	for _, name := range []string{"pool0", "pool1", "pool2"} {
+		info, err := clientCtx.Keyring.Key(name)
+		s.Require().NoError(err)
+		_, seq, err := manager.Sequence(clientCtx, info.GetAddress())
+		s.Require().NoError(err)
+		initSeqs[info.GetAddress().String()] = seq
+	}
+
+	const txs = 12
+
+	var (
+		wg      sync.WaitGroup
+		mtx     sync.Mutex
+		signers = make(map[string]uint64)
+		errs    = make(chan error, txs)
+	)
+	for i := 0; i < txs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			res, err := pool.BroadcastTx(s.txFactory(), func(signer sdk.AccAddress) ([]sdk.Msg, error) {
+				mtx.Lock()
+				signers[signer.String()]++
+				mtx.Unlock()
+
+				return []sdk.Msg{s.sendMsg(signer)}, nil
+			})
+			if err == nil && res.Code != 0 {
+				err = fmt.Errorf("tx failed with code %d: %s", res.Code, res.RawLog)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.Require().NoError(err)
+	}
+
+	var total uint64
+	for addr, count := range signers {
+		_, seq, err := manager.Sequence(clientCtx, sdk.MustAccAddressFromBech32(addr))
+		s.Require().NoError(err)
+		s.Require().Equal(initSeqs[addr]+count, seq)
+		total += count
+	}
+	s.Require().Equal(uint64(txs), total)
+}
+
+func TestSequenceManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(SequenceManagerTestSuite))
+}
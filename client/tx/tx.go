@@ -69,6 +69,11 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
 	}
 
+	txf, err := applyFeeStrategy(clientCtx, txf)
+	if err != nil {
+		return err
+	}
+
 	tx, err := BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return err
@@ -105,6 +110,11 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return nil
 	}
 
+	txf, err = applyFeeStrategy(clientCtx, txf)
+	if err != nil {
+		return err
+	}
+
 	tx, err := BuildUnsignedTx(txf, msgs...)
 	if err != nil {
 		return err
@@ -251,6 +261,59 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// EstimateGasPrice returns the gas price suggested by the node's fee estimator
+// for the given fee strategy, in the denom fees were most often paid in by the
+// transactions of recent blocks.
+func EstimateGasPrice(clientCtx gogogrpc.ClientConn, feeStrategy string) (sdk.DecCoin, error) {
+	res, err := tx.NewFeeEstimatorClient(clientCtx).EstimateFee(context.Background(), &tx.EstimateFeeRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if len(res.Estimates) == 0 {
+		return sdk.DecCoin{}, fmt.Errorf("no fee was paid in the last %d blocks to estimate gas prices from, set the fees or gas prices", res.Blocks)
+	}
+
+	estimate := res.Estimates[0]
+	switch feeStrategy {
+	case flags.FeeStrategyLow:
+		return sdk.NewDecCoinFromDec(estimate.Denom, estimate.Low), nil
+	case flags.FeeStrategyMedium:
+		return sdk.NewDecCoinFromDec(estimate.Denom, estimate.Medium), nil
+	case flags.FeeStrategyHigh:
+		return sdk.NewDecCoinFromDec(estimate.Denom, estimate.High), nil
+	default:
+		return sdk.DecCoin{}, fmt.Errorf("invalid fee strategy %q, expected %s, %s or %s", feeStrategy,
+			flags.FeeStrategyLow, flags.FeeStrategyMedium, flags.FeeStrategyHigh)
+	}
+}
+
+// applyFeeStrategy sets the gas prices of the provided Factory to the gas price
+// suggested by the node for its fee strategy, if any. A new Factory with the
+// updated gas prices will be returned.
+func applyFeeStrategy(clientCtx client.Context, txf Factory) (Factory, error) {
+	if txf.feeStrategy == "" {
+		return txf, nil
+	}
+
+	if clientCtx.Offline {
+		return txf, errors.New("cannot estimate gas prices in offline mode")
+	}
+
+	if !txf.fees.IsZero() || !txf.gasPrices.IsZero() {
+		return txf, errors.New("cannot provide a fee strategy along with fees or gas prices")
+	}
+
+	gasPrice, err := EstimateGasPrice(clientCtx, txf.feeStrategy)
+	if err != nil {
+		return txf, err
+	}
+
+	txf.gasPrices = sdk.NewDecCoins(gasPrice)
+
+	return txf, nil
+}
+
 // prepareFactory ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
 // they will be queried for and set on the provided Factory. A new Factory with
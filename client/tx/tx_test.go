@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -95,6 +96,60 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+// mockFeeEstimatorContext is a mock client.Context returning the given fee
+// estimates, used to unit test EstimateGasPrice.
+type mockFeeEstimatorContext struct {
+	estimates []txtypes.GasPriceEstimate
+}
+
+func (m mockFeeEstimatorContext) Invoke(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) (err error) {
+	*(reply.(*txtypes.EstimateFeeResponse)) = txtypes.EstimateFeeResponse{
+		Height:    10,
+		Blocks:    10,
+		Estimates: m.estimates,
+	}
+
+	return nil
+}
+
+func (mockFeeEstimatorContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestEstimateGasPrice(t *testing.T) {
+	estimates := []txtypes.GasPriceEstimate{
+		{Denom: "stake", Low: sdk.NewDecWithPrec(1, 2), Medium: sdk.NewDecWithPrec(2, 2), High: sdk.NewDecWithPrec(5, 2), Samples: 10},
+		{Denom: "atom", Low: sdk.NewDecWithPrec(1, 3), Medium: sdk.NewDecWithPrec(1, 3), High: sdk.NewDecWithPrec(1, 3), Samples: 1},
+	}
+
+	testCases := []struct {
+		name        string
+		estimates   []txtypes.GasPriceEstimate
+		feeStrategy string
+		expGasPrice sdk.DecCoin
+		expPass     bool
+	}{
+		{"low", estimates, flags.FeeStrategyLow, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)), true},
+		{"medium", estimates, flags.FeeStrategyMedium, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(2, 2)), true},
+		{"high", estimates, flags.FeeStrategyHigh, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 2)), true},
+		{"invalid strategy", estimates, "cheapest", sdk.DecCoin{}, false},
+		{"no estimates", nil, flags.FeeStrategyMedium, sdk.DecCoin{}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gasPrice, err := tx.EstimateGasPrice(mockFeeEstimatorContext{estimates: tc.estimates}, tc.feeStrategy)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expGasPrice, gasPrice)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestBuildSimTx(t *testing.T) {
 	txCfg := NewTestTxConfig()
 
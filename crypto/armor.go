@@ -4,7 +4,6 @@ import (
 	"encoding/hex"
 	"fmt"
 
-	"github.com/tendermint/crypto/bcrypt"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/armor"
 	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
@@ -18,6 +17,7 @@ const (
 	blockTypePrivKey = "TENDERMINT PRIVATE KEY"
 	blockTypeKeyInfo = "TENDERMINT KEY INFO"
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
+	blockTypeBackup  = "TENDERMINT KEYRING BACKUP"
 
 	defaultAlgo = "secp256k1"
 
@@ -125,35 +125,34 @@ func unarmorBytes(armorStr, blockType string) (bz []byte, header map[string]stri
 
 // Encrypt and armor the private key.
 func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase string, algo string) string {
-	saltBytes, encBytes := encryptPrivKey(privKey, passphrase)
-	header := map[string]string{
-		"kdf":  "bcrypt",
-		"salt": fmt.Sprintf("%X", saltBytes),
-	}
+	return EncryptArmorPrivKeyWithKDF(privKey, passphrase, algo, BcryptKDFParams())
+}
 
+// EncryptArmorPrivKeyWithKDF encrypts and armors the private key with a key
+// derived from the passphrase by the given key derivation function.
+func EncryptArmorPrivKeyWithKDF(privKey cryptotypes.PrivKey, passphrase string, algo string, params KDFParams) string {
+	header, encBytes := encryptBytes(legacy.Cdc.MustMarshal(privKey), passphrase, params)
 	if algo != "" {
 		header[headerType] = algo
 	}
 
-	armorStr := armor.EncodeArmor(blockTypePrivKey, header, encBytes)
-
-	return armorStr
+	return armor.EncodeArmor(blockTypePrivKey, header, encBytes)
 }
 
-// encrypt the given privKey with the passphrase using a randomly
-// generated salt and the xsalsa20 cipher. returns the salt and the
-// encrypted priv key.
-func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes []byte, encBytes []byte) {
-	saltBytes = crypto.CRandBytes(16)
-	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+// encryptBytes encrypts the given bytes with the passphrase using a randomly
+// generated salt and the xsalsa20 cipher. returns the armor header of the key
+// derivation and the encrypted bytes.
+func encryptBytes(bz []byte, passphrase string, params KDFParams) (header map[string]string, encBytes []byte) {
+	saltBytes := crypto.CRandBytes(16)
+	key, err := params.deriveKey(saltBytes, passphrase)
 	if err != nil {
-		panic(sdkerrors.Wrap(err, "error generating bcrypt key from passphrase"))
+		panic(sdkerrors.Wrapf(err, "error generating %s key from passphrase", params.KDF))
 	}
 
-	key = crypto.Sha256(key) // get 32 bytes
-	privKeyBytes := legacy.Cdc.MustMarshal(privKey)
+	header = params.header()
+	header["salt"] = fmt.Sprintf("%X", saltBytes)
 
-	return saltBytes, xsalsa20symmetric.EncryptSymmetric(privKeyBytes, key)
+	return header, xsalsa20symmetric.EncryptSymmetric(bz, key)
 }
 
 // UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
@@ -167,20 +166,12 @@ func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey cryptoty
 		return privKey, "", fmt.Errorf("unrecognized armor type: %v", blockType)
 	}
 
-	if header["kdf"] != "bcrypt" {
-		return privKey, "", fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
-	}
-
-	if header["salt"] == "" {
-		return privKey, "", fmt.Errorf("missing salt bytes")
-	}
-
-	saltBytes, err := hex.DecodeString(header["salt"])
+	privKeyBytes, err := decryptBytes(header, encBytes, passphrase)
 	if err != nil {
-		return privKey, "", fmt.Errorf("error decoding salt: %v", err.Error())
+		return privKey, "", err
 	}
 
-	privKey, err = decryptPrivKey(saltBytes, encBytes, passphrase)
+	privKey, err = legacy.PrivKeyFromBytes(privKeyBytes)
 
 	if header[headerType] == "" {
 		header[headerType] = defaultAlgo
@@ -189,20 +180,58 @@ func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey cryptoty
 	return privKey, header[headerType], err
 }
 
-func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privKey cryptotypes.PrivKey, err error) {
-	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+// decryptBytes decrypts the bytes encrypted by encryptBytes with the given
+// armor header.
+func decryptBytes(header map[string]string, encBytes []byte, passphrase string) ([]byte, error) {
+	params, err := kdfParamsFromHeader(header)
 	if err != nil {
-		return privKey, sdkerrors.Wrap(err, "error generating bcrypt key from passphrase")
+		return nil, err
 	}
 
-	key = crypto.Sha256(key) // Get 32 bytes
+	if header["salt"] == "" {
+		return nil, fmt.Errorf("missing salt bytes")
+	}
+
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding salt: %v", err.Error())
+	}
 
-	privKeyBytes, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	key, err := params.deriveKey(saltBytes, passphrase)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "error generating %s key from passphrase", params.KDF)
+	}
+
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
 	if err != nil && err.Error() == "Ciphertext decryption failed" {
-		return privKey, sdkerrors.ErrWrongPassword
+		return nil, sdkerrors.ErrWrongPassword
 	} else if err != nil {
-		return privKey, err
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// EncryptArmorBackup encrypts and armors a keyring backup archive with a key
+// derived from the passphrase by the given key derivation function.
+func EncryptArmorBackup(bz []byte, passphrase string, params KDFParams) string {
+	header, encBytes := encryptBytes(bz, passphrase, params)
+	header[headerVersion] = "0.0.1"
+
+	return armor.EncodeArmor(blockTypeBackup, header, encBytes)
+}
+
+// UnarmorDecryptBackup returns the keyring backup archive armored by
+// EncryptArmorBackup.
+func UnarmorDecryptBackup(armorStr string, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	if header[headerVersion] != "0.0.1" {
+		return nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
 	}
 
-	return legacy.PrivKeyFromBytes(privKeyBytes)
+	return decryptBytes(header, encBytes, passphrase)
 }
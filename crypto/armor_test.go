@@ -172,3 +172,46 @@ func BenchmarkBcryptGenerateFromPassword(b *testing.B) {
 		})
 	}
 }
+
+func TestArmorUnarmorPrivKeyWithKDF(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+
+	for _, params := range []crypto.KDFParams{
+		crypto.BcryptKDFParams(),
+		{KDF: crypto.KDFArgon2id, Time: 1, Memory: 64, Threads: 1},
+		{KDF: crypto.KDFScrypt, N: 1 << 10, R: 8, P: 1},
+	} {
+		armored := crypto.EncryptArmorPrivKeyWithKDF(priv, "passphrase", "", params)
+		_, _, err := crypto.UnarmorDecryptPrivKey(armored, "wrongpassphrase")
+		require.Error(t, err, params.KDF)
+		decrypted, algo, err := crypto.UnarmorDecryptPrivKey(armored, "passphrase")
+		require.NoError(t, err, params.KDF)
+		require.Equal(t, string(hd.Secp256k1Type), algo)
+		require.True(t, priv.Equals(decrypted))
+	}
+
+	// costs out of bounds are rejected before deriving the key
+	saltBytes := tmcrypto.CRandBytes(16)
+	for _, header := range []map[string]string{
+		{"kdf": "argon2id", "time": "1", "memory": "4294967295", "threads": "1"},
+		{"kdf": "argon2id", "time": "0", "memory": "64", "threads": "1"},
+		{"kdf": "argon2id", "time": "1", "memory": "64"},
+		{"kdf": "scrypt", "n": "1000", "r": "8", "p": "1"},
+		{"kdf": "scrypt", "n": "1073741824", "r": "8", "p": "1"},
+	} {
+		header["salt"] = fmt.Sprintf("%X", saltBytes)
+		armored := armor.EncodeArmor("TENDERMINT PRIVATE KEY", header, []byte("encrypted"))
+		_, _, err := crypto.UnarmorDecryptPrivKey(armored, "passphrase")
+		require.Error(t, err, header)
+	}
+}
+
+func TestKDFParamsValidate(t *testing.T) {
+	require.NoError(t, crypto.BcryptKDFParams().Validate())
+	require.NoError(t, crypto.DefaultArgon2idParams().Validate())
+	require.NoError(t, crypto.DefaultScryptParams().Validate())
+
+	require.Error(t, crypto.KDFParams{KDF: "wrong"}.Validate())
+	require.Error(t, crypto.KDFParams{KDF: crypto.KDFArgon2id, Time: 1, Memory: 4, Threads: 1}.Validate())
+	require.Error(t, crypto.KDFParams{KDF: crypto.KDFScrypt, N: 1 << 10, R: 0, P: 1}.Validate())
+}
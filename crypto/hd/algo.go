@@ -3,6 +3,7 @@ package hd
 import (
 	bip39 "github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -20,10 +21,16 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Bls12381Type represents the BLS signature scheme over the BLS12-381 curve.
+	Bls12381Type = PubKeyType("bls12_381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Bls12381 uses BLS signatures over the BLS12-381 curve.
+	Bls12381 = bls12381Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -68,3 +75,38 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type bls12381Algo struct{}
+
+func (s bls12381Algo) Name() PubKeyType {
+	return Bls12381Type
+}
+
+// Derive derives and returns the BLS12-381 private key for the given seed and HD path.
+// The key derived for the HD path as a secp256k1 key is the secret of the KeyGen
+// procedure of the IETF BLS signature draft.
+func (s bls12381Algo) Derive() DeriveFn {
+	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+		secret, err := Secp256k1.Derive()(mnemonic, bip39Passphrase, hdPath)
+		if err != nil {
+			return nil, err
+		}
+
+		privKey, err := bls12381.GenPrivKeyFromSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return privKey.Key, nil
+	}
+}
+
+// Generate generates a BLS12-381 private key from the given bytes.
+func (s bls12381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, bls12381.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &bls12381.PrivKey{Key: bzArr}
+	}
+}
@@ -0,0 +1,200 @@
+package crypto
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/crypto/bcrypt"
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Key derivation functions deriving the keys encrypting armored private keys
+// from their passphrase.
+const (
+	KDFBcrypt   = "bcrypt"
+	KDFArgon2id = "argon2id"
+	KDFScrypt   = "scrypt"
+)
+
+const (
+	headerKDF = "kdf"
+
+	// armor headers of the Argon2id costs
+	headerArgon2Time    = "time"
+	headerArgon2Memory  = "memory"
+	headerArgon2Threads = "threads"
+
+	// armor headers of the scrypt costs
+	headerScryptN = "n"
+	headerScryptR = "r"
+	headerScryptP = "p"
+
+	// kdfKeyLen is the length of the keys of the xsalsa20 cipher.
+	kdfKeyLen = 32
+
+	// maxKDFMemory bounds the memory, in KiB, the costs read from an armor
+	// header may require, so that importing a crafted armor cannot exhaust the
+	// memory of the host.
+	maxKDFMemory = 4 * 1024 * 1024
+)
+
+// KDFParams are a key derivation function and its costs. The costs of
+// bcrypt are set by BcryptSecurityParameter.
+type KDFParams struct {
+	// KDF is the key derivation function, one of KDFBcrypt, KDFArgon2id and
+	// KDFScrypt.
+	KDF string
+
+	// Time is the number of passes of Argon2id over the memory.
+	Time uint32
+	// Memory is the memory used by Argon2id, in KiB.
+	Memory uint32
+	// Threads is the number of threads of Argon2id.
+	Threads uint8
+
+	// N is the CPU and memory cost of scrypt, a power of two.
+	N int
+	// R is the block size of scrypt.
+	R int
+	// P is the parallelization of scrypt.
+	P int
+}
+
+// BcryptKDFParams returns the parameters of the bcrypt key derivation, the
+// default one of armored private keys.
+func BcryptKDFParams() KDFParams {
+	return KDFParams{KDF: KDFBcrypt}
+}
+
+// DefaultArgon2idParams returns the parameters of the Argon2id key derivation
+// recommended by RFC 9106 for memory constrained environments.
+func DefaultArgon2idParams() KDFParams {
+	return KDFParams{KDF: KDFArgon2id, Time: 3, Memory: 64 * 1024, Threads: 4}
+}
+
+// DefaultScryptParams returns the parameters of the scrypt key derivation
+// recommended for interactive logins.
+func DefaultScryptParams() KDFParams {
+	return KDFParams{KDF: KDFScrypt, N: 1 << 15, R: 8, P: 1}
+}
+
+// Validate returns an error if the costs of the key derivation are invalid or
+// require too much memory.
+func (p KDFParams) Validate() error {
+	switch p.KDF {
+	case KDFBcrypt:
+		return nil
+
+	case KDFArgon2id:
+		if p.Time == 0 || p.Threads == 0 {
+			return fmt.Errorf("argon2id time and threads must be positive")
+		}
+		if p.Memory < 8*uint32(p.Threads) || p.Memory > maxKDFMemory {
+			return fmt.Errorf("argon2id memory must be between %d and %d KiB", 8*uint32(p.Threads), maxKDFMemory)
+		}
+
+		return nil
+
+	case KDFScrypt:
+		if p.N <= 1 || p.N&(p.N-1) != 0 {
+			return fmt.Errorf("scrypt N must be a power of two greater than 1")
+		}
+		if p.R <= 0 || p.P <= 0 {
+			return fmt.Errorf("scrypt r and p must be positive")
+		}
+		if uint64(p.N)*uint64(p.R)/8 > maxKDFMemory {
+			return fmt.Errorf("scrypt N * r must not require more than %d KiB", maxKDFMemory)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unrecognized KDF type: %v", p.KDF)
+	}
+}
+
+// deriveKey derives the key of the xsalsa20 cipher from the passphrase.
+func (p KDFParams) deriveKey(saltBytes []byte, passphrase string) ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch p.KDF {
+	case KDFArgon2id:
+		return argon2.IDKey([]byte(passphrase), saltBytes, p.Time, p.Memory, p.Threads, kdfKeyLen), nil
+
+	case KDFScrypt:
+		return scrypt.Key([]byte(passphrase), saltBytes, p.N, p.R, p.P, kdfKeyLen)
+
+	default:
+		key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+		if err != nil {
+			return nil, err
+		}
+
+		return crypto.Sha256(key), nil // get 32 bytes
+	}
+}
+
+// header returns the armor header of the key derivation.
+func (p KDFParams) header() map[string]string {
+	header := map[string]string{headerKDF: p.KDF}
+
+	switch p.KDF {
+	case KDFArgon2id:
+		header[headerArgon2Time] = strconv.FormatUint(uint64(p.Time), 10)
+		header[headerArgon2Memory] = strconv.FormatUint(uint64(p.Memory), 10)
+		header[headerArgon2Threads] = strconv.FormatUint(uint64(p.Threads), 10)
+
+	case KDFScrypt:
+		header[headerScryptN] = strconv.Itoa(p.N)
+		header[headerScryptR] = strconv.Itoa(p.R)
+		header[headerScryptP] = strconv.Itoa(p.P)
+	}
+
+	return header
+}
+
+// kdfParamsFromHeader returns the key derivation of an armor header.
+func kdfParamsFromHeader(header map[string]string) (KDFParams, error) {
+	p := KDFParams{KDF: header[headerKDF]}
+
+	var err error
+	parseUint := func(key string, bitSize int) uint64 {
+		if err != nil {
+			return 0
+		}
+
+		var v uint64
+		if v, err = strconv.ParseUint(header[key], 10, bitSize); err != nil {
+			err = fmt.Errorf("invalid %s %s: %w", p.KDF, key, err)
+		}
+
+		return v
+	}
+
+	switch p.KDF {
+	case KDFBcrypt:
+
+	case KDFArgon2id:
+		p.Time = uint32(parseUint(headerArgon2Time, 32))
+		p.Memory = uint32(parseUint(headerArgon2Memory, 32))
+		p.Threads = uint8(parseUint(headerArgon2Threads, 8))
+
+	case KDFScrypt:
+		p.N = int(parseUint(headerScryptN, 31))
+		p.R = int(parseUint(headerScryptR, 31))
+		p.P = int(parseUint(headerScryptP, 31))
+
+	default:
+		return p, fmt.Errorf("unrecognized KDF type: %v", p.KDF)
+	}
+
+	if err != nil {
+		return p, err
+	}
+
+	return p, p.Validate()
+}
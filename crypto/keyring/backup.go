@@ -0,0 +1,126 @@
+package keyring
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+// keyringBackup is the archive of the keys of a keyring written by Backup,
+// holding the serialized Info of each key as in the keyring.
+type keyringBackup struct {
+	Keys [][]byte `json:"keys"`
+}
+
+// Backup returns all keys of the keyring, including the private keys of the
+// local keys and the references to Ledger, offline and multisig keys, as an
+// ASCII armored archive encrypted with a key derived from the given passphrase
+// by the given key derivation function.
+func Backup(kr Keyring, passphrase string, kdf crypto.KDFParams) (string, error) {
+	infos, err := kr.List()
+	if err != nil {
+		return "", err
+	}
+
+	var backup keyringBackup
+	for _, info := range infos {
+		if err := checkPortableInfo(info); err != nil {
+			return "", err
+		}
+
+		backup.Keys = append(backup.Keys, marshalInfo(info))
+	}
+
+	bz, err := legacy.Cdc.Marshal(backup)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptArmorBackup(bz, passphrase, kdf), nil
+}
+
+// Restore imports all keys of an archive written by Backup into the keyring
+// and returns them. No key is imported if the name or address of any key of
+// the archive is already in the keyring.
+func Restore(kr Keyring, armor, passphrase string) ([]Info, error) {
+	bz, err := crypto.UnarmorDecryptBackup(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var backup keyringBackup
+	if err := legacy.Cdc.Unmarshal(bz, &backup); err != nil {
+		return nil, fmt.Errorf("invalid keyring backup: %w", err)
+	}
+
+	infos := make([]Info, len(backup.Keys))
+	for i, bz := range backup.Keys {
+		if infos[i], err = unmarshalInfo(bz); err != nil {
+			return nil, fmt.Errorf("invalid keyring backup: %w", err)
+		}
+	}
+
+	return importInfos(kr, infos)
+}
+
+// MigrateBackend copies all keys of a keyring, including the private keys of
+// the local keys and the references to Ledger, offline and multisig keys, into
+// another keyring, e.g. of another backend, and returns them. The keys are
+// left in the source keyring. No key is copied if the name or address of any
+// key is already in the destination keyring.
+func MigrateBackend(from, to Keyring) ([]Info, error) {
+	infos, err := from.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if err := checkPortableInfo(info); err != nil {
+			return nil, err
+		}
+	}
+
+	return importInfos(to, infos)
+}
+
+// importInfos writes the given keys into the keyring, after checking that
+// none of them conflicts with a key of the keyring.
+func importInfos(kr Keyring, infos []Info) ([]Info, error) {
+	importer, ok := kr.(LegacyInfoImporter)
+	if !ok {
+		return nil, fmt.Errorf("the keyring does not support importing keys")
+	}
+
+	var conflicts []string
+	for _, info := range infos {
+		if _, err := kr.Key(info.GetName()); err == nil {
+			conflicts = append(conflicts, info.GetName())
+		} else if _, err := kr.KeyByAddress(info.GetAddress()); err == nil {
+			conflicts = append(conflicts, info.GetName())
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("keys already in the keyring: %s", strings.Join(conflicts, ", "))
+	}
+
+	for _, info := range infos {
+		if err := importer.ImportInfo(info); err != nil {
+			return nil, fmt.Errorf("failed to import key %s: %w", info.GetName(), err)
+		}
+	}
+
+	return infos, nil
+}
+
+// checkPortableInfo returns an error if a key cannot be copied out of its
+// keyring.
+func checkPortableInfo(info Info) error {
+	switch info.(type) {
+	case localInfo, *localInfo, ledgerInfo, *ledgerInfo, offlineInfo, *offlineInfo, multiInfo, *multiInfo:
+		return nil
+	default:
+		return fmt.Errorf("key %s of type %T cannot be copied out of its keyring", info.GetName(), info)
+	}
+}
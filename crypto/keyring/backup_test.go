@@ -0,0 +1,113 @@
+package keyring
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newBackupTestKeyring returns a keyring holding a local, a Ledger, an
+// offline and a multisig key.
+func newBackupTestKeyring(t *testing.T) Keyring {
+	kr := NewInMemory()
+
+	local, _, err := kr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	ledgerPub := secp256k1.GenPrivKey().PubKey()
+	require.NoError(t, kr.(LegacyInfoImporter).ImportInfo(newLedgerInfo("ledger", ledgerPub, *hd.NewFundraiserParams(0, sdk.CoinType, 1), hd.Secp256k1Type)))
+
+	_, err = kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	_, err = kr.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []types.PubKey{local.GetPubKey(), ledgerPub}))
+	require.NoError(t, err)
+
+	return kr
+}
+
+// requireSameKeys checks that the keys of both keyrings are the same.
+func requireSameKeys(t *testing.T, expected, actual Keyring) {
+	expectedInfos, err := expected.List()
+	require.NoError(t, err)
+	actualInfos, err := actual.List()
+	require.NoError(t, err)
+	require.Len(t, actualInfos, len(expectedInfos))
+
+	for _, info := range expectedInfos {
+		got, err := actual.Key(info.GetName())
+		require.NoError(t, err)
+		require.Equal(t, info.GetType(), got.GetType())
+		require.Equal(t, info.GetAddress(), got.GetAddress())
+		require.Equal(t, info.GetAlgo(), got.GetAlgo())
+
+		path, err := info.GetPath()
+		gotPath, gotErr := got.GetPath()
+		require.Equal(t, err == nil, gotErr == nil)
+		require.Equal(t, path, gotPath)
+	}
+
+	// The private keys of the local keys are restored.
+	msg := []byte("message")
+	sig, pub, err := actual.Sign("local", msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+}
+
+func TestBackupRestore(t *testing.T) {
+	kr := newBackupTestKeyring(t)
+
+	armor, err := Backup(kr, "passphrase", crypto.DefaultArgon2idParams())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(armor, "-----BEGIN TENDERMINT KEYRING BACKUP-----"))
+
+	restored := NewInMemory()
+	_, err = Restore(restored, armor, "wrong")
+	require.Error(t, err)
+
+	infos, err := Restore(restored, armor, "passphrase")
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+	requireSameKeys(t, kr, restored)
+
+	// Nothing is restored into a keyring already holding one of the keys.
+	conflicting := NewInMemory()
+	_, err = conflicting.SavePubKey("other", infos[0].GetPubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	_, err = Restore(conflicting, armor, "passphrase")
+	require.Error(t, err)
+	list, err := conflicting.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	_, err = Restore(NewInMemory(), "not an archive", "passphrase")
+	require.Error(t, err)
+}
+
+func TestMigrateBackend(t *testing.T) {
+	from := newBackupTestKeyring(t)
+
+	to, err := New("cosmos", BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	infos, err := MigrateBackend(from, to)
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+	requireSameKeys(t, from, to)
+
+	// The keys are left in the source keyring.
+	list, err := from.List()
+	require.NoError(t, err)
+	require.Len(t, list, 4)
+
+	_, err = MigrateBackend(from, to)
+	require.Error(t, err)
+}
@@ -43,4 +43,9 @@
 //			be unlocked and it should be use only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //			are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend forwards key listing and signing over gRPC with mutual TLS to a remote
+//			signer, such as the one served by the keys serve-signer command, so that the private
+//			keys never touch the client host. It is configured by the keyring-remote/signer.json
+//			file of the keyring directory, holding the address of the signer and the paths of
+//			the CA bundle, certificate and key of the client. Keys are managed on the signer.
 package keyring
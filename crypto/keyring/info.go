@@ -6,8 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -196,8 +196,8 @@ type multiInfo struct {
 
 // NewMultiInfo creates a new multiInfo instance
 func NewMultiInfo(name string, pub cryptotypes.PubKey) (Info, error) {
-	if _, ok := pub.(*multisig.LegacyAminoPubKey); !ok {
-		return nil, fmt.Errorf("MultiInfo supports only multisig.LegacyAminoPubKey, got  %T", pub)
+	if _, ok := pub.(multisig.PubKey); !ok {
+		return nil, fmt.Errorf("MultiInfo supports only multisig.PubKey, got  %T", pub)
 	}
 	return &multiInfo{
 		Name:   name,
@@ -237,9 +237,7 @@ func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (i multiInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	multiPK := i.PubKey.(*multisig.LegacyAminoPubKey)
-
-	return codectypes.UnpackInterfaces(multiPK, unpacker)
+	return codectypes.UnpackInterfaces(i.PubKey, unpacker)
 }
 
 // encoding info
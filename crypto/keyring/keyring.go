@@ -34,6 +34,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -43,8 +44,9 @@ const (
 )
 
 var (
-	_                          Keyring = &keystore{}
-	maxPassphraseEntryAttempts         = 3
+	_                          Keyring     = &keystore{}
+	_                          KDFExporter = &keystore{}
+	maxPassphraseEntryAttempts             = 3
 )
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
@@ -134,6 +136,15 @@ type Exporter interface {
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
 }
 
+// KDFExporter is implemented by key stores that support the export of private
+// keys armored with a chosen key derivation function.
+type KDFExporter interface {
+	// ExportPrivKeyArmorWithKDF returns a private key in ASCII armored format,
+	// encrypted with a key derived from the passphrase by the given key
+	// derivation function.
+	ExportPrivKeyArmorWithKDF(uid, encryptPassphrase string, kdf crypto.KDFParams) (armor string, err error)
+}
+
 // UnsafeExporter is implemented by key stores that support unsafe export
 // of private keys' material.
 type UnsafeExporter interface {
@@ -161,7 +172,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -183,6 +194,8 @@ func New(
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendPass:
 		db, err = keyring.Open(newPassBackendKeyringConfig(appName, rootDir, userInput))
+	case BackendRemote:
+		return newRemoteFromDir(rootDir, opts...)
 	default:
 		return nil, fmt.Errorf("unknown keyring backend %v", backend)
 	}
@@ -200,9 +213,14 @@ type keystore struct {
 }
 
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
+	return keystore{kr, newOptions(opts...)}
+}
+
+// newOptions returns the default keyring options overridden by opts.
+func newOptions(opts ...Option) Options {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Bls12381},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -210,7 +228,7 @@ func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 		optionFn(&options)
 	}
 
-	return keystore{kr, options}
+	return options
 }
 
 func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
@@ -236,6 +254,15 @@ func (ks keystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, erro
 }
 
 func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error) {
+	return ks.ExportPrivKeyArmorWithKDF(uid, encryptPassphrase, crypto.BcryptKDFParams())
+}
+
+// ExportPrivKeyArmorWithKDF implements KDFExporter.
+func (ks keystore) ExportPrivKeyArmorWithKDF(uid, encryptPassphrase string, kdf crypto.KDFParams) (armor string, err error) {
+	if err := kdf.Validate(); err != nil {
+		return "", err
+	}
+
 	priv, err := ks.ExportPrivateKeyObject(uid)
 	if err != nil {
 		return "", err
@@ -246,7 +273,7 @@ func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor stri
 		return "", err
 	}
 
-	return crypto.EncryptArmorPrivKey(priv, encryptPassphrase, string(info.GetAlgo())), nil
+	return crypto.EncryptArmorPrivKeyWithKDF(priv, encryptPassphrase, string(info.GetAlgo()), kdf), nil
 }
 
 // ExportPrivateKeyObject exports an armored private key object.
@@ -745,14 +772,7 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 				continue
 			}
 
-			saltBytes := tmcrypto.CRandBytes(16)
-			passwordHash, err := bcrypt.GenerateFromPassword(saltBytes, []byte(pass), 2)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err)
-				continue
-			}
-
-			if err := os.WriteFile(dir+"/keyhash", passwordHash, 0o555); err != nil {
+			if err := writeKeyhash(dir, pass); err != nil {
 				return "", err
 			}
 
@@ -761,6 +781,113 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 	}
 }
 
+// writeKeyhash writes the hash of the passphrase of the keyring of the given
+// directory, against which the passphrase is checked when prompted.
+func writeKeyhash(dir, pass string) error {
+	saltBytes := tmcrypto.CRandBytes(16)
+	passwordHash, err := bcrypt.GenerateFromPassword(saltBytes, []byte(pass), 2)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "keyhash"), passwordHash, 0o555)
+}
+
+// ChangeFilePassphrase re-encrypts all items of the file keyring backend of
+// the given directory with a new passphrase. The keyring is rewritten in a new
+// directory which then replaces the current one, so that the keyring is left
+// untouched on failure.
+func ChangeFilePassphrase(appName, rootDir, oldPassphrase, newPassphrase string) error {
+	if newPassphrase == "" {
+		return errors.New("the new passphrase cannot be empty")
+	}
+
+	fileDir := filepath.Join(rootDir, keyringFileDirName)
+
+	keyhash, err := os.ReadFile(filepath.Join(fileDir, "keyhash"))
+	if err != nil {
+		return fmt.Errorf("failed to read the passphrase hash of the keyring: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword(keyhash, []byte(oldPassphrase)); err != nil {
+		return sdkerrors.ErrWrongPassword
+	}
+
+	oldDB, err := keyring.Open(newFixedPassphraseKeyringConfig(appName, fileDir, oldPassphrase))
+	if err != nil {
+		return err
+	}
+
+	keys, err := oldDB.Keys()
+	if err != nil {
+		return err
+	}
+
+	newDir := fileDir + ".new"
+	if err := os.RemoveAll(newDir); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(newDir, 0o700); err != nil {
+		return err
+	}
+
+	newDB, err := keyring.Open(newFixedPassphraseKeyringConfig(appName, newDir, newPassphrase))
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		// the passphrase hash is stored next to the items without encryption
+		if key == "keyhash" {
+			continue
+		}
+
+		item, err := oldDB.Get(key)
+		if err != nil {
+			_ = os.RemoveAll(newDir)
+			return fmt.Errorf("failed to decrypt %s: %w", key, err)
+		}
+
+		if err := newDB.Set(item); err != nil {
+			_ = os.RemoveAll(newDir)
+			return err
+		}
+	}
+
+	if err := writeKeyhash(newDir, newPassphrase); err != nil {
+		_ = os.RemoveAll(newDir)
+		return err
+	}
+
+	oldDir := fileDir + ".old"
+	if err := os.RemoveAll(oldDir); err != nil {
+		return err
+	}
+
+	if err := os.Rename(fileDir, oldDir); err != nil {
+		return err
+	}
+
+	if err := os.Rename(newDir, fileDir); err != nil {
+		_ = os.Rename(oldDir, fileDir)
+		return err
+	}
+
+	return os.RemoveAll(oldDir)
+}
+
+func newFixedPassphraseKeyringConfig(appName, dir, passphrase string) keyring.Config {
+	return keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
+		ServiceName:     appName,
+		FileDir:         dir,
+		FilePasswordFunc: func(_ string) (string, error) {
+			return passphrase, nil
+		},
+	}
+}
+
 func (ks keystore) writeLocalKey(name string, priv types.PrivKey, algo hd.PubKeyType) (Info, error) {
 	// encrypt private key using keyring
 	pub := priv.PubKey()
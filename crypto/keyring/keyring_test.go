@@ -3,6 +3,8 @@ package keyring
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -47,6 +50,49 @@ func TestNewKeyring(t *testing.T) {
 	require.Equal(t, "foo", info.GetName())
 }
 
+func TestChangeFilePassphrase(t *testing.T) {
+	dir := t.TempDir()
+
+	kr, err := New("cosmos", BackendFile, dir, strings.NewReader("password\npassword\n"))
+	require.NoError(t, err)
+	info, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, ChangeFilePassphrase("cosmos", dir, "wrong", "newpassword"), sdkerrors.ErrWrongPassword)
+	require.Error(t, ChangeFilePassphrase("cosmos", dir, "password", ""))
+	require.NoError(t, ChangeFilePassphrase("cosmos", dir, "password", "newpassword"))
+
+	// The keys are only decrypted with the new passphrase.
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("password\npassword\npassword\n"))
+	require.NoError(t, err)
+	_, err = kr.Key("foo")
+	require.Error(t, err)
+
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("newpassword\n"))
+	require.NoError(t, err)
+	got, err := kr.Key("foo")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), got.GetAddress())
+
+	_, err = os.Stat(filepath.Join(dir, keyringFileDirName+".old"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestExportPrivKeyArmorWithKDF(t *testing.T) {
+	kr := NewInMemory()
+	info, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	armor, err := kr.(KDFExporter).ExportPrivKeyArmorWithKDF("foo", "passphrase", crypto.KDFParams{KDF: crypto.KDFScrypt, N: 1 << 10, R: 8, P: 1})
+	require.NoError(t, err)
+
+	imported := NewInMemory()
+	require.NoError(t, imported.ImportPrivKey("foo", armor, "passphrase"))
+	got, err := imported.Key("foo")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), got.GetAddress())
+}
+
 func TestKeyManagementKeyRing(t *testing.T) {
 	kb, err := New("keybasename", "test", t.TempDir(), nil)
 	require.NoError(t, err)
@@ -0,0 +1,454 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	keyringRemoteDirName       = "keyring-remote"
+	remoteSignerConfigFileName = "signer.json"
+
+	// remoteSignerTimeout bounds the calls to a remote signer. It is long
+	// enough for a signature to be confirmed on a Ledger device of the signer.
+	remoteSignerTimeout = 2 * time.Minute
+)
+
+var _ Keyring = remoteKeystore{}
+
+// RemoteSignerConfig is the configuration of the remote keyring backend. New
+// reads it from the signer.json file of the keyring-remote directory of the
+// keyring directory, in which relative paths are resolved against that
+// directory.
+type RemoteSignerConfig struct {
+	// Address is the <host>:<port> of the signer.
+	Address string `json:"address"`
+	// CAFile is the PEM bundle of the CAs the certificate of the signer is
+	// verified against.
+	CAFile string `json:"ca_file"`
+	// CertFile and KeyFile are the PEM certificate and key the client
+	// authenticates to the signer with.
+	CertFile string `json:"cert_file"`
+	KeyFile  string `json:"key_file"`
+	// ServerName is the name the certificate of the signer is verified for,
+	// the host of Address if empty.
+	ServerName string `json:"server_name,omitempty"`
+}
+
+// remoteKeystore is a keyring whose keys are held by a remote signer. It only
+// lists the keys of the signer and signs with them: the keys are managed on
+// the signer.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	options Options
+}
+
+// NewRemote returns a keyring forwarding key listing and signing to the
+// remote signer of the given configuration, over gRPC with mutual TLS.
+func NewRemote(cfg RemoteSignerConfig, opts ...Option) (Keyring, error) {
+	tlsCfg, err := cfg.clientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer %s: %w", cfg.Address, err)
+	}
+
+	return remoteKeystore{
+		client:  NewRemoteSignerClient(conn),
+		options: newOptions(opts...),
+	}, nil
+}
+
+// newRemoteFromDir returns a remote keyring configured by the signer.json file
+// of the keyring-remote directory of the given keyring directory.
+func newRemoteFromDir(rootDir string, opts ...Option) (Keyring, error) {
+	dir := filepath.Join(rootDir, keyringRemoteDirName)
+
+	bz, err := os.ReadFile(filepath.Join(dir, remoteSignerConfigFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read remote signer configuration: %w", err)
+	}
+
+	var cfg RemoteSignerConfig
+	if err := json.Unmarshal(bz, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid remote signer configuration: %w", err)
+	}
+
+	for _, path := range []*string{&cfg.CAFile, &cfg.CertFile, &cfg.KeyFile} {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+
+	return NewRemote(cfg, opts...)
+}
+
+// clientTLSConfig returns the TLS configuration of the connection to the
+// signer, authenticating both ends.
+func (cfg RemoteSignerConfig) clientTLSConfig() (*tls.Config, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("remote signer address is empty")
+	}
+	if cfg.CAFile == "" || cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("remote signer CA, certificate and key files must be set")
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	bz, err := os.ReadFile(cfg.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no valid certificates found in CA file %s", cfg.CAFile)
+	}
+
+	serverName := cfg.ServerName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(cfg.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote signer address %s: %w", cfg.Address, err)
+		}
+
+		serverName = host
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (ks remoteKeystore) List() ([]Info, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Keys(ctx, &RemoteKeysRequest{})
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	infos := make([]Info, len(res.Keys))
+	for i, key := range res.Keys {
+		if infos[i], err = key.toInfo(); err != nil {
+			return nil, err
+		}
+	}
+
+	return infos, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (Info, error) {
+	return ks.key(&RemoteKeyRequest{Name: uid})
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (Info, error) {
+	return ks.key(&RemoteKeyRequest{Address: address.Bytes()})
+}
+
+func (ks remoteKeystore) key(req *RemoteKeyRequest) (Info, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Key(ctx, req)
+	if err != nil {
+		return nil, fromRemoteError(err)
+	}
+
+	return res.Key.toInfo()
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.sign(&RemoteSignRequest{Name: uid, Msg: msg})
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.sign(&RemoteSignRequest{Address: address.Bytes(), Msg: msg})
+}
+
+func (ks remoteKeystore) sign(req *RemoteSignRequest) ([]byte, types.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, req)
+	if err != nil {
+		return nil, nil, fromRemoteError(err)
+	}
+
+	pub, err := legacy.PubKeyFromBytes(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Signature, pub, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(legacy.Cdc.MustMarshal(info.GetPubKey()), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.ExportPubKeyArmor(info.GetName())
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return errRemoteUnsupported("deleting keys")
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return errRemoteUnsupported("deleting keys")
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", errRemoteUnsupported("creating keys")
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, errRemoteUnsupported("creating keys")
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, errRemoteUnsupported("saving keys")
+}
+
+func (ks remoteKeystore) SavePubKey(string, types.PubKey, hd.PubKeyType) (Info, error) {
+	return nil, errRemoteUnsupported("saving keys")
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (Info, error) {
+	return nil, errRemoteUnsupported("saving keys")
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return errRemoteUnsupported("importing keys")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errRemoteUnsupported("exporting private keys")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", errRemoteUnsupported("exporting private keys")
+}
+
+func errRemoteUnsupported(op string) error {
+	return fmt.Errorf("%s is not supported by the %s keyring backend: manage the keys on the signer", op, BackendRemote)
+}
+
+// fromRemoteError converts the errors returned by a remote signer for keys it
+// does not hold into ErrKeyNotFound.
+func fromRemoteError(err error) error {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, s.Message())
+	}
+
+	return err
+}
+
+// remoteInfo is the public information about a key of a remote signer.
+type remoteInfo struct {
+	Name   string
+	Type   KeyType
+	PubKey types.PubKey
+	Algo   hd.PubKeyType
+}
+
+var _ Info = remoteInfo{}
+
+// GetType implements Info interface
+func (i remoteInfo) GetType() KeyType {
+	return i.Type
+}
+
+// GetName implements Info interface
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i remoteInfo) GetPubKey() types.PubKey {
+	return i.PubKey
+}
+
+// GetAddress implements Info interface
+func (i remoteInfo) GetAddress() sdk.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
+// GetAlgo implements Info interface
+func (i remoteInfo) GetAlgo() hd.PubKeyType {
+	return i.Algo
+}
+
+// GetPath implements Info interface
+func (i remoteInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for remote keys")
+}
+
+// toInfo converts a key returned by a remote signer into its Info.
+func (k *RemoteKey) toInfo() (Info, error) {
+	if k == nil {
+		return nil, errors.New("remote signer returned no key")
+	}
+
+	pub, err := legacy.PubKeyFromBytes(k.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for keyType, name := range keyTypes {
+		if name == k.Type {
+			return remoteInfo{Name: k.Name, Type: keyType, PubKey: pub, Algo: hd.PubKeyType(k.Algo)}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown type %s of remote key %s", k.Type, k.Name)
+}
+
+// remoteSignerServer is the server of the RemoteSigner service, serving the
+// keys of a keyring.
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+// NewRemoteSignerServer returns a RemoteSigner service server listing and
+// signing with the keys of the given keyring.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+// Keys implements the RemoteSigner.Keys RPC method.
+func (s remoteSignerServer) Keys(_ context.Context, _ *RemoteKeysRequest) (*RemoteKeysResponse, error) {
+	infos, err := s.kr.List()
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	keys := make([]*RemoteKey, len(infos))
+	for i, info := range infos {
+		keys[i] = newRemoteKey(info)
+	}
+
+	return &RemoteKeysResponse{Keys: keys}, nil
+}
+
+// Key implements the RemoteSigner.Key RPC method.
+func (s remoteSignerServer) Key(_ context.Context, req *RemoteKeyRequest) (*RemoteKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	var (
+		info Info
+		err  error
+	)
+	switch {
+	case req.Name != "" && len(req.Address) == 0:
+		info, err = s.kr.Key(req.Name)
+	case req.Name == "" && len(req.Address) != 0:
+		info, err = s.kr.KeyByAddress(sdk.AccAddress(req.Address))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exactly one of name and address must be set")
+	}
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &RemoteKeyResponse{Key: newRemoteKey(info)}, nil
+}
+
+// Sign implements the RemoteSigner.Sign RPC method.
+func (s remoteSignerServer) Sign(_ context.Context, req *RemoteSignRequest) (*RemoteSignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	var (
+		sig []byte
+		pub types.PubKey
+		err error
+	)
+	switch {
+	case req.Name != "" && len(req.Address) == 0:
+		sig, pub, err = s.kr.Sign(req.Name, req.Msg)
+	case req.Name == "" && len(req.Address) != 0:
+		sig, pub, err = s.kr.SignByAddress(sdk.AccAddress(req.Address), req.Msg)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exactly one of name and address must be set")
+	}
+	if err != nil {
+		return nil, toRemoteError(err)
+	}
+
+	return &RemoteSignResponse{Signature: sig, PubKey: legacy.Cdc.MustMarshal(pub)}, nil
+}
+
+// newRemoteKey returns the public information about a key served to clients.
+func newRemoteKey(info Info) *RemoteKey {
+	return &RemoteKey{
+		Name:   info.GetName(),
+		Type:   info.GetType().String(),
+		Algo:   string(info.GetAlgo()),
+		PubKey: legacy.Cdc.MustMarshal(info.GetPubKey()),
+	}
+}
+
+// toRemoteError converts the keyring errors for missing keys into NotFound
+// gRPC errors.
+func toRemoteError(err error) error {
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Unknown, err.Error())
+}
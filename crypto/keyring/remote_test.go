@@ -0,0 +1,218 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// testCA is a certificate authority issuing the certificates of the remote
+// signer tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate signed by the CA, and its key, to dir.
+func (ca testCA) issue(t *testing.T, dir, name string, usage x509.ExtKeyUsage) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".pem")
+	keyFile = filepath.Join(dir, name+"-key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
+
+// startRemoteSigner serves the keys of kr with mutual TLS, trusting the
+// clients certified by ca, and returns its address.
+func startRemoteSigner(t *testing.T, kr Keyring, ca testCA) string {
+	certFile, keyFile := ca.issue(t, t.TempDir(), "signer", x509.ExtKeyUsageServerAuth)
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})))
+	RegisterRemoteSignerServer(srv, NewRemoteSignerServer(kr))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	ca := newTestCA(t)
+
+	signerKr := NewInMemory()
+	local, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	multi, err := signerKr.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(1, []types.PubKey{local.GetPubKey()}))
+	require.NoError(t, err)
+
+	addr := startRemoteSigner(t, signerKr, ca)
+
+	// The client is configured by the signer.json file of its keyring
+	// directory, with paths relative to the keyring-remote directory.
+	rootDir := t.TempDir()
+	dir := filepath.Join(rootDir, keyringRemoteDirName)
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.pem"), ca.pem, 0o600))
+	ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+
+	bz, err := json.Marshal(RemoteSignerConfig{
+		Address:  addr,
+		CAFile:   "ca.pem",
+		CertFile: "client.pem",
+		KeyFile:  "client-key.pem",
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, remoteSignerConfigFileName), bz, 0o600))
+
+	kr, err := New("cosmos", BackendRemote, rootDir, nil)
+	require.NoError(t, err)
+
+	infos, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	info, err := kr.Key("local")
+	require.NoError(t, err)
+	require.Equal(t, TypeLocal, info.GetType())
+	require.Equal(t, local.GetAddress(), info.GetAddress())
+	require.Equal(t, hd.Secp256k1Type, info.GetAlgo())
+
+	info, err = kr.KeyByAddress(multi.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, TypeMulti, info.GetType())
+	require.Equal(t, "multi", info.GetName())
+
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("message")
+	sig, pub, err := kr.Sign("local", msg)
+	require.NoError(t, err)
+	require.True(t, local.GetPubKey().Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(local.GetAddress(), msg)
+	require.NoError(t, err)
+	require.True(t, local.GetPubKey().VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("multi", msg)
+	require.Error(t, err)
+
+	armor, err := kr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+	pubBytes, algo, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	require.Equal(t, string(hd.Secp256k1Type), algo)
+	require.Equal(t, legacy.Cdc.MustMarshal(local.GetPubKey()), pubBytes)
+
+	// Keys are managed on the signer.
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.Error(t, err)
+	require.Error(t, kr.Delete("local"))
+	_, err = kr.ExportPrivKeyArmor("local", "passphrase")
+	require.Error(t, err)
+
+	_, err = signerKr.Key("local")
+	require.NoError(t, err)
+}
+
+func TestRemoteKeyringUntrustedClient(t *testing.T) {
+	ca := newTestCA(t)
+	addr := startRemoteSigner(t, NewInMemory(), ca)
+
+	// A client certified by another CA is rejected by the signer.
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, ca.pem, 0o600))
+	certFile, keyFile := newTestCA(t).issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+
+	kr, err := NewRemote(RemoteSignerConfig{Address: addr, CAFile: caFile, CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+
+	_, err = kr.List()
+	require.Error(t, err)
+
+	// The certificate files are required.
+	_, err = NewRemote(RemoteSignerConfig{Address: addr, CAFile: caFile})
+	require.Error(t, err)
+
+	_, err = New("cosmos", BackendRemote, t.TempDir(), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package bls12381
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	bls "github.com/kilic/bls12-381"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ cryptotypes.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier batch verifies BLS signatures of different messages, checking
+// e(g1, sum(r_i * sig_i)) == prod(e(r_i * pk_i, H(msg_i))) for random 64-bit
+// scalars r_i, which takes one pairing per signature instead of two.
+type BatchVerifier struct {
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	pubKey *PubKey
+	msg    []byte
+	sig    []byte
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add implements cryptotypes.BatchVerifier Add method.
+func (b *BatchVerifier) Add(key cryptotypes.PubKey, msg, sig []byte) error {
+	pubKey, ok := key.(*PubKey)
+	if !ok {
+		return fmt.Errorf("batch verification of %T is not supported", key)
+	}
+
+	b.entries = append(b.entries, batchEntry{pubKey: pubKey, msg: msg, sig: sig})
+
+	return nil
+}
+
+// Verify implements cryptotypes.BatchVerifier Verify method. If the batch is
+// not valid, its signatures are verified one at a time.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	if len(b.entries) == 0 {
+		return false, nil
+	}
+
+	valid := make([]bool, len(b.entries))
+	// a single signature is faster to verify on its own
+	if len(b.entries) > 1 && b.verify() {
+		for i := range valid {
+			valid[i] = true
+		}
+
+		return true, valid
+	}
+
+	ok := true
+	for i, e := range b.entries {
+		valid[i] = e.pubKey.VerifySignature(e.msg, e.sig)
+		ok = ok && valid[i]
+	}
+
+	return ok, valid
+}
+
+func (b *BatchVerifier) verify() bool {
+	engine := bls.NewEngine()
+	g1, g2 := engine.G1, engine.G2
+
+	aggSig := g2.Zero()
+	for _, e := range b.entries {
+		p, err := decodePubKey(g1, e.pubKey.Key)
+		if err != nil {
+			return false
+		}
+		s, err := g2.FromCompressed(e.sig)
+		if err != nil || g2.IsZero(s) {
+			return false
+		}
+		h, err := g2.HashToCurve(e.msg, []byte(dstSignature))
+		if err != nil {
+			return false
+		}
+
+		// the random scalars prevent invalid signatures from cancelling each
+		// other in the sum
+		r, err := randomScalar()
+		if err != nil {
+			return false
+		}
+
+		g2.Add(aggSig, aggSig, g2.MulScalarBig(g2.New(), s, r))
+		engine.AddPair(g1.MulScalarBig(g1.New(), p, r), h)
+	}
+
+	return engine.AddPairInv(g1.One(), aggSig).Check()
+}
+
+// randomScalar returns a random non-zero 64-bit scalar.
+func randomScalar() (*big.Int, error) {
+	var bz [8]byte
+	for {
+		if _, err := rand.Read(bz[:]); err != nil {
+			return nil, err
+		}
+
+		if r := new(big.Int).SetBytes(bz[:]); r.Sign() != 0 {
+			return r, nil
+		}
+	}
+}
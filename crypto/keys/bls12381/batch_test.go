@@ -0,0 +1,73 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	bls "github.com/kilic/bls12-381"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	newBatch := func(n int) (*bls12381.BatchVerifier, [][]byte) {
+		batch := bls12381.NewBatchVerifier()
+		var sigs [][]byte
+		for i := 0; i < n; i++ {
+			privKey := bls12381.GenPrivKey()
+			msg := crypto.CRandBytes(100)
+			sig, err := privKey.Sign(msg)
+			require.NoError(t, err)
+
+			require.NoError(t, batch.Add(privKey.PubKey(), msg, sig))
+			sigs = append(sigs, sig)
+		}
+
+		return batch, sigs
+	}
+
+	batch, _ := newBatch(5)
+	ok, valid := batch.Verify()
+	require.True(t, ok)
+	require.Equal(t, []bool{true, true, true, true, true}, valid)
+
+	// the invalid signatures are found
+	batch, sigs := newBatch(5)
+	sigs[1][7] ^= byte(0x01)
+	sigs[3][7] ^= byte(0x01)
+	ok, valid = batch.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, false, true, false, true}, valid)
+
+	// a signature of the wrong size
+	batch, _ = newBatch(2)
+	privKey := bls12381.GenPrivKey()
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.NoError(t, batch.Add(privKey.PubKey(), []byte("msg"), append(sig, 0)))
+	ok, valid = batch.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, true, false}, valid)
+
+	// invalid signatures can't cancel each other in the batch
+	batch, sigs = newBatch(2)
+	g2 := bls.NewG2()
+	s0, err := g2.FromCompressed(sigs[0])
+	require.NoError(t, err)
+	s1, err := g2.FromCompressed(sigs[1])
+	require.NoError(t, err)
+	copy(sigs[0], g2.ToCompressed(g2.Add(g2.New(), s0, g2.One())))
+	copy(sigs[1], g2.ToCompressed(g2.Sub(g2.New(), s1, g2.One())))
+	ok, valid = batch.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{false, false}, valid)
+
+	// an empty batch
+	ok, _ = bls12381.NewBatchVerifier().Verify()
+	require.False(t, ok)
+
+	// another key type
+	require.Error(t, batch.Add(secp256k1.GenPrivKey().PubKey(), []byte("msg"), sig))
+}
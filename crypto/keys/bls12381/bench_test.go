@@ -0,0 +1,59 @@
+package bls12381
+
+import (
+	"io"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/benchmarking"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func BenchmarkKeyGeneration(b *testing.B) {
+	b.ReportAllocs()
+	benchmarkKeygenWrapper := func(reader io.Reader) types.PrivKey {
+		return genPrivKey(reader)
+	}
+	benchmarking.BenchmarkKeyGeneration(b, benchmarkKeygenWrapper)
+}
+
+func BenchmarkSigning(b *testing.B) {
+	b.ReportAllocs()
+	priv := GenPrivKey()
+	benchmarking.BenchmarkSigning(b, priv)
+}
+
+func BenchmarkVerification(b *testing.B) {
+	b.ReportAllocs()
+	priv := GenPrivKey()
+	benchmarking.BenchmarkVerification(b, priv)
+}
+
+func BenchmarkAggregatedVerification(b *testing.B) {
+	b.ReportAllocs()
+	msg := []byte("hello world")
+
+	var (
+		pubKeys []*PubKey
+		sigs    [][]byte
+	)
+	for i := 0; i < 10; i++ {
+		priv := GenPrivKey()
+		sig, err := priv.Sign(msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		pubKeys = append(pubKeys, priv.PubKey().(*PubKey))
+		sigs = append(sigs, sig)
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyAggregateSignature(pubKeys, msg, aggSig)
+	}
+}
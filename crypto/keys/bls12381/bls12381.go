@@ -0,0 +1,173 @@
+package bls12381
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	bls "github.com/kilic/bls12-381"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Domain separation tags of the signatures and of the proofs of possession, as
+// the ciphersuite of the proof of possession scheme of the IETF BLS signature draft.
+const (
+	dstSignature         = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+	dstProofOfPossession = "BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+)
+
+// order is the order r of G1 and G2.
+var order = bls.NewG1().Q()
+
+// keyGen derives a private key from a secret of at least 32 bytes, as the KeyGen
+// procedure of the IETF BLS signature draft.
+func keyGen(ikm []byte) []byte {
+	const l = 48
+
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	ikm = append(append([]byte{}, ikm...), 0)
+	for {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+
+		okm := make([]byte, l)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, []byte{0, l}), okm); err != nil {
+			panic(err)
+		}
+
+		sk := new(big.Int).Mod(new(big.Int).SetBytes(okm), order)
+		if sk.Sign() != 0 {
+			return sk.FillBytes(make([]byte, PrivKeySize))
+		}
+	}
+}
+
+// decodeScalar returns the scalar of a private key, which must be in ]0, r[.
+func decodeScalar(bz []byte) (*big.Int, error) {
+	if len(bz) != PrivKeySize {
+		return nil, fmt.Errorf("invalid private key size %d", len(bz))
+	}
+
+	sk := new(big.Int).SetBytes(bz)
+	if sk.Sign() == 0 || sk.Cmp(order) >= 0 {
+		return nil, errors.New("invalid private key")
+	}
+
+	return sk, nil
+}
+
+// decodePubKey returns the point of a public key, which must be a point of G1
+// other than the identity.
+func decodePubKey(g1 *bls.G1, bz []byte) (*bls.PointG1, error) {
+	p, err := g1.FromCompressed(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	if g1.IsZero(p) {
+		return nil, errors.New("public key is the identity")
+	}
+
+	return p, nil
+}
+
+// sign returns the signature of msg with the domain separation tag dst.
+func sign(sk *big.Int, msg []byte, dst string) ([]byte, error) {
+	g2 := bls.NewG2()
+	h, err := g2.HashToCurve(msg, []byte(dst))
+	if err != nil {
+		return nil, err
+	}
+
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), h, sk)), nil
+}
+
+// verify returns whether sig is a signature of msg with the domain separation
+// tag dst by the public key pk, which is the aggregation of the public keys of
+// the signers for an aggregated signature.
+func verify(pk *bls.PointG1, msg, sig []byte, dst string) bool {
+	engine := bls.NewEngine()
+	s, err := engine.G2.FromCompressed(sig)
+	if err != nil || engine.G2.IsZero(s) {
+		return false
+	}
+
+	h, err := engine.G2.HashToCurve(msg, []byte(dst))
+	if err != nil {
+		return false
+	}
+
+	// e(pk, H(msg)) == e(g1, sig)
+	return engine.AddPair(pk, h).AddPairInv(engine.G1.One(), s).Check()
+}
+
+// AggregateSignatures returns the aggregation of signatures, which is verified
+// against the aggregation of the public keys of the signers for the signatures
+// of the same message.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signature to aggregate")
+	}
+
+	g2 := bls.NewG2()
+	agg := g2.Zero()
+	for i, sig := range sigs {
+		s, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", i, err)
+		}
+
+		g2.Add(agg, agg, s)
+	}
+
+	return g2.ToCompressed(agg), nil
+}
+
+// AggregatePubKeys returns the aggregation of public keys, against which the
+// aggregated signature of a message by these keys is verified. The proofs of
+// possession of the keys must have been verified, otherwise a key may cancel
+// the others.
+func AggregatePubKeys(pubKeys []*PubKey) (*PubKey, error) {
+	g1 := bls.NewG1()
+	agg, err := aggregatePubKeys(g1, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubKey{Key: g1.ToCompressed(agg)}, nil
+}
+
+func aggregatePubKeys(g1 *bls.G1, pubKeys []*PubKey) (*bls.PointG1, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public key to aggregate")
+	}
+
+	agg := g1.Zero()
+	for i, pubKey := range pubKeys {
+		p, err := decodePubKey(g1, pubKey.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+
+		g1.Add(agg, agg, p)
+	}
+
+	if g1.IsZero(agg) {
+		return nil, errors.New("the aggregated public key is the identity")
+	}
+
+	return agg, nil
+}
+
+// VerifyAggregateSignature returns whether sig is the aggregated signature of
+// msg by pubKeys, whose proofs of possession must have been verified.
+func VerifyAggregateSignature(pubKeys []*PubKey, msg, sig []byte) bool {
+	agg, err := aggregatePubKeys(bls.NewG1(), pubKeys)
+	if err != nil {
+		return false
+	}
+
+	return verify(agg, msg, sig, dstSignature)
+}
@@ -0,0 +1,168 @@
+package bls12381_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	// test case 0 of EIP-2333, whose master key is derived with KeyGen
+	seed := mustDecodeHex(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	privKey, err := bls12381.GenPrivKeyFromSecret(seed)
+	require.NoError(t, err)
+
+	expected, ok := new(big.Int).SetString("6083874454709270928345386274498605044986640685124978867557563392430687146096", 10)
+	require.True(t, ok)
+	require.Equal(t, expected.FillBytes(make([]byte, bls12381.PrivKeySize)), privKey.Key)
+
+	_, err = bls12381.GenPrivKeyFromSecret(seed[:31])
+	require.Error(t, err)
+}
+
+func TestSignTestVector(t *testing.T) {
+	// test vector of the Ethereum consensus specs, which use the same ciphersuite
+	privKey := &bls12381.PrivKey{Key: mustDecodeHex(t, "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3")}
+	pubKey := privKey.PubKey()
+	require.Equal(t, mustDecodeHex(t, "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"), pubKey.Bytes())
+
+	msg := make([]byte, 32)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Equal(t, mustDecodeHex(t, "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"), sig)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestSignAndValidate(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12381.PubKeySize)
+	require.Len(t, pubKey.Address(), 32)
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// another message
+	require.False(t, pubKey.VerifySignature(msg[1:], sig))
+	// another key
+	require.False(t, bls12381.GenPrivKey().PubKey().VerifySignature(msg, sig))
+	// a mutated signature
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+	// an invalid public key
+	require.False(t, (&bls12381.PubKey{Key: make([]byte, bls12381.PubKeySize)}).VerifySignature(msg, sig))
+
+	// an invalid private key
+	_, err = (&bls12381.PrivKey{Key: make([]byte, bls12381.PrivKeySize)}).Sign(msg)
+	require.Error(t, err)
+}
+
+func TestProofOfPossession(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(*bls12381.PubKey)
+
+	pop, err := privKey.ProofOfPossession()
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyProofOfPossession(pop))
+	require.False(t, bls12381.GenPrivKey().PubKey().(*bls12381.PubKey).VerifyProofOfPossession(pop))
+
+	// a proof of possession isn't a signature of the public key
+	sig, err := privKey.Sign(pubKey.Bytes())
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifyProofOfPossession(sig))
+	require.False(t, pubKey.VerifySignature(pubKey.Bytes(), pop))
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	msg := []byte("message")
+	var (
+		pubKeys []*bls12381.PubKey
+		sigs    [][]byte
+	)
+	for i := 0; i < 4; i++ {
+		privKey := bls12381.GenPrivKey()
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+
+		pubKeys = append(pubKeys, privKey.PubKey().(*bls12381.PubKey))
+		sigs = append(sigs, sig)
+	}
+
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12381.VerifyAggregateSignature(pubKeys, msg, aggSig))
+	require.False(t, bls12381.VerifyAggregateSignature(pubKeys[1:], msg, aggSig))
+	require.False(t, bls12381.VerifyAggregateSignature(pubKeys, []byte("other"), aggSig))
+
+	// the aggregated public key verifies the aggregated signature
+	aggPubKey, err := bls12381.AggregatePubKeys(pubKeys)
+	require.NoError(t, err)
+	require.True(t, aggPubKey.VerifySignature(msg, aggSig))
+
+	// a single signature is its own aggregation
+	aggSig, err = bls12381.AggregateSignatures(sigs[:1])
+	require.NoError(t, err)
+	require.Equal(t, sigs[0], aggSig)
+
+	_, err = bls12381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12381.AggregateSignatures([][]byte{sigs[0], sigs[1][1:]})
+	require.Error(t, err)
+	_, err = bls12381.AggregatePubKeys(nil)
+	require.Error(t, err)
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	pubKey := bls12381.GenPrivKey().PubKey()
+
+	require.True(t, pubKey.Equals(&bls12381.PubKey{Key: pubKey.Bytes()}))
+	require.False(t, pubKey.Equals(bls12381.GenPrivKey().PubKey()))
+	require.False(t, pubKey.Equals(secp256k1.GenPrivKey().PubKey()))
+}
+
+func TestEncoding(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterfaceJSON(pubKey)
+	require.NoError(t, err)
+	var jsonPk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &jsonPk))
+	require.True(t, pubKey.Equals(jsonPk))
+
+	bz, err = legacy.Cdc.Marshal(pubKey)
+	require.NoError(t, err)
+	var aminoPk cryptotypes.PubKey
+	require.NoError(t, legacy.Cdc.Unmarshal(bz, &aminoPk))
+	require.True(t, pubKey.Equals(aminoPk))
+
+	bz, err = legacy.Cdc.Marshal(privKey)
+	require.NoError(t, err)
+	var aminoPriv cryptotypes.PrivKey
+	require.NoError(t, legacy.Cdc.Unmarshal(bz, &aminoPriv))
+	require.True(t, privKey.Equals(aminoPriv))
+}
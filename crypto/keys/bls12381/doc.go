@@ -0,0 +1,37 @@
+// Package bls12381 implements Cosmos-SDK compatible BLS12-381 public and private
+// keys, following the proof of possession scheme of the IETF BLS signature draft:
+// https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-05
+//
+// Public keys are points of G1 and signatures points of G2, hashed to the curve
+// with the BLS12381G2_XMD:SHA-256_SSWU_RO_ suite. The signatures of a message by
+// several keys can be aggregated into a single signature, verified against the
+// aggregation of the public keys with two pairings whatever the number of keys.
+// The aggregation is only secure for keys whose proof of possession has been
+// verified, as done by the MultisigPubKey. The keys can be protobuf serialized
+// and packed in Any.
+package bls12381
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	PrivKeyName        = "cosmos-sdk/PrivKeyBls12381"
+	PubKeyName         = "cosmos-sdk/PubKeyBls12381"
+	MultisigPubKeyName = "cosmos-sdk/PubKeyBls12381Multisig"
+	// PrivKeySize is the size, in bytes, of private keys as used in this package.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of public keys as used in this package.
+	PubKeySize = 48
+	// SignatureSize is the size, in bytes, of signatures and proofs of possession.
+	SignatureSize = 96
+
+	keyType = "bls12381"
+)
+
+// RegisterInterfaces adds the bls12381 PubKey and MultisigPubKey to pubkey registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &MultisigPubKey{})
+}
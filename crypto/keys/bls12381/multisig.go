@@ -0,0 +1,222 @@
+package bls12381
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ multisigtypes.AggregatedPubKey = &MultisigPubKey{}
+
+// NewMultisigPubKey returns a new MultisigPubKey, verifying the proof of
+// possession of each public key.
+func NewMultisigPubKey(threshold int, pubKeys []*PubKey, proofsOfPossession [][]byte) (*MultisigPubKey, error) {
+	if threshold <= 0 || threshold > len(pubKeys) {
+		return nil, fmt.Errorf("threshold must be between 1 and %d, got %d", len(pubKeys), threshold)
+	}
+
+	m := &MultisigPubKey{Threshold: uint32(threshold), PubKeys: pubKeys, ProofsOfPossession: proofsOfPossession}
+	if err := m.VerifyProofsOfPossession(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Validate returns an error if the threshold can't be reached or the proofs of
+// possession don't match the keys. It doesn't verify the proofs of possession.
+func (m *MultisigPubKey) Validate() error {
+	if len(m.PubKeys) == 0 {
+		return errors.New("multisignature has no keys")
+	}
+	if len(m.ProofsOfPossession) != len(m.PubKeys) {
+		return fmt.Errorf("multisignature has %d proofs of possession for %d keys", len(m.ProofsOfPossession), len(m.PubKeys))
+	}
+	if m.Threshold == 0 || int(m.Threshold) > len(m.PubKeys) {
+		return fmt.Errorf("threshold must be between 1 and %d, got %d", len(m.PubKeys), m.Threshold)
+	}
+
+	return nil
+}
+
+// VerifyProofsOfPossession verifies the proof of possession of each public key,
+// which must be done once before trusting the aggregated signatures of the
+// multisig, to prevent a key from cancelling the others in the aggregation.
+func (m *MultisigPubKey) VerifyProofsOfPossession() error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	for i, pubKey := range m.PubKeys {
+		if !pubKey.VerifyProofOfPossession(m.ProofsOfPossession[i]) {
+			return fmt.Errorf("invalid proof of possession of key %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Address implements cryptotypes.PubKey Address method
+func (m *MultisigPubKey) Address() tmcrypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoded version of the MultisigPubKey
+func (m *MultisigPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature method.
+// The multi-signature has a single signature, the aggregation of the signatures of the
+// signers set in its bit array, which is verified against the aggregation of their
+// public keys. The proofs of possession are not verified.
+func (m *MultisigPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	bitarray := sig.BitArray
+	size := bitarray.Count()
+	// ensure bit array is the correct size
+	if len(m.PubKeys) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(m.PubKeys))
+	}
+	// ensure the threshold is reached
+	signers := m.signers(bitarray)
+	if len(signers) < int(m.Threshold) {
+		return fmt.Errorf("not enough signatures set, have %d, expected %d", len(signers), m.Threshold)
+	}
+	// ensure there is a single aggregated signature
+	if len(sig.Signatures) != 1 {
+		return fmt.Errorf("expected a single aggregated signature, got %d", len(sig.Signatures))
+	}
+	aggSig, ok := sig.Signatures[0].(*signing.SingleSignatureData)
+	if !ok {
+		return fmt.Errorf("improper aggregated signature data type %T", sig.Signatures[0])
+	}
+
+	msg, err := getSignBytes(aggSig.SignMode)
+	if err != nil {
+		return err
+	}
+
+	if !VerifyAggregateSignature(signers, msg, aggSig.Signature) {
+		return errors.New("unable to verify aggregated signature")
+	}
+
+	return nil
+}
+
+// AggregateMultisignature implements the multisigtypes.AggregatedPubKey method,
+// aggregating the signatures of the signers, which must have the same sign mode.
+func (m *MultisigPubKey) AggregateMultisignature(sig *signing.MultiSignatureData) (*signing.MultiSignatureData, error) {
+	if len(sig.Signatures) == 0 {
+		return nil, errors.New("no signature to aggregate")
+	}
+	if len(sig.Signatures) != len(m.signers(sig.BitArray)) {
+		return nil, fmt.Errorf("signature size is incorrect %d", len(sig.Signatures))
+	}
+
+	var (
+		mode signing.SignMode
+		sigs = make([][]byte, len(sig.Signatures))
+	)
+	for i, sigData := range sig.Signatures {
+		single, ok := sigData.(*signing.SingleSignatureData)
+		if !ok {
+			return nil, fmt.Errorf("improper signature data type for index %d", i)
+		}
+		if i == 0 {
+			mode = single.SignMode
+		} else if single.SignMode != mode {
+			return nil, fmt.Errorf("signature %d has sign mode %s, expected %s", i, single.SignMode, mode)
+		}
+		sigs[i] = single.Signature
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signing.MultiSignatureData{
+		BitArray:   sig.BitArray,
+		Signatures: []signing.SignatureData{&signing.SingleSignatureData{SignMode: mode, Signature: aggSig}},
+	}, nil
+}
+
+// signers returns the public keys set in bitarray.
+func (m *MultisigPubKey) signers(bitarray *cryptotypes.CompactBitArray) []*PubKey {
+	var signers []*PubKey
+	for i, pubKey := range m.PubKeys {
+		if bitarray.GetIndex(i) {
+			signers = append(signers, pubKey)
+		}
+	}
+
+	return signers
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method,
+// it panics because it can't handle MultiSignatureData.
+func (m *MultisigPubKey) VerifySignature(msg []byte, sig []byte) bool {
+	panic("not implemented")
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (m *MultisigPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m == nil {
+		return nil
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+	for i, pubKey := range m.PubKeys {
+		pubKeys[i] = pubKey
+	}
+
+	return pubKeys
+}
+
+// Equals returns true if other is a MultisigPubKey with the same threshold,
+// and the same keys in the same order.
+func (m *MultisigPubKey) Equals(key cryptotypes.PubKey) bool {
+	other, ok := key.(*MultisigPubKey)
+	if !ok || m.Threshold != other.Threshold || len(m.PubKeys) != len(other.PubKeys) {
+		return false
+	}
+
+	for i, pubKey := range m.PubKeys {
+		if !pubKey.Equals(other.PubKeys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetThreshold implements the PubKey.GetThreshold method
+func (m *MultisigPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Type returns multisig type
+func (m *MultisigPubKey) Type() string {
+	return "PubKeyBls12381Multisig"
+}
+
+// String returns the threshold and the keys of the multisig.
+func (m *MultisigPubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381Multisig{%d:%v}", m.Threshold, m.PubKeys)
+}
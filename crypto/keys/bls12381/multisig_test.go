@@ -0,0 +1,202 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	bls "github.com/kilic/bls12-381"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func generateKeys(t *testing.T, n int) (privKeys []*bls12381.PrivKey, pubKeys []*bls12381.PubKey, pops [][]byte) {
+	for i := 0; i < n; i++ {
+		privKey := bls12381.GenPrivKey()
+		pop, err := privKey.ProofOfPossession()
+		require.NoError(t, err)
+
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, privKey.PubKey().(*bls12381.PubKey))
+		pops = append(pops, pop)
+	}
+
+	return privKeys, pubKeys, pops
+}
+
+// multisign returns the multi-signature of msg by the given signers, with one
+// signature per signer.
+func multisign(t *testing.T, pk *bls12381.MultisigPubKey, privKeys []*bls12381.PrivKey, msg []byte, signers ...int) *signing.MultiSignatureData {
+	sig := multisig.NewMultisig(len(pk.PubKeys))
+	for _, i := range signers {
+		bz, err := privKeys[i].Sign(msg)
+		require.NoError(t, err)
+
+		sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: bz}
+		require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigData, privKeys[i].PubKey(), pk.GetPubKeys()))
+	}
+
+	return sig
+}
+
+// rogueKey returns attacker - honest, whose private key is unknown.
+func rogueKey(t *testing.T, attacker, honest *bls12381.PubKey) *bls12381.PubKey {
+	g1 := bls.NewG1()
+	a, err := g1.FromCompressed(attacker.Key)
+	require.NoError(t, err)
+	h, err := g1.FromCompressed(honest.Key)
+	require.NoError(t, err)
+
+	return &bls12381.PubKey{Key: g1.ToCompressed(g1.Sub(g1.New(), a, h))}
+}
+
+func TestNewMultisigPubKey(t *testing.T) {
+	_, pubKeys, pops := generateKeys(t, 3)
+
+	pk, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), pk.GetThreshold())
+	require.Len(t, pk.GetPubKeys(), 3)
+	require.Len(t, pk.Address(), 32)
+
+	_, err = bls12381.NewMultisigPubKey(0, pubKeys, pops)
+	require.Error(t, err)
+	_, err = bls12381.NewMultisigPubKey(4, pubKeys, pops)
+	require.Error(t, err)
+	_, err = bls12381.NewMultisigPubKey(2, pubKeys, pops[:2])
+	require.Error(t, err)
+
+	// a proof of possession of another key
+	_, err = bls12381.NewMultisigPubKey(2, pubKeys, [][]byte{pops[0], pops[2], pops[1]})
+	require.EqualError(t, err, "invalid proof of possession of key 1")
+}
+
+func TestRogueKeyAttack(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(_ signing.SignMode) ([]byte, error) { return msg, nil }
+
+	_, honest, honestPops := generateKeys(t, 1)
+	attackerPriv, _, _ := generateKeys(t, 1)
+
+	// the rogue key of the attacker cancels the honest key in the aggregation
+	rogue := rogueKey(t, attackerPriv[0].PubKey().(*bls12381.PubKey), honest[0])
+
+	aggPubKey, err := bls12381.AggregatePubKeys([]*bls12381.PubKey{honest[0], rogue})
+	require.NoError(t, err)
+	require.True(t, aggPubKey.Equals(attackerPriv[0].PubKey()))
+
+	// without the proofs of possession, the attacker alone signs for both keys
+	pk := &bls12381.MultisigPubKey{Threshold: 2, PubKeys: []*bls12381.PubKey{honest[0], rogue}, ProofsOfPossession: [][]byte{honestPops[0], honestPops[0]}}
+	sigBz, err := attackerPriv[0].Sign(msg)
+	require.NoError(t, err)
+	sig := multisig.NewMultisig(2)
+	multisig.AddSignature(sig, &signing.SingleSignatureData{Signature: sigBz}, 0)
+	sig.BitArray.SetIndex(1, true)
+	require.NoError(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// which the verification of the proofs of possession prevents
+	require.EqualError(t, pk.VerifyProofsOfPossession(), "invalid proof of possession of key 1")
+}
+
+func TestVerifyAggregatedMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(_ signing.SignMode) ([]byte, error) { return msg, nil }
+
+	privKeys, pubKeys, pops := generateKeys(t, 5)
+	pk, err := bls12381.NewMultisigPubKey(3, pubKeys, pops)
+	require.NoError(t, err)
+
+	for _, signers := range [][]int{{0, 1, 2}, {4, 0, 2}, {0, 1, 2, 3, 4}} {
+		sig, err := pk.AggregateMultisignature(multisign(t, pk, privKeys, msg, signers...))
+		require.NoError(t, err)
+		require.Len(t, sig.Signatures, 1)
+		require.NoError(t, pk.VerifyMultisignature(signBytesFn, sig))
+	}
+
+	// below the threshold
+	sig, err := pk.AggregateMultisignature(multisign(t, pk, privKeys, msg, 0, 1))
+	require.NoError(t, err)
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// a signer set without signing
+	sig, err = pk.AggregateMultisignature(multisign(t, pk, privKeys, msg, 0, 1, 2))
+	require.NoError(t, err)
+	sig.BitArray.SetIndex(3, true)
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// the signatures not aggregated
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, multisign(t, pk, privKeys, msg, 0, 1, 2)))
+
+	// a signature of another message
+	sig, err = pk.AggregateMultisignature(multisign(t, pk, privKeys, []byte("other"), 0, 1, 2))
+	require.NoError(t, err)
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// a bit array of the wrong size
+	sig, err = pk.AggregateMultisignature(multisign(t, pk, privKeys, msg, 0, 1, 2))
+	require.NoError(t, err)
+	sig.BitArray = cryptotypes.NewCompactBitArray(4)
+	for i := 0; i < 3; i++ {
+		sig.BitArray.SetIndex(i, true)
+	}
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// signatures of different sign modes can't be aggregated
+	sig = multisign(t, pk, privKeys, msg, 0, 1, 2)
+	sig.Signatures[1].(*signing.SingleSignatureData).SignMode = signing.SignMode_SIGN_MODE_DIRECT
+	_, err = pk.AggregateMultisignature(sig)
+	require.Error(t, err)
+}
+
+func TestNestedAggregatedMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(_ signing.SignMode) ([]byte, error) { return msg, nil }
+
+	privKeys, pubKeys, pops := generateKeys(t, 3)
+	blsPk, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+	require.NoError(t, err)
+	blsSig, err := blsPk.AggregateMultisignature(multisign(t, blsPk, privKeys, msg, 0, 2))
+	require.NoError(t, err)
+
+	secpPriv := secp256k1.GenPrivKey()
+	legacyPk := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{blsPk, secpPriv.PubKey()})
+	secpSig, err := secpPriv.Sign(msg)
+	require.NoError(t, err)
+
+	sig := multisig.NewMultisig(2)
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, blsSig, blsPk, legacyPk.GetPubKeys()))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, &signing.SingleSignatureData{Signature: secpSig}, secpPriv.PubKey(), legacyPk.GetPubKeys()))
+	require.NoError(t, legacyPk.VerifyMultisignature(signBytesFn, sig))
+}
+
+func TestMultisigEncoding(t *testing.T) {
+	_, pubKeys, pops := generateKeys(t, 3)
+	pk, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+	require.NoError(t, err)
+
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	var jsonPk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &jsonPk))
+	require.True(t, pk.Equals(jsonPk))
+	require.Equal(t, pk.Address(), jsonPk.Address())
+
+	bz, err = legacy.Cdc.Marshal(pk)
+	require.NoError(t, err)
+	var aminoPk cryptotypes.PubKey
+	require.NoError(t, legacy.Cdc.Unmarshal(bz, &aminoPk))
+	require.True(t, pk.Equals(aminoPk))
+	require.Equal(t, pk.Address(), aminoPk.Address())
+}
@@ -0,0 +1,127 @@
+package bls12381
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+
+	bls "github.com/kilic/bls12-381"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+// GenPrivKey generates a new BLS12-381 private key from OS randomness.
+func GenPrivKey() *PrivKey {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) *PrivKey {
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		panic(err)
+	}
+
+	return &PrivKey{Key: keyGen(ikm)}
+}
+
+// GenPrivKeyFromSecret derives a private key from a secret of at least 32 bytes
+// with the KeyGen procedure of the IETF BLS signature draft.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	if len(secret) < 32 {
+		return nil, fmt.Errorf("the secret must be at least 32 bytes, got %d", len(secret))
+	}
+
+	return &PrivKey{Key: keyGen(secret)}, nil
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point of G1 to get the pubkey.
+//
+// Panics if the private key is invalid.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	sk, err := decodeScalar(privKey.Key)
+	if err != nil {
+		panic(err)
+	}
+
+	g1 := bls.NewG1()
+	return &PubKey{Key: g1.ToCompressed(g1.MulScalarBig(g1.New(), g1.One(), sk))}
+}
+
+// Sign produces a signature on the provided message.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk, err := decodeScalar(privKey.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return sign(sk, msg, dstSignature)
+}
+
+// ProofOfPossession returns the proof of possession of the private key, the
+// signature of the public key with a dedicated domain separation tag.
+func (privKey *PrivKey) ProofOfPossession() ([]byte, error) {
+	sk, err := decodeScalar(privKey.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return sign(sk, privKey.PubKey().Bytes(), dstProofOfPossession)
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// String returns the type of the key, never the key itself.
+func (privKey *PrivKey) String() string {
+	return "PrivKeyBls12381{...}"
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
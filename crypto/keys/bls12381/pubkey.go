@@ -0,0 +1,100 @@
+package bls12381
+
+import (
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	bls "github.com/kilic/bls12-381"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address implements SDK PubKey interface, returning the ADR-28 address of
+// the key.
+func (pubKey *PubKey) Address() tmcrypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+
+	return address.Hash(proto.MessageName(pubKey), pubKey.Key)
+}
+
+// Bytes returns the PubKey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature implements SDK PubKey interface.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	p, err := decodePubKey(bls.NewG1(), pubKey.Key)
+	if err != nil {
+		return false
+	}
+
+	return verify(p, msg, sig, dstSignature)
+}
+
+// VerifyProofOfPossession returns whether pop is the proof of possession of the
+// private key of the public key.
+func (pubKey *PubKey) VerifyProofOfPossession(pop []byte) bool {
+	p, err := decodePubKey(bls.NewG1(), pubKey.Key)
+	if err != nil {
+		return false
+	}
+
+	return verify(p, pubKey.Key, pop, dstProofOfPossession)
+}
+
+// String returns Hex representation of a pubkey with it's type
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	if pubKey.Type() != other.Type() {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(pubKey.Bytes(), other.Bytes()) == 1
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errors.Wrap(errors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
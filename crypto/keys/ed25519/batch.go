@@ -0,0 +1,81 @@
+package ed25519
+
+import (
+	"fmt"
+
+	"github.com/hdevalence/ed25519consensus"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ cryptotypes.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier batch verifies ed25519 signatures with the zip215 rules of
+// PubKey.VerifySignature, so that a batch is valid if and only if each of its
+// signatures is valid.
+type BatchVerifier struct {
+	verifier ed25519consensus.BatchVerifier
+	entries  []batchEntry
+}
+
+type batchEntry struct {
+	pubKey *PubKey
+	msg    []byte
+	sig    []byte
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{verifier: ed25519consensus.NewBatchVerifier()}
+}
+
+// Add implements cryptotypes.BatchVerifier Add method.
+func (b *BatchVerifier) Add(key cryptotypes.PubKey, msg, sig []byte) error {
+	pubKey, ok := key.(*PubKey)
+	if !ok {
+		return fmt.Errorf("batch verification of %T is not supported", key)
+	}
+
+	b.entries = append(b.entries, batchEntry{pubKey: pubKey, msg: msg, sig: sig})
+	b.verifier.Add(pubKey.Key, msg, sig)
+
+	return nil
+}
+
+// Verify implements cryptotypes.BatchVerifier Verify method. If the batch is
+// not valid, its signatures are verified one at a time.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	if len(b.entries) == 0 {
+		return false, nil
+	}
+
+	valid := make([]bool, len(b.entries))
+	// a single signature is faster to verify on its own
+	if len(b.entries) > 1 && b.batchable() && b.verifier.Verify() {
+		for i := range valid {
+			valid[i] = true
+		}
+
+		return true, valid
+	}
+
+	ok := true
+	for i, e := range b.entries {
+		valid[i] = e.pubKey.VerifySignature(e.msg, e.sig)
+		ok = ok && valid[i]
+	}
+
+	return ok, valid
+}
+
+// batchable returns whether the sizes of the keys and signatures are those
+// checked by PubKey.VerifySignature, which the batch verification doesn't check.
+func (b *BatchVerifier) batchable() bool {
+	for _, e := range b.entries {
+		if len(e.pubKey.Key) != PubKeySize || len(e.sig) != SignatureSize {
+			return false
+		}
+	}
+
+	return true
+}
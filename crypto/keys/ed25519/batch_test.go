@@ -0,0 +1,59 @@
+package ed25519_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	newBatch := func(n int) (*ed25519.BatchVerifier, [][]byte) {
+		batch := ed25519.NewBatchVerifier()
+		var sigs [][]byte
+		for i := 0; i < n; i++ {
+			privKey := ed25519.GenPrivKey()
+			msg := crypto.CRandBytes(100)
+			sig, err := privKey.Sign(msg)
+			require.NoError(t, err)
+
+			require.NoError(t, batch.Add(privKey.PubKey(), msg, sig))
+			sigs = append(sigs, sig)
+		}
+
+		return batch, sigs
+	}
+
+	batch, _ := newBatch(5)
+	ok, valid := batch.Verify()
+	require.True(t, ok)
+	require.Equal(t, []bool{true, true, true, true, true}, valid)
+
+	// the invalid signatures are found
+	batch, sigs := newBatch(5)
+	sigs[1][7] ^= byte(0x01)
+	sigs[3][7] ^= byte(0x01)
+	ok, valid = batch.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, false, true, false, true}, valid)
+
+	// a signature of the wrong size
+	batch, _ = newBatch(2)
+	privKey := ed25519.GenPrivKey()
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.NoError(t, batch.Add(privKey.PubKey(), []byte("msg"), append(sig, 0)))
+	ok, valid = batch.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, true, false}, valid)
+
+	// an empty batch
+	ok, _ = ed25519.NewBatchVerifier().Verify()
+	require.False(t, ok)
+
+	// another key type
+	require.Error(t, batch.Add(secp256k1.GenPrivKey().PubKey(), []byte("msg"), sig))
+}
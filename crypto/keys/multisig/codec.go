@@ -30,4 +30,6 @@ func init() {
 		secp256k1.PubKeyName, nil)
 	AminoCdc.RegisterConcrete(&LegacyAminoPubKey{},
 		PubKeyAminoRoute, nil)
+	AminoCdc.RegisterConcrete(&WeightedMultisigPubKey{},
+		WeightedPubKeyAminoRoute, nil)
 }
@@ -65,32 +65,79 @@ func (m *LegacyAminoPubKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LegacyAminoPubKey proto.InternalMessageInfo
 
+// WeightedMultisigPubKey specifies a public key type which nests multiple
+// public keys with a weight each, a multisignature being valid if the sum of
+// the weights of its signers reaches the threshold.
+type WeightedMultisigPubKey struct {
+	Threshold uint32       `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty" yaml:"threshold"`
+	PubKeys   []*types.Any `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" yaml:"pubkeys"`
+	// weights are the weights of the public keys, in the same order.
+	Weights []uint32 `protobuf:"varint,3,rep,packed,name=weights,proto3" json:"weights,omitempty" yaml:"weights"`
+}
+
+func (m *WeightedMultisigPubKey) Reset()         { *m = WeightedMultisigPubKey{} }
+func (m *WeightedMultisigPubKey) String() string { return proto.CompactTextString(m) }
+func (*WeightedMultisigPubKey) ProtoMessage()    {}
+func (*WeightedMultisigPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b57537e097d47d, []int{1}
+}
+func (m *WeightedMultisigPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMultisigPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMultisigPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMultisigPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMultisigPubKey.Merge(m, src)
+}
+func (m *WeightedMultisigPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMultisigPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMultisigPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMultisigPubKey proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LegacyAminoPubKey)(nil), "cosmos.crypto.multisig.LegacyAminoPubKey")
+	proto.RegisterType((*WeightedMultisigPubKey)(nil), "cosmos.crypto.multisig.WeightedMultisigPubKey")
 }
 
 func init() { proto.RegisterFile("cosmos/crypto/multisig/keys.proto", fileDescriptor_46b57537e097d47d) }
 
 var fileDescriptor_46b57537e097d47d = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0xcf, 0x2d, 0xcd, 0x29, 0xc9, 0x2c,
-	0xce, 0x4c, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83,
-	0x28, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x29, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1,
-	0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4,
-	0xd2, 0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x88, 0x94, 0xd2, 0x62, 0x46, 0x2e, 0x41, 0x9f, 0xd4, 0xf4,
-	0xc4, 0xe4, 0x4a, 0xc7, 0xdc, 0xcc, 0xbc, 0xfc, 0x80, 0xd2, 0x24, 0xef, 0xd4, 0x4a, 0x21, 0x23,
-	0x2e, 0xce, 0x92, 0x8c, 0xa2, 0xd4, 0xe2, 0x8c, 0xfc, 0x9c, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x91, 0x4f, 0xf7, 0xe4, 0x05, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0xe0, 0x52, 0x4a,
-	0x41, 0x08, 0x65, 0x42, 0x21, 0x5c, 0xdc, 0x05, 0xa5, 0x49, 0x39, 0x99, 0xc9, 0xf1, 0x20, 0x77,
-	0x4a, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0xe8, 0x41, 0xac, 0xd6, 0x83, 0x59, 0xad, 0xe7,
-	0x98, 0x57, 0xe9, 0x24, 0xfb, 0xe8, 0x9e, 0x3c, 0x3b, 0xc4, 0xaa, 0xe2, 0x4f, 0xf7, 0xe4, 0xf9,
-	0x20, 0xc6, 0x16, 0x94, 0x26, 0x81, 0x74, 0x2a, 0x05, 0x71, 0x41, 0xcc, 0x01, 0xc9, 0x5a, 0xb1,
-	0x74, 0x2c, 0x90, 0x67, 0x70, 0xf2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x58, 0xb0, 0x81,
-	0x29, 0xdd, 0xe2, 0x94, 0x6c, 0x58, 0x08, 0x82, 0x8c, 0x85, 0x07, 0x63, 0x12, 0x1b, 0xd8, 0x2d,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0x6e, 0xbf, 0x3c, 0x67, 0x01, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x33, 0x7f, 0x7f, 0x2c, 0x4e, 0xa9, 0x68, 0x28, 0xa5, 0x16, 0x4c, 0x6a, 0x56, 0x5d,
+	0xe8, 0x0c, 0xd6, 0x5d, 0x77, 0xcd, 0xb6, 0x0a, 0x52, 0x04, 0xc1, 0x8d, 0x34, 0xe9, 0x38, 0x09,
+	0x4d, 0x7a, 0x43, 0x27, 0x83, 0xcc, 0x1b, 0xb8, 0xf4, 0x11, 0x04, 0x5f, 0xc6, 0x65, 0x57, 0xe2,
+	0xaa, 0x48, 0xf2, 0x06, 0x7d, 0x02, 0x49, 0xa6, 0x69, 0x5f, 0xc1, 0x55, 0x32, 0x9c, 0xef, 0xde,
+	0x73, 0x2e, 0x1c, 0x7c, 0xee, 0x83, 0x88, 0x41, 0x50, 0x7f, 0xa9, 0x92, 0x14, 0x68, 0x2c, 0xa3,
+	0x34, 0x14, 0x21, 0xa7, 0x73, 0xa6, 0x04, 0x49, 0x96, 0x90, 0x82, 0xd9, 0xd6, 0x08, 0xd1, 0x08,
+	0xa9, 0x90, 0x6e, 0x8b, 0x03, 0x87, 0x12, 0xa1, 0xc5, 0x9f, 0xa6, 0xbb, 0xa7, 0x1c, 0x80, 0x47,
+	0x8c, 0x96, 0x2f, 0x4f, 0x3e, 0xd3, 0xe9, 0x42, 0x69, 0xc9, 0xf9, 0x40, 0xf8, 0xe4, 0x86, 0xf1,
+	0xa9, 0xaf, 0x46, 0x71, 0xb8, 0x80, 0x3b, 0xe9, 0x8d, 0x99, 0x32, 0x07, 0xf8, 0x30, 0x0d, 0x96,
+	0x4c, 0x04, 0x10, 0xcd, 0x3a, 0xa8, 0x87, 0xfa, 0x4d, 0xb7, 0xb5, 0x59, 0xdb, 0xc7, 0x6a, 0x1a,
+	0x47, 0x43, 0x67, 0x27, 0x39, 0x93, 0x3d, 0x66, 0xde, 0xe3, 0x46, 0x22, 0xbd, 0x28, 0xf4, 0x9f,
+	0x8a, 0x9c, 0x9d, 0x7f, 0xbd, 0x5a, 0xbf, 0x31, 0x68, 0x11, 0x6d, 0x4d, 0x2a, 0x6b, 0x32, 0x5a,
+	0x28, 0xf7, 0x2c, 0x5b, 0xdb, 0x75, 0x6d, 0x25, 0x36, 0x6b, 0xfb, 0x48, 0xaf, 0x4d, 0xa4, 0x57,
+	0x4c, 0x3a, 0x13, 0xac, 0xf7, 0x14, 0xea, 0xf0, 0xff, 0xeb, 0xbb, 0x6d, 0x38, 0x5f, 0x08, 0xb7,
+	0x1f, 0x58, 0xc8, 0x83, 0x94, 0xcd, 0x6e, 0xb7, 0xb7, 0xfe, 0xb5, 0xa8, 0xe6, 0x05, 0xae, 0xbf,
+	0x94, 0x19, 0x45, 0xa7, 0xd6, 0xab, 0xf5, 0x9b, 0xae, 0xb9, 0x1f, 0xd8, 0x0a, 0xce, 0xa4, 0x42,
+	0xf4, 0x61, 0xee, 0xf8, 0x33, 0xb3, 0xd0, 0x2a, 0xb3, 0xd0, 0x4f, 0x66, 0xa1, 0xb7, 0xdc, 0x32,
+	0x56, 0xb9, 0x65, 0x7c, 0xe7, 0x96, 0xf1, 0x78, 0xc5, 0xc3, 0x34, 0x90, 0x1e, 0xf1, 0x21, 0xa6,
+	0x55, 0x1f, 0xca, 0xcf, 0xa5, 0x98, 0xcd, 0xab, 0x6a, 0x14, 0x21, 0x76, 0xfd, 0xf0, 0x0e, 0xca,
+	0xe4, 0xd7, 0xbf, 0x03, 0x00, 0xbc, 0xda, 0xa2, 0x62, 0x40, 0x02, 0x00, 0x00,
 }
 
 func (m *LegacyAminoPubKey) Marshal() (dAtA []byte, err error) {
@@ -135,6 +182,66 @@ func (m *LegacyAminoPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedMultisigPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedMultisigPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedMultisigPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		dAtA2 := make([]byte, len(m.Weights)*10)
+		var j1 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintKeys(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeys(v)
 	base := offset
@@ -164,6 +271,31 @@ func (m *LegacyAminoPubKey) Size() (n int) {
 	return n
 }
 
+func (m *WeightedMultisigPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovKeys(uint64(m.Threshold))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, e := range m.PubKeys {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovKeys(uint64(e))
+		}
+		n += 1 + sovKeys(uint64(l)) + l
+	}
+	return n
+}
+
 func sovKeys(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -273,6 +405,185 @@ func (m *LegacyAminoPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedMultisigPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedMultisigPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedMultisigPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, &types.Any{})
+			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeys
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthKeys
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthKeys
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeys
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -124,6 +124,10 @@ func (m *LegacyAminoPubKey) Equals(key cryptotypes.PubKey) bool {
 	if !ok {
 		return false
 	}
+	// the threshold of a weighted multisig is a weight
+	if _, ok := key.(multisigtypes.WeightedPubKey); ok {
+		return false
+	}
 	pubKeys := m.GetPubKeys()
 	otherPubKeys := otherKey.GetPubKeys()
 	if m.GetThreshold() != otherKey.GetThreshold() || len(pubKeys) != len(otherPubKeys) {
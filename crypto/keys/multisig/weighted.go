@@ -0,0 +1,223 @@
+package multisig
+
+import (
+	fmt "fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// WeightedPubKeyAminoRoute is the amino route of WeightedMultisigPubKey.
+const WeightedPubKeyAminoRoute = "cosmos-sdk/PubKeyWeightedMultisig"
+
+var (
+	_ multisigtypes.WeightedPubKey  = &WeightedMultisigPubKey{}
+	_ types.UnpackInterfacesMessage = &WeightedMultisigPubKey{}
+)
+
+// NewWeightedMultisigPubKey returns a new WeightedMultisigPubKey, a multisignature
+// being valid if the sum of the weights of its signers reaches the threshold.
+func NewWeightedMultisigPubKey(threshold uint32, pubKeys []cryptotypes.PubKey, weights []uint32) (*WeightedMultisigPubKey, error) {
+	anyPubKeys, err := packPubKeys(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &WeightedMultisigPubKey{Threshold: threshold, PubKeys: anyPubKeys, Weights: weights}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Validate returns an error if the threshold can't be reached or the weights
+// don't match the keys.
+func (m *WeightedMultisigPubKey) Validate() error {
+	if len(m.PubKeys) == 0 {
+		return fmt.Errorf("weighted multisignature has no keys")
+	}
+	if len(m.Weights) != len(m.PubKeys) {
+		return fmt.Errorf("weighted multisignature has %d weights for %d keys", len(m.Weights), len(m.PubKeys))
+	}
+	if m.Threshold == 0 {
+		return fmt.Errorf("weighted multisignature threshold must be positive")
+	}
+
+	var total uint64
+	for i, weight := range m.Weights {
+		if weight == 0 {
+			return fmt.Errorf("weight of key %d must be positive", i)
+		}
+		total += uint64(weight)
+	}
+
+	if total < uint64(m.Threshold) {
+		return fmt.Errorf("total weight %d is lower than the threshold %d", total, m.Threshold)
+	}
+
+	return nil
+}
+
+// Address implements cryptotypes.PubKey Address method
+func (m *WeightedMultisigPubKey) Address() cryptotypes.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoded version of the WeightedMultisigPubKey
+func (m *WeightedMultisigPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature method.
+// The signatures must be added in an order corresponding to the public keys order in
+// WeightedMultisigPubKey, and the sum of the weights of the signers must reach the
+// threshold.
+func (m *WeightedMultisigPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	bitarray := sig.BitArray
+	sigs := sig.Signatures
+	size := bitarray.Count()
+	pubKeys := m.GetPubKeys()
+	// ensure bit array is the correct size
+	if len(pubKeys) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(pubKeys))
+	}
+	// ensure there is one signature per set bit
+	if len(sigs) != bitarray.NumTrueBitsBefore(size) {
+		return fmt.Errorf("signature size is incorrect %d", len(sigs))
+	}
+
+	var weight uint64
+	for i := 0; i < size; i++ {
+		if bitarray.GetIndex(i) {
+			weight += uint64(m.Weights[i])
+		}
+	}
+	// ensure the weight of the signers reaches the threshold
+	if weight < uint64(m.Threshold) {
+		return fmt.Errorf("not enough signatures set, have weight %d, expected %d", weight, m.Threshold)
+	}
+
+	// index in the list of signatures which we are concerned with.
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if !bitarray.GetIndex(i) {
+			continue
+		}
+
+		switch si := sigs[sigIndex].(type) {
+		case *signing.SingleSignatureData:
+			msg, err := getSignBytes(si.SignMode)
+			if err != nil {
+				return err
+			}
+			if !pubKeys[i].VerifySignature(msg, si.Signature) {
+				return fmt.Errorf("unable to verify signature at index %d", i)
+			}
+		case *signing.MultiSignatureData:
+			nestedMultisigPk, ok := pubKeys[i].(multisigtypes.PubKey)
+			if !ok {
+				return fmt.Errorf("unable to parse pubkey of index %d", i)
+			}
+			if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("improper signature data type for index %d", sigIndex)
+		}
+		sigIndex++
+	}
+
+	return nil
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method,
+// it panics because it can't handle MultiSignatureData.
+func (m *WeightedMultisigPubKey) VerifySignature(msg []byte, sig []byte) bool {
+	panic("not implemented")
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method
+func (m *WeightedMultisigPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m == nil {
+		return nil
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+	for i := 0; i < len(m.PubKeys); i++ {
+		pubKeys[i] = m.PubKeys[i].GetCachedValue().(cryptotypes.PubKey)
+	}
+
+	return pubKeys
+}
+
+// GetWeights implements the WeightedPubKey.GetWeights method
+func (m *WeightedMultisigPubKey) GetWeights() []uint32 {
+	return m.Weights
+}
+
+// Equals returns true if other is a WeightedMultisigPubKey with the same
+// threshold, and the same keys and weights in the same order.
+func (m *WeightedMultisigPubKey) Equals(key cryptotypes.PubKey) bool {
+	other, ok := key.(*WeightedMultisigPubKey)
+	if !ok {
+		return false
+	}
+
+	pubKeys := m.GetPubKeys()
+	otherPubKeys := other.GetPubKeys()
+	if m.Threshold != other.Threshold || len(pubKeys) != len(otherPubKeys) || len(m.Weights) != len(other.Weights) {
+		return false
+	}
+
+	for i := 0; i < len(pubKeys); i++ {
+		if !pubKeys[i].Equals(otherPubKeys[i]) {
+			return false
+		}
+	}
+
+	for i := range m.Weights {
+		if m.Weights[i] != other.Weights[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetThreshold implements the PubKey.GetThreshold method, returning the
+// minimum sum of the weights of the signers.
+func (m *WeightedMultisigPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Type returns multisig type
+func (m *WeightedMultisigPubKey) Type() string {
+	return "PubKeyWeightedMultisig"
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *WeightedMultisigPubKey) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range m.PubKeys {
+		var pk cryptotypes.PubKey
+		err := unpacker.UnpackAny(any, &pk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestNewWeightedMultisigPubKey(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+
+	testCases := []struct {
+		msg       string
+		threshold uint32
+		pubKeys   []cryptotypes.PubKey
+		weights   []uint32
+		expectErr bool
+	}{
+		{"valid", 4, pubKeys, []uint32{3, 1, 1}, false},
+		{"threshold of the total weight", 5, pubKeys, []uint32{3, 1, 1}, false},
+		{"threshold above the total weight", 6, pubKeys, []uint32{3, 1, 1}, true},
+		{"zero threshold", 0, pubKeys, []uint32{3, 1, 1}, true},
+		{"zero weight", 1, pubKeys, []uint32{3, 0, 1}, true},
+		{"missing weight", 1, pubKeys, []uint32{3, 1}, true},
+		{"no keys", 1, nil, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			pk, err := kmultisig.NewWeightedMultisigPubKey(tc.threshold, tc.pubKeys, tc.weights)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint(tc.threshold), pk.GetThreshold())
+				require.Equal(t, tc.weights, pk.GetWeights())
+				require.Len(t, pk.Address().Bytes(), 32)
+			}
+		})
+	}
+}
+
+func TestWeightedEquals(t *testing.T) {
+	pubKeys := generatePubKeys(2)
+
+	pk, err := kmultisig.NewWeightedMultisigPubKey(2, pubKeys, []uint32{1, 1})
+	require.NoError(t, err)
+	same, err := kmultisig.NewWeightedMultisigPubKey(2, pubKeys, []uint32{1, 1})
+	require.NoError(t, err)
+	otherWeights, err := kmultisig.NewWeightedMultisigPubKey(2, pubKeys, []uint32{2, 1})
+	require.NoError(t, err)
+	legacyPk := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	require.True(t, pk.Equals(same))
+	require.False(t, pk.Equals(otherWeights))
+	require.False(t, pk.Equals(legacyPk))
+	require.False(t, legacyPk.Equals(pk))
+	require.NotEqual(t, pk.Address(), otherWeights.Address())
+}
+
+func TestVerifyWeightedMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(_ signing.SignMode) ([]byte, error) { return msg, nil }
+
+	// a CEO of weight 3 and three board members of weight 1
+	pubKeys, sigs := generatePubKeysAndSignatures(4, msg)
+	pk, err := kmultisig.NewWeightedMultisigPubKey(5, pubKeys, []uint32{3, 1, 1, 1})
+	require.NoError(t, err)
+
+	newSig := func(signers ...int) *signing.MultiSignatureData {
+		sig := multisig.NewMultisig(len(pubKeys))
+		for _, i := range signers {
+			require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[i], pubKeys[i], pubKeys))
+		}
+		return sig
+	}
+
+	require.NoError(t, pk.VerifyMultisignature(signBytesFn, newSig(0, 1, 2)))
+	require.NoError(t, pk.VerifyMultisignature(signBytesFn, newSig(3, 0, 2)))
+	require.NoError(t, pk.VerifyMultisignature(signBytesFn, newSig(0, 1, 2, 3)))
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, newSig(0, 1)))
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, newSig(1, 2, 3)))
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, newSig()))
+
+	// an invalid signature
+	sig := newSig(0, 1, 2)
+	sig.Signatures[1] = sigs[3]
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// a bit array of the wrong size
+	sig = multisig.NewMultisig(3)
+	multisig.AddSignature(sig, sigs[0], 0)
+	multisig.AddSignature(sig, sigs[1], 1)
+	multisig.AddSignature(sig, sigs[2], 2)
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+
+	// more signatures than set bits
+	sig = newSig(0, 1, 2)
+	sig.Signatures = append(sig.Signatures, sigs[3])
+	require.Error(t, pk.VerifyMultisignature(signBytesFn, sig))
+}
+
+func TestVerifyNestedWeightedMultisignature(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(_ signing.SignMode) ([]byte, error) { return msg, nil }
+
+	// a weighted multisig nested in a legacy one
+	nestedPubKeys, nestedSigs := generatePubKeysAndSignatures(3, msg)
+	nestedPk, err := kmultisig.NewWeightedMultisigPubKey(3, nestedPubKeys, []uint32{2, 1, 1})
+	require.NoError(t, err)
+	nestedSig := multisig.NewMultisig(3)
+	multisig.AddSignature(nestedSig, nestedSigs[0], 0)
+	multisig.AddSignature(nestedSig, nestedSigs[2], 2)
+
+	pubKeys, sigs := generatePubKeysAndSignatures(2, msg)
+	legacyPk := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{nestedPk, pubKeys[0], pubKeys[1]})
+	sig := multisig.NewMultisig(3)
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, nestedSig, nestedPk, legacyPk.GetPubKeys()))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[1], pubKeys[1], legacyPk.GetPubKeys()))
+	require.NoError(t, legacyPk.VerifyMultisignature(signBytesFn, sig))
+
+	// a legacy multisig nested in a weighted one
+	weightedPk, err := kmultisig.NewWeightedMultisigPubKey(3, []cryptotypes.PubKey{legacyPk, pubKeys[0]}, []uint32{2, 1})
+	require.NoError(t, err)
+	outerSig := multisig.NewMultisig(2)
+	multisig.AddSignature(outerSig, sig, 0)
+	require.Error(t, weightedPk.VerifyMultisignature(signBytesFn, outerSig))
+	multisig.AddSignature(outerSig, sigs[0], 1)
+	require.NoError(t, weightedPk.VerifyMultisignature(signBytesFn, outerSig))
+
+	// the nested multisignature must reach its own threshold
+	nestedSig = multisig.NewMultisig(3)
+	multisig.AddSignature(nestedSig, nestedSigs[1], 1)
+	multisig.AddSignature(nestedSig, nestedSigs[2], 2)
+	sig = multisig.NewMultisig(3)
+	multisig.AddSignature(sig, nestedSig, 0)
+	multisig.AddSignature(sig, sigs[1], 2)
+	require.Error(t, legacyPk.VerifyMultisignature(signBytesFn, sig))
+}
+
+func TestWeightedEncoding(t *testing.T) {
+	pubKeys := generatePubKeys(2)
+	nested := kmultisig.NewLegacyAminoPubKey(1, pubKeys)
+	pk, err := kmultisig.NewWeightedMultisigPubKey(3, []cryptotypes.PubKey{pubKeys[0], nested}, []uint32{1, 2})
+	require.NoError(t, err)
+
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	// proto JSON
+	bz, err := cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	var jsonPk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &jsonPk))
+	require.True(t, pk.Equals(jsonPk))
+
+	// proto binary
+	bz, err = cdc.MarshalInterface(pk)
+	require.NoError(t, err)
+	var protoPk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &protoPk))
+	require.True(t, pk.Equals(protoPk))
+	require.Equal(t, pk.Address(), protoPk.Address())
+
+	// amino binary
+	bz, err = legacy.Cdc.Marshal(pk)
+	require.NoError(t, err)
+	var aminoPk cryptotypes.PubKey
+	require.NoError(t, legacy.Cdc.Unmarshal(bz, &aminoPk))
+	require.Equal(t, pk.Weights, aminoPk.(*kmultisig.WeightedMultisigPubKey).Weights)
+
+	// keyring
+	kr := keyring.NewInMemory()
+	info, err := kr.SaveMultisig("weighted", pk)
+	require.NoError(t, err)
+	require.Equal(t, pk.Address().Bytes(), info.GetAddress().Bytes())
+	info, err = kr.Key("weighted")
+	require.NoError(t, err)
+	require.True(t, pk.Equals(info.GetPubKey()))
+}
@@ -0,0 +1,27 @@
+// Package webauthn implements Cosmos-SDK compatible public keys of WebAuthn
+// credentials, such as the passkeys of browsers and platform authenticators:
+// https://www.w3.org/TR/webauthn-2/
+//
+// An authenticator doesn't sign a message, but the concatenation of its
+// authenticator data and of the SHA-256 hash of the client data JSON, whose
+// challenge is set by the client. The signature of a tx is the proto encoded
+// Signature of an assertion, whose challenge is the SHA-256 hash of the sign
+// bytes of the tx. The keys can be protobuf serialized and packed in Any.
+package webauthn
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// PubKeySize is the size, in bytes, of the compressed secp256r1 key of a PubKey.
+	PubKeySize = 33
+
+	keyType = "webauthn"
+)
+
+// RegisterInterfaces adds webauthn PubKey to pubkey registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
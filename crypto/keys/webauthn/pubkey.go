@@ -0,0 +1,144 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// assertionType is the type of the client data of an assertion.
+	assertionType = "webauthn.get"
+	// flagUserPresent is the flag of the authenticator data set when the user
+	// was present.
+	flagUserPresent = 0x01
+	// authenticatorDataMinSize is the size of the authenticator data without
+	// extensions: the RP ID hash, the flags and the signature counter.
+	authenticatorDataMinSize = sha256.Size + 1 + 4
+)
+
+var _ cryptotypes.PubKey = &PubKey{}
+
+// clientData is the part of the client data JSON checked by VerifySignature.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// Address implements SDK PubKey interface, returning the ADR-28 address of the
+// key and of its relying party ID.
+func (pubKey *PubKey) Address() tmcrypto.Address {
+	return address.Hash(proto.MessageName(pubKey), pubKey.Bytes())
+}
+
+// Bytes returns the proto encoded version of the PubKey.
+func (pubKey *PubKey) Bytes() []byte {
+	if pubKey == nil {
+		return nil
+	}
+
+	bz, err := pubKey.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifySignature implements SDK PubKey interface. sig is a proto encoded
+// Signature, which is valid if:
+//   - the client data is of an assertion whose challenge is the base64url
+//     encoded SHA-256 hash of msg,
+//   - the authenticator data is scoped to the relying party ID of the key and
+//     has the user present flag set,
+//   - the signature of the authenticator data and of the SHA-256 hash of the
+//     client data JSON is valid.
+//
+// As authenticators don't normalize their ECDSA signatures, both s and -s are
+// accepted. Their signature counter isn't checked, the account sequence
+// already preventing replays.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+
+	var data clientData
+	if err := json.Unmarshal(assertion.ClientDataJSON, &data); err != nil {
+		return false
+	}
+	challenge := sha256.Sum256(msg)
+	if data.Type != assertionType || data.Challenge != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+		return false
+	}
+
+	authData := assertion.AuthenticatorData
+	rpIDHash := sha256.Sum256([]byte(pubKey.RPID))
+	if len(authData) < authenticatorDataMinSize || !bytes.Equal(authData[:sha256.Size], rpIDHash[:]) ||
+		authData[sha256.Size]&flagUserPresent == 0 {
+		return false
+	}
+
+	key, ok := pubKey.ecdsaKey()
+	if !ok {
+		return false
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	h := sha256.New()
+	h.Write(authData)
+	h.Write(clientDataHash[:])
+
+	return ecdsa.VerifyASN1(key, h.Sum(nil), assertion.Signature)
+}
+
+// ecdsaKey returns the secp256r1 key of pubKey, or false if it isn't a point
+// of the curve.
+func (pubKey *PubKey) ecdsaKey() (*ecdsa.PublicKey, bool) {
+	if len(pubKey.Key) != PubKeySize {
+		return nil, false
+	}
+
+	curve := elliptic.P256()
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey.Key)
+	if x == nil {
+		return nil, false
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, true
+}
+
+// String returns the hex representation of the key and its relying party ID.
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyWebAuthn{%X, %s}", pubKey.Key, pubKey.RPID)
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+// Equals returns true if other is a PubKey with the same key and relying party ID.
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*PubKey)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(pubKey.Key, pk2.Key) && pubKey.RPID == pk2.RPID
+}
+
+// String implements proto.Message interface.
+func (m *Signature) String() string {
+	return fmt.Sprintf("SignatureWebAuthn{%X, %s, %X}", m.AuthenticatorData, m.ClientDataJSON, m.Signature)
+}
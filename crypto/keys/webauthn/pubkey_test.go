@@ -0,0 +1,150 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// authenticator is a platform authenticator holding the credential of a
+// relying party.
+type authenticator struct {
+	key  *ecdsa.PrivateKey
+	rpID string
+}
+
+func newAuthenticator(t *testing.T, rpID string) authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return authenticator{key: key, rpID: rpID}
+}
+
+func (a authenticator) pubKey() *webauthn.PubKey {
+	return &webauthn.PubKey{Key: elliptic.MarshalCompressed(elliptic.P256(), a.key.X, a.key.Y), RPID: a.rpID}
+}
+
+// assert returns the assertion of the client data, as returned by
+// navigator.credentials.get.
+func (a authenticator) assert(t *testing.T, flags byte, clientDataJSON string) *webauthn.Signature {
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	authData := append(rpIDHash[:], flags, 0, 0, 0, 42)
+
+	clientDataHash := sha256.Sum256([]byte(clientDataJSON))
+	h := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, h[:])
+	require.NoError(t, err)
+
+	return &webauthn.Signature{AuthenticatorData: authData, ClientDataJSON: []byte(clientDataJSON), Signature: sig}
+}
+
+func clientDataJSON(typ string, msg []byte) string {
+	challenge := sha256.Sum256(msg)
+	return fmt.Sprintf(`{"type":%q,"challenge":%q,"origin":"https://wallet.example.com","crossOrigin":false}`,
+		typ, base64.RawURLEncoding.EncodeToString(challenge[:]))
+}
+
+func marshal(t *testing.T, sig *webauthn.Signature) []byte {
+	bz, err := sig.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestVerifySignature(t *testing.T) {
+	msg := []byte("sign bytes")
+	a := newAuthenticator(t, "wallet.example.com")
+	pubKey := a.pubKey()
+	require.Len(t, pubKey.Key, webauthn.PubKeySize)
+
+	sig := a.assert(t, 0x05, clientDataJSON("webauthn.get", msg))
+	require.True(t, pubKey.VerifySignature(msg, marshal(t, sig)))
+
+	// a signature whose s isn't normalized
+	var ecdsaSig struct{ R, S *big.Int }
+	_, err := asn1.Unmarshal(sig.Signature, &ecdsaSig)
+	require.NoError(t, err)
+	ecdsaSig.S.Sub(elliptic.P256().Params().N, ecdsaSig.S)
+	highS := *sig
+	highS.Signature, err = asn1.Marshal(ecdsaSig)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, marshal(t, &highS)))
+
+	challenge := sha256.Sum256(msg)
+	paddedClientDataJSON := `{"type":"webauthn.get","challenge":"` + base64.URLEncoding.EncodeToString(challenge[:]) + `"}`
+
+	testCases := []struct {
+		name   string
+		pubKey *webauthn.PubKey
+		msg    []byte
+		sig    []byte
+	}{
+		{"other sign bytes", pubKey, []byte("other sign bytes"), marshal(t, sig)},
+		{"other relying party", &webauthn.PubKey{Key: pubKey.Key, RPID: "evil.example.com"}, msg, marshal(t, sig)},
+		{"other credential", newAuthenticator(t, a.rpID).pubKey(), msg, marshal(t, sig)},
+		{"invalid key", &webauthn.PubKey{Key: make([]byte, webauthn.PubKeySize), RPID: a.rpID}, msg, marshal(t, sig)},
+		{"credential creation", pubKey, msg, marshal(t, a.assert(t, 0x05, clientDataJSON("webauthn.create", msg)))},
+		{"user not present", pubKey, msg, marshal(t, a.assert(t, 0x04, clientDataJSON("webauthn.get", msg)))},
+		{"padded challenge", pubKey, msg, marshal(t, a.assert(t, 0x01, paddedClientDataJSON))},
+		{"invalid client data", pubKey, msg, marshal(t, a.assert(t, 0x01, "{"))},
+		{"short authenticator data", pubKey, msg, marshal(t, &webauthn.Signature{
+			AuthenticatorData: sig.AuthenticatorData[:36], ClientDataJSON: sig.ClientDataJSON, Signature: sig.Signature,
+		})},
+		{"raw signature", pubKey, msg, sig.Signature},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.False(t, tc.pubKey.VerifySignature(tc.msg, tc.sig))
+		})
+	}
+}
+
+func TestPubKey(t *testing.T) {
+	a := newAuthenticator(t, "wallet.example.com")
+	pubKey := a.pubKey()
+	other := &webauthn.PubKey{Key: pubKey.Key, RPID: "other.example.com"}
+
+	require.True(t, pubKey.Equals(a.pubKey()))
+	require.False(t, pubKey.Equals(other))
+	require.Len(t, pubKey.Address(), 32)
+	require.NotEqual(t, pubKey.Address(), other.Address())
+
+	// the secp256r1 key of the credential has another address
+	var r1PubKey secp256r1.PubKey
+	require.NoError(t, r1PubKey.Unmarshal(append([]byte{0x0a, byte(len(pubKey.Key))}, pubKey.Key...)))
+	require.False(t, pubKey.Equals(&r1PubKey))
+	require.NotEqual(t, pubKey.Address(), r1PubKey.Address())
+}
+
+func TestEncoding(t *testing.T) {
+	pubKey := newAuthenticator(t, "wallet.example.com").pubKey()
+
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterface(pubKey)
+	require.NoError(t, err)
+	var pk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &pk))
+	require.True(t, pubKey.Equals(pk))
+
+	bz, err = cdc.MarshalInterfaceJSON(pubKey)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &pk))
+	require.True(t, pubKey.Equals(pk))
+}
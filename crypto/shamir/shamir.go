@@ -0,0 +1,186 @@
+// Package shamir splits secrets, such as the entropy of BIP39 mnemonics and
+// private keys, into shares with Shamir's secret sharing scheme over GF(256),
+// so that any threshold of the shares recovers the secret while fewer shares
+// reveal nothing about it.
+//
+// Every byte of the secret is the constant term of a random polynomial whose
+// degree is the threshold minus one, and a share holds the evaluations of the
+// polynomials at its index. A digest of the secret is shared along with it, so
+// that recovering from inconsistent shares is detected.
+//
+// Shares are encoded as words of the BIP39 English wordlist, see Share.
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+const (
+	// MaxShares is the maximum number of shares of a secret.
+	MaxShares = 255
+
+	// MaxSecretLen is the maximum length of a secret, in bytes.
+	MaxSecretLen = 64
+
+	// digestLen is the length of the digest of the secret shared along with it.
+	digestLen = 4
+)
+
+// Split splits the secret into count shares, any threshold of which recover
+// it.
+func Split(secretType SecretType, secret []byte, threshold, count int) ([]Share, error) {
+	if err := secretType.validate(secret); err != nil {
+		return nil, err
+	}
+
+	if threshold < 2 {
+		return nil, errors.New("the threshold must be at least 2")
+	}
+	if count < threshold || count > MaxShares {
+		return nil, fmt.Errorf("the number of shares must be between the threshold and %d", MaxShares)
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	value := append(append([]byte{}, secret...), secretDigest(secret)...)
+
+	// coefficients[i] are the coefficients of the polynomial of value[i]
+	// of degree 1 to threshold-1.
+	coefficients := make([][]byte, len(value))
+	for i := range coefficients {
+		coefficients[i] = make([]byte, threshold-1)
+		if _, err := rand.Read(coefficients[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	shares := make([]Share, count)
+	for i := range shares {
+		x := byte(i + 1)
+
+		shares[i] = Share{
+			Version:    ShareVersion,
+			SecretType: secretType,
+			ID:         uint16(id[0])<<8 | uint16(id[1]),
+			Threshold:  uint8(threshold),
+			Index:      x,
+			Value:      make([]byte, len(value)),
+		}
+
+		for j, intercept := range value {
+			shares[i].Value[j] = evaluate(intercept, coefficients[j], x)
+		}
+	}
+
+	return shares, nil
+}
+
+// Combine recovers the secret split into the given shares, of which there must
+// be at least the threshold.
+func Combine(shares []Share) (SecretType, []byte, error) {
+	if len(shares) == 0 {
+		return 0, nil, errors.New("no shares")
+	}
+
+	first := shares[0]
+	if len(shares) < int(first.Threshold) {
+		return 0, nil, fmt.Errorf("%d shares are required, got %d", first.Threshold, len(shares))
+	}
+
+	seen := make(map[byte]bool, len(shares))
+	for _, share := range shares {
+		if err := share.Validate(); err != nil {
+			return 0, nil, err
+		}
+
+		if share.ID != first.ID || share.SecretType != first.SecretType ||
+			share.Threshold != first.Threshold || len(share.Value) != len(first.Value) {
+			return 0, nil, errors.New("the shares do not belong to the same secret")
+		}
+
+		if seen[share.Index] {
+			return 0, nil, fmt.Errorf("duplicate share %d", share.Index)
+		}
+		seen[share.Index] = true
+	}
+
+	shares = shares[:first.Threshold]
+
+	value := make([]byte, len(first.Value))
+	for i := range value {
+		value[i] = interpolate(shares, i)
+	}
+
+	secret, digest := value[:len(value)-digestLen], value[len(value)-digestLen:]
+	if !bytes.Equal(digest, secretDigest(secret)) {
+		return 0, nil, errors.New("invalid shares: the digest of the recovered secret does not match")
+	}
+
+	return first.SecretType, secret, nil
+}
+
+// secretDigest returns the digest of the secret shared along with it.
+func secretDigest(secret []byte) []byte {
+	digest := sha256.Sum256(secret)
+	return digest[:digestLen]
+}
+
+// evaluate returns the value at x of the polynomial with the given intercept
+// and coefficients of degree 1 and above.
+func evaluate(intercept byte, coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coefficients[i]
+	}
+
+	return mul(y, x) ^ intercept
+}
+
+// interpolate returns the intercept of the polynomial of the i-th byte of the
+// values of the shares, by Lagrange interpolation at 0.
+func interpolate(shares []Share, i int) byte {
+	var y byte
+	for j, sj := range shares {
+		basis := byte(1)
+		for k, sk := range shares {
+			if k != j {
+				basis = mul(basis, div(sk.Index, sk.Index^sj.Index))
+			}
+		}
+
+		y ^= mul(sj.Value[i], basis)
+	}
+
+	return y
+}
+
+// mul multiplies in GF(256) with the reduction polynomial of AES,
+// x^8 + x^4 + x^3 + x + 1, without branching on the operands.
+func mul(a, b byte) byte {
+	var p byte
+	for i := 0; i < 8; i++ {
+		p ^= a & -(b & 1)
+		a = a<<1 ^ 0x1b&-(a>>7)
+		b >>= 1
+	}
+
+	return p
+}
+
+// div divides in GF(256), b being non-zero, multiplying a by the inverse
+// b^254 of b.
+func div(a, b byte) byte {
+	inv := b
+	for i := 0; i < 6; i++ {
+		inv = mul(mul(inv, inv), b)
+	}
+
+	return mul(a, mul(inv, inv))
+}
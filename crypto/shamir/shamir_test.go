@@ -0,0 +1,175 @@
+package shamir
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGF256(t *testing.T) {
+	// test vector of the AES specification
+	require.Equal(t, byte(0xc1), mul(0x57, 0x83))
+
+	for a := 1; a < 256; a++ {
+		require.Equal(t, byte(1), div(byte(a), byte(a)))
+		for b := 1; b < 256; b += 7 {
+			require.Equal(t, byte(a), div(mul(byte(a), byte(b)), byte(b)))
+		}
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := bytes.Repeat([]byte{0x42}, 32)
+
+	shares, err := Split(SecretSecp256k1, secret, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		var sub []Share
+		for _, i := range subset {
+			sub = append(sub, shares[i])
+		}
+
+		secretType, recovered, err := Combine(sub)
+		require.NoError(t, err)
+		require.Equal(t, SecretSecp256k1, secretType)
+		require.Equal(t, secret, recovered)
+	}
+
+	_, _, err = Combine(shares[:2])
+	require.EqualError(t, err, "3 shares are required, got 2")
+
+	_, _, err = Combine([]Share{shares[0], shares[1], shares[0]})
+	require.EqualError(t, err, "duplicate share 1")
+
+	other, err := Split(SecretSecp256k1, secret, 3, 5)
+	require.NoError(t, err)
+	if other[0].ID != shares[0].ID {
+		_, _, err = Combine([]Share{shares[0], shares[1], other[2]})
+		require.EqualError(t, err, "the shares do not belong to the same secret")
+	}
+
+	// A corrupted share value is detected by the digest of the secret.
+	corrupted := shares[2]
+	corrupted.Value = append([]byte{}, corrupted.Value...)
+	corrupted.Value[0] ^= 1
+	_, _, err = Combine([]Share{shares[0], shares[1], corrupted})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "digest")
+}
+
+func TestSplitErrors(t *testing.T) {
+	secret := bytes.Repeat([]byte{0x42}, 32)
+
+	_, err := Split(SecretSecp256k1, secret, 1, 3)
+	require.Error(t, err)
+	_, err = Split(SecretSecp256k1, secret, 3, 2)
+	require.Error(t, err)
+	_, err = Split(SecretSecp256k1, secret, 3, 256)
+	require.Error(t, err)
+	_, err = Split(SecretSecp256k1, secret[:31], 2, 3)
+	require.Error(t, err)
+	_, err = Split(SecretMnemonic, secret[:15], 2, 3)
+	require.Error(t, err)
+	_, err = Split(SecretType(7), secret, 2, 3)
+	require.Error(t, err)
+}
+
+func TestShareEncoding(t *testing.T) {
+	for _, size := range []int{128, 160, 192, 224, 256} {
+		entropy, err := bip39.NewEntropy(size)
+		require.NoError(t, err)
+
+		shares, err := Split(SecretMnemonic, entropy, 2, 3)
+		require.NoError(t, err)
+
+		for _, share := range shares {
+			parsed, err := ParseShare(share.String())
+			require.NoError(t, err)
+			require.Equal(t, share, parsed)
+		}
+
+		// Words are case and whitespace insensitive.
+		parsed, err := ParseShare("  " + strings.ToUpper(strings.ReplaceAll(shares[0].String(), " ", "\t ")) + "\n")
+		require.NoError(t, err)
+		require.Equal(t, shares[0], parsed)
+	}
+}
+
+func TestParseShareErrors(t *testing.T) {
+	shares, err := Split(SecretSecp256k1, bytes.Repeat([]byte{0x42}, 32), 2, 3)
+	require.NoError(t, err)
+	words := strings.Fields(shares[0].String())
+
+	_, err = ParseShare("")
+	require.EqualError(t, err, "empty share")
+
+	_, err = ParseShare("abandon ability")
+	require.EqualError(t, err, "the share is too short")
+
+	_, err = ParseShare(strings.Join(append(append([]string{}, words[:3]...), append([]string{"notaword"}, words[4:]...)...), " "))
+	require.EqualError(t, err, `invalid share word "notaword"`)
+
+	_, err = ParseShare(strings.Join(words[:len(words)-1], " "))
+	require.Error(t, err)
+
+	_, err = ParseShare(strings.Join(append(words, "abandon"), " "))
+	require.Error(t, err)
+
+	// Changing any word breaks the checksum.
+	for i := range words {
+		changed := append([]string{}, words...)
+		if changed[i] == "zoo" {
+			changed[i] = "abandon"
+		} else {
+			changed[i] = "zoo"
+		}
+
+		_, err = ParseShare(strings.Join(changed, " "))
+		require.Error(t, err, i)
+	}
+
+	// Swapping two distinct words breaks the checksum.
+	swapped := append([]string{}, words...)
+	for i := 0; i < len(swapped)-1; i++ {
+		if swapped[i] != swapped[i+1] {
+			swapped[i], swapped[i+1] = swapped[i+1], swapped[i]
+			break
+		}
+	}
+	_, err = ParseShare(strings.Join(swapped, " "))
+	require.Error(t, err)
+
+	// A share with a valid checksum but an unsupported version is rejected.
+	share := shares[0]
+	share.Version = 1
+	_, err = ParseShare(share.String())
+	require.EqualError(t, err, "unsupported share version 1")
+
+	share = shares[0]
+	share.Index = 0
+	_, err = ParseShare(share.String())
+	require.EqualError(t, err, "invalid share index 0")
+}
+
+func TestMnemonicEntropy(t *testing.T) {
+	for _, size := range []int{128, 160, 192, 224, 256} {
+		entropy, err := bip39.NewEntropy(size)
+		require.NoError(t, err)
+		entropy[0] = 0 // leading zeros are kept
+
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		require.NoError(t, err)
+
+		got, err := MnemonicEntropy(mnemonic)
+		require.NoError(t, err)
+		require.Equal(t, entropy, got)
+	}
+
+	_, err := MnemonicEntropy("abandon abandon abandon")
+	require.Error(t, err)
+}
@@ -0,0 +1,226 @@
+package shamir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	bip39 "github.com/cosmos/go-bip39"
+)
+
+// ShareVersion is the version of the encoding of the shares.
+const ShareVersion = 0
+
+const (
+	// shareHeaderLen is the length of the version, secret type, identifier,
+	// threshold, index and value length of a share.
+	shareHeaderLen = 7
+
+	// checksumLen is the length of the checksum of an encoded share.
+	checksumLen = 4
+
+	// bitsPerWord is the number of bits encoded by a BIP39 word.
+	bitsPerWord = 11
+)
+
+// SecretType is the type of a secret split into shares.
+type SecretType byte
+
+const (
+	// SecretMnemonic is the entropy of a BIP39 mnemonic.
+	SecretMnemonic SecretType = iota
+	// SecretSecp256k1 is a secp256k1 private key.
+	SecretSecp256k1
+)
+
+// String implements fmt.Stringer.
+func (t SecretType) String() string {
+	switch t {
+	case SecretMnemonic:
+		return "mnemonic"
+	case SecretSecp256k1:
+		return "secp256k1"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(t))
+	}
+}
+
+// validate returns an error if the secret is invalid for the type.
+func (t SecretType) validate(secret []byte) error {
+	switch t {
+	case SecretMnemonic:
+		if len(secret) < 16 || len(secret) > 32 || len(secret)%4 != 0 {
+			return fmt.Errorf("invalid mnemonic entropy length %d", len(secret))
+		}
+	case SecretSecp256k1:
+		if len(secret) != 32 {
+			return fmt.Errorf("invalid secp256k1 private key length %d", len(secret))
+		}
+	default:
+		return fmt.Errorf("unknown secret type %d", byte(t))
+	}
+
+	if len(secret) > MaxSecretLen {
+		return fmt.Errorf("the secret must not be longer than %d bytes", MaxSecretLen)
+	}
+
+	return nil
+}
+
+// Share is a share of a secret split by Split.
+//
+// A share is encoded as words of the BIP39 English wordlist, each encoding 11
+// bits of the concatenation of:
+//
+//	version (1 byte) | secret type (1 byte) | identifier (2 bytes) |
+//	threshold (1 byte) | index (1 byte) | value length (1 byte) |
+//	value | checksum (4 bytes)
+//
+// padded with zero bits. The checksum is the first bytes of the SHA-256 hash
+// of the preceding bytes. Unlike BIP39 mnemonics, the words of a share do not
+// form a valid mnemonic.
+type Share struct {
+	Version    uint8
+	SecretType SecretType
+	// ID identifies the shares of a same secret.
+	ID        uint16
+	Threshold uint8
+	// Index is the point the polynomials are evaluated at, from 1.
+	Index uint8
+	// Value are the evaluations of the polynomials of the bytes of the secret
+	// and of its digest.
+	Value []byte
+}
+
+// Validate returns an error if the share is invalid.
+func (s Share) Validate() error {
+	if s.Version != ShareVersion {
+		return fmt.Errorf("unsupported share version %d", s.Version)
+	}
+
+	if s.Threshold < 2 {
+		return fmt.Errorf("invalid share threshold %d", s.Threshold)
+	}
+
+	if s.Index == 0 {
+		return errors.New("invalid share index 0")
+	}
+
+	if len(s.Value) <= digestLen {
+		return fmt.Errorf("invalid share value length %d", len(s.Value))
+	}
+
+	return s.SecretType.validate(s.Value[:len(s.Value)-digestLen])
+}
+
+// String returns the words encoding the share.
+func (s Share) String() string {
+	bz := s.bytes()
+	bz = append(bz, checksum(bz)...)
+
+	count := (len(bz)*8 + bitsPerWord - 1) / bitsPerWord
+
+	// shift the bytes so that the padding bits are the last ones
+	n := new(big.Int).SetBytes(bz)
+	n.Lsh(n, uint(count*bitsPerWord-len(bz)*8))
+
+	words := make([]string, count)
+	mask := big.NewInt(1<<bitsPerWord - 1)
+	for i := count - 1; i >= 0; i-- {
+		words[i] = bip39.EnglishWordList[new(big.Int).And(n, mask).Int64()]
+		n.Rsh(n, bitsPerWord)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// bytes returns the encoding of the share without its checksum.
+func (s Share) bytes() []byte {
+	bz := make([]byte, 0, shareHeaderLen+len(s.Value)+checksumLen)
+	bz = append(bz, s.Version, byte(s.SecretType), byte(s.ID>>8), byte(s.ID), s.Threshold, s.Index, byte(len(s.Value)))
+
+	return append(bz, s.Value...)
+}
+
+// ParseShare parses and validates the share encoded by the given words.
+func ParseShare(words string) (Share, error) {
+	fields := strings.Fields(strings.ToLower(words))
+	if len(fields) == 0 {
+		return Share{}, errors.New("empty share")
+	}
+
+	n := new(big.Int)
+	for _, word := range fields {
+		index, ok := bip39.ReverseWordMap[word]
+		if !ok {
+			return Share{}, fmt.Errorf("invalid share word %q", word)
+		}
+
+		n.Lsh(n, bitsPerWord)
+		n.Or(n, big.NewInt(int64(index)))
+	}
+
+	totalBits := len(fields) * bitsPerWord
+	if totalBits < (shareHeaderLen+checksumLen)*8 {
+		return Share{}, errors.New("the share is too short")
+	}
+
+	// the length of the value gives the number of padding bits
+	valueLen := int(new(big.Int).Rsh(n, uint(totalBits-shareHeaderLen*8)).Int64() & 0xff)
+	byteLen := shareHeaderLen + valueLen + checksumLen
+	if (byteLen*8+bitsPerWord-1)/bitsPerWord != len(fields) {
+		return Share{}, fmt.Errorf("invalid share length of %d words", len(fields))
+	}
+
+	padding := uint(totalBits - byteLen*8)
+	if new(big.Int).And(n, big.NewInt(1<<padding-1)).Sign() != 0 {
+		return Share{}, errors.New("invalid share padding")
+	}
+
+	bz := n.Rsh(n, padding).FillBytes(make([]byte, byteLen))
+	payload, sum := bz[:byteLen-checksumLen], bz[byteLen-checksumLen:]
+	if !bytes.Equal(sum, checksum(payload)) {
+		return Share{}, errors.New("invalid share checksum")
+	}
+
+	share := Share{
+		Version:    payload[0],
+		SecretType: SecretType(payload[1]),
+		ID:         uint16(payload[2])<<8 | uint16(payload[3]),
+		Threshold:  payload[4],
+		Index:      payload[5],
+		Value:      payload[shareHeaderLen:],
+	}
+
+	return share, share.Validate()
+}
+
+// checksum returns the checksum of the encoding of a share.
+func checksum(bz []byte) []byte {
+	sum := sha256.Sum256(bz)
+	return sum[:checksumLen]
+}
+
+// MnemonicEntropy returns the entropy encoded by a BIP39 mnemonic.
+func MnemonicEntropy(mnemonic string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if _, err := bip39.MnemonicToByteArray(mnemonic); err != nil {
+		return nil, err
+	}
+
+	words := strings.Split(mnemonic, " ")
+	n := new(big.Int)
+	for _, word := range words {
+		n.Lsh(n, bitsPerWord)
+		n.Or(n, big.NewInt(int64(bip39.ReverseWordMap[word])))
+	}
+
+	// drop the checksum of one bit per 32 bits of entropy
+	entropyBits := len(words) * bitsPerWord * 32 / 33
+	n.Rsh(n, uint(len(words)*bitsPerWord-entropyBits))
+
+	return n.FillBytes(make([]byte, entropyBits/8)), nil
+}
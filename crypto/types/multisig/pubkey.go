@@ -25,3 +25,23 @@ type PubKey interface {
 // It will generally be implemented as a closure which wraps whatever signable object signatures are
 // being verified against.
 type GetSignBytesFunc func(mode signing.SignMode) ([]byte, error)
+
+// WeightedPubKey defines a multi-signature PubKey whose nested keys have a weight each,
+// the threshold being the minimum sum of the weights of the keys of a valid multi-signature.
+type WeightedPubKey interface {
+	PubKey
+
+	// GetWeights returns the weights of the types.PubKey's nested within the multi-sig PubKey,
+	// in the same order.
+	GetWeights() []uint32
+}
+
+// AggregatedPubKey defines a multi-signature PubKey whose multi-signatures carry a single
+// signature, aggregating the signatures of all the signers, instead of one signature per signer.
+type AggregatedPubKey interface {
+	PubKey
+
+	// AggregateMultisignature returns the multi-signature aggregating the signatures of sig,
+	// which has one signature per signer as added by AddSignatureFromPubKey.
+	AggregateMultisignature(sig *signing.MultiSignatureData) (*signing.MultiSignatureData, error)
+}
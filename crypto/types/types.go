@@ -41,3 +41,14 @@ type PrivKey interface {
 type (
 	Address = tmcrypto.Address
 )
+
+// BatchVerifier verifies the signatures of several messages at once, which is
+// faster than verifying them one at a time.
+type BatchVerifier interface {
+	// Add adds a signature of msg by key to the batch. It returns an error if
+	// the key type isn't supported by the verifier.
+	Add(key PubKey, msg, sig []byte) error
+	// Verify returns true if all the signatures of the batch are valid, and the
+	// validity of each signature otherwise. An empty batch is not valid.
+	Verify() (bool, []bool)
+}
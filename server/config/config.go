@@ -75,6 +75,14 @@ type BaseConfig struct {
 
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
+
+	// QueryCacheSize defines the number of gRPC query responses kept in the
+	// height-keyed query cache. 0 disables the cache.
+	QueryCacheSize uint64 `mapstructure:"query-cache-size"`
+
+	// ModuleLogLevels defines comma-separated module:level pairs overriding the
+	// log level of the loggers of the given modules, e.g. "x/bank:debug".
+	ModuleLogLevels string `mapstructure:"module-log-levels"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -103,9 +111,71 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
-	// TODO: TLS/Proxy configuration.
-	//
-	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
+	// TLS defines the TLS configuration of the API server
+	TLS TLSConfig `mapstructure:"tls"`
+
+	// Auth defines the client authentication configuration of the API server
+	Auth AuthConfig `mapstructure:"auth"`
+
+	// RateLimit defines the per-client rate limiting configuration of the API server
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+}
+
+// TLSConfig defines the TLS configuration of a server listener.
+type TLSConfig struct {
+	// CertFile defines the path to the PEM encoded server certificate. TLS is
+	// enabled when both CertFile and KeyFile are set.
+	CertFile string `mapstructure:"cert-file"`
+
+	// KeyFile defines the path to the PEM encoded server private key.
+	KeyFile string `mapstructure:"key-file"`
+
+	// ClientCAFile defines the path to a PEM encoded CA bundle. When set, clients
+	// must present a certificate signed by one of these CAs (mutual TLS).
+	ClientCAFile string `mapstructure:"client-ca-file"`
+}
+
+// Enabled returns true if TLS is configured.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// AuthConfig defines the client authentication configuration of a server.
+type AuthConfig struct {
+	// APIKeys defines the set of accepted API keys. When non-empty, every request
+	// must carry one of these keys in the "x-api-key" header (or gRPC metadata).
+	APIKeys []string `mapstructure:"api-keys"`
+}
+
+// Enabled returns true if API-key authentication is configured.
+func (c AuthConfig) Enabled() bool {
+	return len(c.APIKeys) > 0
+}
+
+// RateLimitConfig defines a per-client token-bucket rate limiting configuration.
+type RateLimitConfig struct {
+	// Enable defines if rate limiting should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// RequestsPerSecond defines the rate at which tokens are added to each
+	// client's bucket.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+
+	// Burst defines the maximum number of tokens in each client's bucket.
+	Burst uint `mapstructure:"burst"`
+
+	// Overrides defines per-method limits that take precedence over the default
+	// limit. Methods are matched by longest prefix against the full gRPC method
+	// name (e.g. /cosmos.bank.v1beta1.Query/AllBalances) or the REST URL path.
+	Overrides []RateLimitOverride `mapstructure:"overrides"`
+}
+
+// RateLimitOverride defines the rate limit applied to the methods matching
+// Method. A non-positive RequestsPerSecond disables rate limiting for them.
+type RateLimitOverride struct {
+	Method            string  `mapstructure:"method"`
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	Burst             uint    `mapstructure:"burst"`
 }
 
 // RosettaConfig defines the Rosetta API listener configuration.
@@ -138,6 +208,16 @@ type GRPCConfig struct {
 
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
+
+	// TLS defines the TLS configuration of the gRPC server. The gRPC-web server
+	// shares this configuration.
+	TLS TLSConfig `mapstructure:"tls"`
+
+	// Auth defines the client authentication configuration of the gRPC server
+	Auth AuthConfig `mapstructure:"auth"`
+
+	// RateLimit defines the per-client rate limiting configuration of the gRPC server
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -217,10 +297,17 @@ func DefaultConfig() *Config {
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250, // 50 MB
 			IAVLDisableFastNode: true,
+			QueryCacheSize:      0,
+			ModuleLogLevels:     "",
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:           false,
+			GlobalLabels:      [][]string{},
+			TracingEnabled:    false,
+			TracingExporter:   telemetry.TracingExporterOTLP,
+			TracingEndpoint:   "http://localhost:4318/v1/traces",
+			TracingFile:       "",
+			TracingSampleRate: 1,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -229,10 +316,14 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			Auth:               AuthConfig{APIKeys: []string{}},
+			RateLimit:          defaultRateLimitConfig(),
 		},
 		GRPC: GRPCConfig{
-			Enable:  true,
-			Address: DefaultGRPCAddress,
+			Enable:    true,
+			Address:   DefaultGRPCAddress,
+			Auth:      AuthConfig{APIKeys: []string{}},
+			RateLimit: defaultRateLimitConfig(),
 		},
 		Rosetta: RosettaConfig{
 			Enable:     false,
@@ -271,6 +362,16 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		}
 	}
 
+	apiRateLimit, err := getRateLimitConfig(v, "api")
+	if err != nil {
+		return Config{}, err
+	}
+
+	grpcRateLimit, err := getRateLimitConfig(v, "grpc")
+	if err != nil {
+		return Config{}, err
+	}
+
 	return Config{
 		BaseConfig: BaseConfig{
 			MinGasPrices:        v.GetString("minimum-gas-prices"),
@@ -285,6 +386,8 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MinRetainBlocks:     v.GetUint64("min-retain-blocks"),
 			IAVLCacheSize:       v.GetUint64("iavl-cache-size"),
 			IAVLDisableFastNode: v.GetBool("iavl-disable-fastnode"),
+			QueryCacheSize:      v.GetUint64("query-cache-size"),
+			ModuleLogLevels:     v.GetString("module-log-levels"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -294,6 +397,11 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableServiceLabel:      v.GetBool("telemetry.enable-service-label"),
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
+			TracingEnabled:          v.GetBool("telemetry.tracing-enabled"),
+			TracingExporter:         v.GetString("telemetry.tracing-exporter"),
+			TracingEndpoint:         v.GetString("telemetry.tracing-endpoint"),
+			TracingFile:             v.GetString("telemetry.tracing-file"),
+			TracingSampleRate:       v.GetFloat64("telemetry.tracing-sample-rate"),
 		},
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
@@ -304,6 +412,9 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			RPCWriteTimeout:    v.GetUint("api.rpc-write-timeout"),
 			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
 			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
+			TLS:                getTLSConfig(v, "api"),
+			Auth:               getAuthConfig(v, "api"),
+			RateLimit:          apiRateLimit,
 		},
 		Rosetta: RosettaConfig{
 			Enable:     v.GetBool("rosetta.enable"),
@@ -314,8 +425,11 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			Offline:    v.GetBool("rosetta.offline"),
 		},
 		GRPC: GRPCConfig{
-			Enable:  v.GetBool("grpc.enable"),
-			Address: v.GetString("grpc.address"),
+			Enable:    v.GetBool("grpc.enable"),
+			Address:   v.GetString("grpc.address"),
+			TLS:       getTLSConfig(v, "grpc"),
+			Auth:      getAuthConfig(v, "grpc"),
+			RateLimit: grpcRateLimit,
 		},
 		GRPCWeb: GRPCWebConfig{
 			Enable:           v.GetBool("grpc-web.enable"),
@@ -329,6 +443,43 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	}, nil
 }
 
+func defaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Enable:            false,
+		RequestsPerSecond: 50,
+		Burst:             100,
+		Overrides:         []RateLimitOverride{},
+	}
+}
+
+func getTLSConfig(v *viper.Viper, section string) TLSConfig {
+	return TLSConfig{
+		CertFile:     v.GetString(section + ".tls.cert-file"),
+		KeyFile:      v.GetString(section + ".tls.key-file"),
+		ClientCAFile: v.GetString(section + ".tls.client-ca-file"),
+	}
+}
+
+func getAuthConfig(v *viper.Viper, section string) AuthConfig {
+	return AuthConfig{
+		APIKeys: v.GetStringSlice(section + ".auth.api-keys"),
+	}
+}
+
+func getRateLimitConfig(v *viper.Viper, section string) (RateLimitConfig, error) {
+	overrides := []RateLimitOverride{}
+	if err := v.UnmarshalKey(section+".rate-limit.overrides", &overrides); err != nil {
+		return RateLimitConfig{}, fmt.Errorf("failed to parse %s rate limit overrides: %w", section, err)
+	}
+
+	return RateLimitConfig{
+		Enable:            v.GetBool(section + ".rate-limit.enable"),
+		RequestsPerSecond: v.GetFloat64(section + ".rate-limit.requests-per-second"),
+		Burst:             v.GetUint(section + ".rate-limit.burst"),
+		Overrides:         overrides,
+	}, nil
+}
+
 // ValidateBasic returns an error if min-gas-prices field is empty in BaseConfig. Otherwise, it returns nil.
 func (c Config) ValidateBasic() error {
 	if c.BaseConfig.MinGasPrices == "" {
@@ -339,6 +490,48 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", storetypes.PruningOptionEverything,
 		)
 	}
+	if err := c.API.TLS.validate("api"); err != nil {
+		return err
+	}
+	if err := c.GRPC.TLS.validate("grpc"); err != nil {
+		return err
+	}
+	if err := c.API.RateLimit.validate("api"); err != nil {
+		return err
+	}
+	if err := c.GRPC.RateLimit.validate("grpc"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c TLSConfig) validate(section string) error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return sdkerrors.ErrAppConfig.Wrapf("%s.tls: cert-file and key-file must be set together", section)
+	}
+	if c.ClientCAFile != "" && !c.Enabled() {
+		return sdkerrors.ErrAppConfig.Wrapf("%s.tls: client-ca-file requires cert-file and key-file", section)
+	}
+
+	return nil
+}
+
+func (c RateLimitConfig) validate(section string) error {
+	if !c.Enable {
+		return nil
+	}
+	if c.RequestsPerSecond <= 0 || c.Burst == 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("%s.rate-limit: requests-per-second and burst must be positive", section)
+	}
+	for _, o := range c.Overrides {
+		if o.Method == "" {
+			return sdkerrors.ErrAppConfig.Wrapf("%s.rate-limit: override method cannot be empty", section)
+		}
+		if o.RequestsPerSecond > 0 && o.Burst == 0 {
+			return sdkerrors.ErrAppConfig.Wrapf("%s.rate-limit: override %s must have a positive burst", section, o.Method)
+		}
+	}
 
 	return nil
 }
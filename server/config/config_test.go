@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +20,50 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestConfigTemplateRoundTrip(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.API.TLS = TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem", ClientCAFile: "ca.pem"}
+	cfg.API.Auth.APIKeys = []string{"foo", "bar"}
+	cfg.GRPC.RateLimit.Enable = true
+	cfg.GRPC.RateLimit.Overrides = []RateLimitOverride{
+		{Method: "/cosmos.bank.v1beta1.Query/AllBalances", RequestsPerSecond: 0.5, Burst: 2},
+		{Method: "/cosmos.base.tendermint.v1beta1.Service/", RequestsPerSecond: 0},
+	}
+
+	configFile := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configFile, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.API.TLS, parsed.API.TLS)
+	require.Equal(t, cfg.API.Auth, parsed.API.Auth)
+	require.Equal(t, cfg.API.RateLimit, parsed.API.RateLimit)
+	require.Equal(t, cfg.GRPC.RateLimit, parsed.GRPC.RateLimit)
+	require.Empty(t, parsed.GRPC.Auth.APIKeys)
+}
+
+func TestValidateBasicTLSAndRateLimit(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.GRPC.TLS.CertFile = "cert.pem"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.GRPC.TLS.KeyFile = "key.pem"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.API.TLS.ClientCAFile = "ca.pem"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.API.TLS.ClientCAFile = ""
+
+	cfg.API.RateLimit.Enable = true
+	cfg.API.RateLimit.Burst = 0
+	require.Error(t, cfg.ValidateBasic())
+	cfg.API.RateLimit.Burst = 10
+	require.NoError(t, cfg.ValidateBasic())
+}
@@ -78,6 +78,20 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is true.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# QueryCacheSize defines the number of gRPC query responses kept in the
+# height-keyed query cache. Responses are keyed by method, request and height,
+# and those of latest-height queries are dropped on every commit.
+# Default is 0, which disables the cache.
+query-cache-size = {{ .BaseConfig.QueryCacheSize }}
+
+# ModuleLogLevels defines comma-separated module:level pairs overriding the
+# log level of the loggers of the given modules, i.e. of the logs carrying the
+# given "module" field, such as "x/bank" or "consensus".
+#
+# Example:
+# "x/bank:debug,x/staking:debug,consensus:error"
+module-log-levels = "{{ .BaseConfig.ModuleLogLevels }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -113,6 +127,25 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# TracingEnabled enables OpenTelemetry tracing of blocks, transactions and
+# queries.
+tracing-enabled = {{ .Telemetry.TracingEnabled }}
+
+# TracingExporter defines where spans are exported to, one of "otlp",
+# "stdout" or "file".
+tracing-exporter = "{{ .Telemetry.TracingExporter }}"
+
+# TracingEndpoint defines the URL of the OTLP/HTTP traces endpoint spans are
+# sent to by the "otlp" exporter.
+tracing-endpoint = "{{ .Telemetry.TracingEndpoint }}"
+
+# TracingFile defines the file spans are appended to by the "file" exporter.
+tracing-file = "{{ .Telemetry.TracingFile }}"
+
+# TracingSampleRate defines the fraction of blocks and queries, between 0 and 1,
+# whose traces are sampled.
+tracing-sample-rate = {{ .Telemetry.TracingSampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -143,13 +176,61 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+[api.tls]
+
+# CertFile defines the path to the PEM encoded server certificate. TLS is
+# enabled when both cert-file and key-file are set.
+cert-file = "{{ .API.TLS.CertFile }}"
+
+# KeyFile defines the path to the PEM encoded server private key.
+key-file = "{{ .API.TLS.KeyFile }}"
+
+# ClientCAFile defines the path to a PEM encoded CA bundle. When set, clients
+# must present a certificate signed by one of these CAs (mutual TLS).
+client-ca-file = "{{ .API.TLS.ClientCAFile }}"
+
+[api.auth]
+
+# APIKeys defines the set of accepted API keys. When non-empty, every request
+# must carry one of these keys in the "x-api-key" header.
+api-keys = [{{ range $k := .API.Auth.APIKeys }}"{{ $k }}", {{ end }}]
+
+[api.rate-limit]
+
+# Enable defines if per-client token-bucket rate limiting should be enabled.
+# Clients are identified by API key, client certificate or remote IP address.
+enable = {{ .API.RateLimit.Enable }}
+
+# RequestsPerSecond defines the rate at which each client's bucket is refilled.
+requests-per-second = {{ .API.RateLimit.RequestsPerSecond }}
+
+# Burst defines the maximum number of requests a client can issue at once.
+burst = {{ .API.RateLimit.Burst }}
+
+# Overrides define per-method limits, matched by longest prefix against the
+# REST URL path. A non-positive requests-per-second disables rate limiting
+# for the matching methods.
+#
+# Example:
+# [[api.rate-limit.overrides]]
+# method = "/cosmos/bank/v1beta1/balances"
+# requests-per-second = 1
+# burst = 5
+{{ range $o := .API.RateLimit.Overrides }}
+[[api.rate-limit.overrides]]
+method = "{{ $o.Method }}"
+requests-per-second = {{ $o.RequestsPerSecond }}
+burst = {{ $o.Burst }}
+{{ end }}
 ###############################################################################
 ###                           Rosetta Configuration                         ###
 ###############################################################################
 
 [rosetta]
 
-# Enable defines if the Rosetta API server should be enabled.
+# Enable defines if the Rosetta API server should be enabled. Rosetta queries
+# the gRPC server with the first of its API keys, and can only run in offline
+# mode when the gRPC server has TLS enabled.
 enable = {{ .Rosetta.Enable }}
 
 # Address defines the Rosetta API server to listen on.
@@ -179,6 +260,53 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+[grpc.tls]
+
+# CertFile defines the path to the PEM encoded server certificate. TLS is
+# enabled when both cert-file and key-file are set.
+# NOTE: the gRPC-web server shares this TLS configuration.
+cert-file = "{{ .GRPC.TLS.CertFile }}"
+
+# KeyFile defines the path to the PEM encoded server private key.
+key-file = "{{ .GRPC.TLS.KeyFile }}"
+
+# ClientCAFile defines the path to a PEM encoded CA bundle. When set, clients
+# must present a certificate signed by one of these CAs (mutual TLS).
+client-ca-file = "{{ .GRPC.TLS.ClientCAFile }}"
+
+[grpc.auth]
+
+# APIKeys defines the set of accepted API keys. When non-empty, every request
+# must carry one of these keys in the "x-api-key" header.
+api-keys = [{{ range $k := .GRPC.Auth.APIKeys }}"{{ $k }}", {{ end }}]
+
+[grpc.rate-limit]
+
+# Enable defines if per-client token-bucket rate limiting should be enabled.
+# Clients are identified by API key, client certificate or remote IP address.
+enable = {{ .GRPC.RateLimit.Enable }}
+
+# RequestsPerSecond defines the rate at which each client's bucket is refilled.
+requests-per-second = {{ .GRPC.RateLimit.RequestsPerSecond }}
+
+# Burst defines the maximum number of requests a client can issue at once.
+burst = {{ .GRPC.RateLimit.Burst }}
+
+# Overrides define per-method limits, matched by longest prefix against the
+# full gRPC method name. A non-positive requests-per-second disables rate limiting
+# for the matching methods.
+#
+# Example:
+# [[grpc.rate-limit.overrides]]
+# method = "/cosmos.bank.v1beta1.Query/AllBalances"
+# requests-per-second = 1
+# burst = 5
+{{ range $o := .GRPC.RateLimit.Overrides }}
+[[grpc.rate-limit.overrides]]
+method = "{{ $o.Method }}"
+requests-per-second = {{ $o.RequestsPerSecond }}
+burst = {{ $o.Burst }}
+{{ end }}
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
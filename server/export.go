@@ -3,8 +3,10 @@ package server
 // DONTCOVER
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -14,12 +16,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 const (
 	FlagHeight           = "height"
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
+	FlagOutputDir        = "output-dir"
+
+	// ExportGenesisDirKey is the app option holding the directory the app
+	// exporter must write the module genesis states to, instead of returning
+	// them as the exported app state. It is set by `export --output-dir`.
+	ExportGenesisDirKey = "export-genesis-dir"
+
+	// GenesisDirName is the name of the directory, relative to the genesis
+	// file, holding the module genesis states of a split genesis.
+	GenesisDirName = "genesis"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -66,18 +79,46 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			height, _ := cmd.Flags().GetInt64(FlagHeight)
 			forZeroHeight, _ := cmd.Flags().GetBool(FlagForZeroHeight)
 			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(FlagJailAllowedAddrs)
+			outputDir, _ := cmd.Flags().GetString(FlagOutputDir)
+
+			if outputDir != "" {
+				serverCtx.Viper.Set(ExportGenesisDirKey, filepath.Join(outputDir, GenesisDirName))
+			}
 
 			exported, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper)
 			if err != nil {
 				return fmt.Errorf("error exporting state: %v", err)
 			}
 
+			if outputDir != "" {
+				if _, err := os.Stat(filepath.Join(outputDir, GenesisDirName)); err != nil {
+					return fmt.Errorf("app exporter did not export the module genesis states to %s: %w", outputDir, err)
+				}
+			}
+
 			doc, err := tmtypes.GenesisDocFromFile(serverCtx.Config.GenesisFile())
 			if err != nil {
 				return err
 			}
 
 			doc.AppState = exported.AppState
+			if outputDir != "" {
+				// the genesis directory is referenced relative to the genesis file
+				var appState map[string]json.RawMessage
+				if err := json.Unmarshal(exported.AppState, &appState); err != nil {
+					return err
+				}
+				genesisDir, ok, err := module.GenesisDirFromAppState(appState)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return fmt.Errorf("app exporter did not export the module genesis states to %s", outputDir)
+				}
+
+				genesisDir.Path = GenesisDirName
+				doc.AppState = genesisDir.AppState()
+			}
 			doc.Validators = exported.Validators
 			doc.InitialHeight = exported.Height
 			doc.ConsensusParams = &tmproto.ConsensusParams{
@@ -104,6 +145,10 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
+			if outputDir != "" {
+				return os.WriteFile(filepath.Join(outputDir, "genesis.json"), sdk.MustSortJSON(encoded), 0o644)
+			}
+
 			cmd.Println(string(sdk.MustSortJSON(encoded)))
 			return nil
 		},
@@ -113,6 +158,7 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
 	cmd.Flags().Bool(FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().String(FlagOutputDir, "", "Write genesis.json to this directory, with each module state split into its own file under genesis/ and large collections streamed as JSON lines")
 
 	return cmd
 }
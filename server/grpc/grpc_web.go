@@ -9,11 +9,19 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/security"
 	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
-// StartGRPCWeb starts a gRPC-Web server on the given address.
+// StartGRPCWeb starts a gRPC-Web server on the given address. It is served over
+// TLS when TLS is configured for the gRPC server. Authentication and rate
+// limiting are enforced by the wrapped gRPC server.
 func StartGRPCWeb(grpcSrv *grpc.Server, config config.Config) (*http.Server, error) {
+	tlsCfg, err := security.NewTLSConfig(config.GRPC.TLS)
+	if err != nil {
+		return nil, err
+	}
+
 	var options []grpcweb.Option
 	if config.GRPCWeb.EnableUnsafeCORS {
 		options = append(options,
@@ -28,11 +36,19 @@ func StartGRPCWeb(grpcSrv *grpc.Server, config config.Config) (*http.Server, err
 		Addr:              config.GRPCWeb.Address,
 		Handler:           wrappedServer,
 		ReadHeaderTimeout: 500 * time.Millisecond,
+		TLSConfig:         tlsCfg,
 	}
 
 	errCh := make(chan error)
 	go func() {
-		if err := grpcWebSrv.ListenAndServe(); err != nil {
+		var err error
+		if tlsCfg != nil {
+			// the certificates are already loaded in TLSConfig
+			err = grpcWebSrv.ListenAndServeTLS("", "")
+		} else {
+			err = grpcWebSrv.ListenAndServe()
+		}
+		if err != nil {
 			errCh <- fmt.Errorf("[grpc] failed to serve: %w", err)
 		}
 	}()
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/rs/zerolog"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 )
@@ -11,6 +14,17 @@ var _ tmlog.Logger = (*ZeroLogWrapper)(nil)
 // Tendermint's Logger interface.
 type ZeroLogWrapper struct {
 	zerolog.Logger
+
+	// moduleLevels overrides the level of the loggers whose "module" field is
+	// set to one of its keys, e.g. "x/bank" or "consensus".
+	moduleLevels map[string]zerolog.Level
+}
+
+// NewZeroLogWrapper returns a ZeroLogWrapper around the given logger. The level
+// of the loggers derived from it with a "module" field found in moduleLevels is
+// set to the module's level instead of the level of logger.
+func NewZeroLogWrapper(logger zerolog.Logger, moduleLevels map[string]zerolog.Level) ZeroLogWrapper {
+	return ZeroLogWrapper{Logger: logger, moduleLevels: moduleLevels}
 }
 
 // Info implements Tendermint's Logger interface and logs with level INFO. A set
@@ -36,9 +50,44 @@ func (z ZeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
 
 // With returns a new wrapped logger with additional context provided by a set
 // of key/value tuples. The number of tuples must be even and the key of the
-// tuple must be a string.
+// tuple must be a string. If a "module" field is provided and its level is
+// overridden, the returned logger logs with that level.
 func (z ZeroLogWrapper) With(keyVals ...interface{}) tmlog.Logger {
-	return ZeroLogWrapper{z.Logger.With().Fields(getLogFields(keyVals...)).Logger()}
+	fields := getLogFields(keyVals...)
+	logger := z.Logger.With().Fields(fields).Logger()
+
+	if module, ok := fields["module"].(string); ok {
+		if lvl, ok := z.moduleLevels[module]; ok {
+			logger = logger.Level(lvl)
+		}
+	}
+
+	return ZeroLogWrapper{Logger: logger, moduleLevels: z.moduleLevels}
+}
+
+// ParseModuleLogLevels parses a comma-separated list of module:level pairs,
+// e.g. "x/bank:debug,consensus:error", into the level of each module.
+func ParseModuleLogLevels(s string) (map[string]zerolog.Level, error) {
+	levels := make(map[string]zerolog.Level)
+	if strings.TrimSpace(s) == "" {
+		return levels, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid module log level %q: expected module:level", pair)
+		}
+
+		lvl, err := zerolog.ParseLevel(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse log level of module %s (%s): %w", parts[0], parts[1], err)
+		}
+
+		levels[parts[0]] = lvl
+	}
+
+	return levels, nil
 }
 
 func getLogFields(keyVals ...interface{}) map[string]interface{} {
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModuleLogLevels(t *testing.T) {
+	levels, err := ParseModuleLogLevels("")
+	require.NoError(t, err)
+	require.Empty(t, levels)
+
+	levels, err = ParseModuleLogLevels("x/bank:debug, consensus:error")
+	require.NoError(t, err)
+	require.Equal(t, map[string]zerolog.Level{"x/bank": zerolog.DebugLevel, "consensus": zerolog.ErrorLevel}, levels)
+
+	_, err = ParseModuleLogLevels("x/bank")
+	require.Error(t, err)
+
+	_, err = ParseModuleLogLevels("x/bank:loud")
+	require.Error(t, err)
+}
+
+func TestZeroLogWrapper_ModuleLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewZeroLogWrapper(zerolog.New(&buf).Level(zerolog.InfoLevel), map[string]zerolog.Level{
+		"x/bank":    zerolog.DebugLevel,
+		"consensus": zerolog.ErrorLevel,
+	})
+
+	logger.With("module", "x/bank").Debug("bank debug")
+	logger.With("module", "x/bank").With("height", 1).Debug("bank debug with height")
+	logger.With("module", "consensus").Info("consensus info")
+	logger.With("module", "x/staking").Debug("staking debug")
+	logger.With("module", "x/staking").Info("staking info")
+
+	out := buf.String()
+	require.Contains(t, out, `"message":"bank debug"`)
+	require.Contains(t, out, `"message":"bank debug with height"`)
+	require.NotContains(t, out, "consensus info")
+	require.NotContains(t, out, "staking debug")
+	require.Contains(t, out, `"message":"staking info"`)
+}
@@ -7,13 +7,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cobra"
 	tmcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/node"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
 )
 
-// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height.
+// NewRollbackCmd creates a command to rollback tendermint and multistore state by one height,
+// or to a given height.
 func NewRollbackCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback cosmos-sdk and tendermint state by one height",
+		Short: "rollback cosmos-sdk and tendermint state by one height, or to a given height",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when Tendermint has persisted an incorrect app hash and is thus unable to make
@@ -21,8 +31,21 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also roll back to height n - 1. No blocks are removed, so upon
 restarting Tendermint the transactions in block n will be re-executed against the
 application.
+
+With '--height h', both states are rolled back to height h instead, which must not
+have been pruned from either the block store, the state store or the multistore.
+Blocks above h + 1 are removed and fetched again from peers, while the transactions
+in block h + 1 are re-executed upon restart.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			if target < 0 {
+				return fmt.Errorf("height must not be negative: %d", target)
+			}
+
 			ctx := GetServerContextFromCmd(cmd)
 			cfg := ctx.Config
 			home := cfg.RootDir
@@ -31,6 +54,17 @@ application.
 				return err
 			}
 			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			if target > 0 {
+				hash, err := rollbackToHeight(cfg, target, app.CommitMultiStore().RollbackToVersion)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("Rolled back state to height %d and hash %X", target, hash)
+				return nil
+			}
+
 			// rollback tendermint state
 			height, hash, err := tmcmd.RollbackState(ctx.Config)
 			if err != nil {
@@ -48,5 +82,197 @@ application.
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Height to roll back to (0 means one height below the latest one)")
 	return cmd
 }
+
+// rollbackToHeight rolls the Tendermint state back to the given height and the
+// block store back to the following height, whose block is re-executed upon
+// restart. It returns the app hash of the given height.
+//
+// Everything needed from the Tendermint stores is loaded before any store is
+// modified. rollbackApp, which must leave the application untouched when it
+// fails, is then called before the Tendermint stores are written: should
+// writing them fail, Tendermint replays the blocks above the application
+// height upon restart, whereas it cannot recover from an application ahead of
+// its own state.
+func rollbackToHeight(cfg *tmcfg.Config, height int64, rollbackApp func(int64) error) ([]byte, error) {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false})
+
+	latest, err := stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	if latest.IsEmpty() {
+		return nil, fmt.Errorf("no state found")
+	}
+
+	if height >= latest.LastBlockHeight {
+		return nil, fmt.Errorf("target height %d must be below the latest height %d", height, latest.LastBlockHeight)
+	}
+	if height < latest.InitialHeight || height < blockStore.Base() {
+		return nil, fmt.Errorf("block at height %d has been pruned, the lowest available height is %d", height, blockStore.Base())
+	}
+
+	rolledBack, err := loadStateAtHeight(stateStore, blockStore, latest, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tendermint state at height %d: %w", height, err)
+	}
+
+	removed, err := loadBlockMetasAbove(blockStore, height+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rollbackApp(height); err != nil {
+		return nil, fmt.Errorf("failed to rollback to version: %w", err)
+	}
+
+	// Bootstrap saves the validator sets and consensus params the rolled back
+	// state refers to along with the state itself.
+	if err := stateStore.Bootstrap(rolledBack); err != nil {
+		return nil, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+
+	if err := removeBlocks(blockStoreDB, removed, height+1); err != nil {
+		return nil, fmt.Errorf("failed to remove blocks above height %d: %w", height+1, err)
+	}
+
+	return rolledBack.AppHash, nil
+}
+
+// loadStateAtHeight rebuilds the Tendermint state as it was right after the
+// block at the given height was committed. Its app hash and last results hash
+// are only agreed upon by the following block.
+func loadStateAtHeight(stateStore sm.Store, blockStore *store.BlockStore, latest sm.State, height int64) (sm.State, error) {
+	block := blockStore.LoadBlockMeta(height)
+	if block == nil {
+		return sm.State{}, fmt.Errorf("block at height %d not found", height)
+	}
+
+	nextBlock := blockStore.LoadBlockMeta(height + 1)
+	if nextBlock == nil {
+		return sm.State{}, fmt.Errorf("block at height %d not found", height+1)
+	}
+
+	lastValidators, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	validators, err := stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	nextValidators, err := stateStore.LoadValidators(height + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	params, err := stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	return sm.State{
+		Version: tmstate.Version{
+			Consensus: tmversion.Consensus{
+				Block: version.BlockProtocol,
+				App:   params.Version.AppVersion,
+			},
+			Software: version.TMCoreSemVer,
+		},
+		ChainID:       latest.ChainID,
+		InitialHeight: latest.InitialHeight,
+
+		LastBlockHeight: block.Header.Height,
+		LastBlockID:     block.BlockID,
+		LastBlockTime:   block.Header.Time,
+
+		// Tendermint only saves the validator set and the consensus params at
+		// the heights they changed, and refers to the last of these heights
+		// afterwards. Bootstrap saves them in full at the next heights, which
+		// can thus be referred to instead of the heights they actually changed.
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: height + 2,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: height + 1,
+
+		LastResultsHash: nextBlock.Header.LastResultsHash,
+		AppHash:         nextBlock.Header.AppHash,
+	}, nil
+}
+
+// loadBlockMetasAbove loads the metadata of every block above the given height.
+func loadBlockMetasAbove(blockStore *store.BlockStore, height int64) ([]*tmtypes.BlockMeta, error) {
+	var metas []*tmtypes.BlockMeta
+	for h := blockStore.Height(); h > height; h-- {
+		meta := blockStore.LoadBlockMeta(h)
+		if meta == nil {
+			return nil, fmt.Errorf("block at height %d not found", h)
+		}
+
+		metas = append(metas, meta)
+	}
+
+	return metas, nil
+}
+
+// removeBlocks removes the given blocks from the block store, then sets its
+// height to the given height.
+//
+// The block store of Tendermint v0.34 can only prune blocks from its base, so
+// the blocks are removed using the keys it saves them under.
+func removeBlocks(db dbm.DB, metas []*tmtypes.BlockMeta, height int64) error {
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for _, meta := range metas {
+		h := meta.Header.Height
+		keys := []string{
+			fmt.Sprintf("H:%d", h),
+			fmt.Sprintf("BH:%x", meta.BlockID.Hash),
+			fmt.Sprintf("SC:%d", h),
+			// the canonical commit of a block is saved along with the next block
+			fmt.Sprintf("C:%d", h-1),
+		}
+		for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+			keys = append(keys, fmt.Sprintf("P:%d:%d", h, i))
+		}
+
+		for _, key := range keys {
+			if err := batch.Delete([]byte(key)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	blockStoreState := store.LoadBlockStoreState(db)
+	if blockStoreState.Height > height {
+		blockStoreState.Height = height
+		store.SaveBlockStoreState(&blockStoreState, db)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	pvm "github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRollbackToHeight(t *testing.T) {
+	cfg := tmcfg.ResetTestRoot("rollback")
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+	cfg.DBBackend = string(dbm.GoLevelDBBackend)
+	cfg.RPC.ListenAddress = ""
+	cfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+
+	appDB := dbm.NewMemDB()
+	app := newRollbackTestApp(t, appDB)
+	runTestNode(t, cfg, app, 6)
+
+	blockStore, stateStore := loadTestNodeStores(t, cfg)
+	latest, err := stateStore.Load()
+	require.NoError(t, err)
+	latestHeight := latest.LastBlockHeight
+	require.GreaterOrEqual(t, latestHeight, int64(6))
+	removed := blockStore.LoadBlockMeta(5)
+	require.NotNil(t, removed)
+	appHash := []byte(blockStore.LoadBlockMeta(4).Header.AppHash)
+	require.NoError(t, blockStore.Close())
+	require.NoError(t, stateStore.Close())
+
+	_, err = rollbackToHeight(cfg, latestHeight, app.CommitMultiStore().RollbackToVersion)
+	require.Error(t, err)
+
+	// nothing is changed when the application cannot be rolled back
+	_, err = rollbackToHeight(cfg, 3, func(int64) error { return errors.New("pruned") })
+	require.Error(t, err)
+	blockStore, stateStore = loadTestNodeStores(t, cfg)
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, latestHeight, state.LastBlockHeight)
+	require.NotNil(t, blockStore.LoadBlock(5))
+	require.NoError(t, blockStore.Close())
+	require.NoError(t, stateStore.Close())
+
+	hash, err := rollbackToHeight(cfg, 3, app.CommitMultiStore().RollbackToVersion)
+	require.NoError(t, err)
+	require.Equal(t, appHash, hash)
+	require.Equal(t, sdk.CommitID{Version: 3, Hash: hash}, app.CommitMultiStore().LastCommitID())
+
+	blockStore, stateStore = loadTestNodeStores(t, cfg)
+	state, err = stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), state.LastBlockHeight)
+	require.Equal(t, appHash, []byte(state.AppHash))
+	require.Equal(t, int64(4), blockStore.Height())
+	require.NotNil(t, blockStore.LoadBlock(4))
+	require.NotNil(t, blockStore.LoadSeenCommit(4))
+	for h := int64(5); h <= latestHeight; h++ {
+		require.Nil(t, blockStore.LoadBlockMeta(h))
+		require.Nil(t, blockStore.LoadSeenCommit(h))
+	}
+	require.Nil(t, blockStore.LoadBlockByHash(removed.BlockID.Hash))
+	require.NoError(t, blockStore.Close())
+	require.NoError(t, stateStore.Close())
+
+	// The node replays block 4 and carries on from there. Its validator signs
+	// the heights above 4 again, which is only safe since it is alone.
+	pvm.LoadFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()).Reset()
+	runTestNode(t, cfg, newRollbackTestApp(t, appDB), latestHeight+1)
+}
+
+func TestRollbackCmdNegativeHeight(t *testing.T) {
+	cmd := NewRollbackCmd(nil, t.TempDir())
+	cmd.SetArgs([]string{"--height", "-1"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	// the command fails before the app is created
+	require.EqualError(t, cmd.Execute(), "height must not be negative: -1")
+}
+
+func newRollbackTestApp(t *testing.T, db dbm.DB) *baseapp.BaseApp {
+	key := sdk.NewKVStoreKey("main")
+	app := baseapp.NewBaseApp("rollback", log.NewNopLogger(), db, nil)
+	app.MountStores(key)
+	app.SetParamStore(paramStore{key: key})
+	require.NoError(t, app.LoadLatestVersion())
+
+	return app
+}
+
+// paramStore saves the consensus params as JSON in the application store.
+type paramStore struct {
+	key sdk.StoreKey
+}
+
+func (ps paramStore) Set(ctx sdk.Context, key []byte, value interface{}) {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(ps.key).Set(key, bz)
+}
+
+func (ps paramStore) Has(ctx sdk.Context, key []byte) bool {
+	return ctx.KVStore(ps.key).Has(key)
+}
+
+func (ps paramStore) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+	bz := ctx.KVStore(ps.key).Get(key)
+	if len(bz) == 0 {
+		return
+	}
+
+	if err := json.Unmarshal(bz, ptr); err != nil {
+		panic(err)
+	}
+}
+
+// runTestNode runs a single validator node until it has committed the given
+// height.
+func runTestNode(t *testing.T, cfg *tmcfg.Config, app *baseapp.BaseApp, height int64) {
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	require.NoError(t, err)
+
+	// The databases of the indexer and the evidence pool are not closed when
+	// the node stops, so they are kept in memory.
+	dbProvider := func(ctx *node.DBContext) (dbm.DB, error) {
+		if ctx.ID == "blockstore" || ctx.ID == "state" {
+			return node.DefaultDBProvider(ctx)
+		}
+		return dbm.NewMemDB(), nil
+	}
+
+	n, err := node.NewNode(
+		cfg,
+		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(app),
+		node.DefaultGenesisDocProviderFunc(cfg),
+		dbProvider,
+		node.DefaultMetricsProvider(cfg.Instrumentation),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+
+	require.Eventually(t, func() bool {
+		return app.LastBlockHeight() >= height
+	}, 30*time.Second, 10*time.Millisecond)
+	require.NoError(t, n.Stop())
+}
+
+func loadTestNodeStores(t *testing.T, cfg *tmcfg.Config) (*store.BlockStore, sm.Store) {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	require.NoError(t, err)
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+
+	return store.NewBlockStore(blockStoreDB), sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false})
+}
@@ -34,6 +34,19 @@ func (c *Client) SupportedOperations() []string {
 	return c.supportedOperations
 }
 
+// BalanceExemptions lists the sub-accounts whose balance can change without any
+// operation: delegated coins are subject to rounding as validator shares change.
+// Operations are still emitted for these sub-accounts when an account is
+// involved in a block.
+func (c *Client) BalanceExemptions() []*types.BalanceExemption {
+	return []*types.BalanceExemption{
+		{
+			SubAccountAddress: stringPtr(SubAccountDelegated),
+			ExemptionType:     types.BalanceDynamic,
+		},
+	}
+}
+
 // ---------- cosmos-rosetta-gateway.types.OfflineClient implementation ------------ //
 
 func (c *Client) SignedTx(_ context.Context, txBytes []byte, signatures []*types.Signature) (signedTxBytes []byte, err error) {
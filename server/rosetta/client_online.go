@@ -23,12 +23,14 @@ import (
 
 	crgerrs "github.com/cosmos/cosmos-sdk/server/rosetta/lib/errors"
 	crgtypes "github.com/cosmos/cosmos-sdk/server/rosetta/lib/types"
+	"github.com/cosmos/cosmos-sdk/server/security"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	tmrpc "github.com/tendermint/tendermint/rpc/client"
 )
@@ -47,9 +49,10 @@ type Client struct {
 
 	config *Config
 
-	auth  auth.QueryClient
-	bank  bank.QueryClient
-	tmRPC tmrpc.Client
+	auth    auth.QueryClient
+	bank    bank.QueryClient
+	staking staking.QueryClient
+	tmRPC   tmrpc.Client
 
 	version string
 
@@ -84,7 +87,9 @@ func NewClient(cfg *Config) (*Client, error) {
 		bank.EventTypeCoinSpent,
 		bank.EventTypeCoinReceived,
 		bank.EventTypeCoinBurn,
+		SubAccountBalanceChangeOpType,
 	)
+	supportedOperations = append(supportedOperations, StakingOperationTypes...)
 
 	return &Client{
 		supportedOperations: supportedOperations,
@@ -101,7 +106,12 @@ func NewClient(cfg *Config) (*Client, error) {
 
 // Bootstrap is gonna connect the client to the endpoints
 func (c *Client) Bootstrap() error {
-	grpcConn, err := grpc.Dial(c.config.GRPCEndpoint, grpc.WithInsecure())
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if c.config.GRPCAPIKey != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(apiKeyInterceptor(c.config.GRPCAPIKey)))
+	}
+
+	grpcConn, err := grpc.Dial(c.config.GRPCEndpoint, opts...)
 	if err != nil {
 		return err
 	}
@@ -116,11 +126,21 @@ func (c *Client) Bootstrap() error {
 
 	c.auth = authClient
 	c.bank = bankClient
+	c.staking = staking.NewQueryClient(grpcConn)
 	c.tmRPC = tmRPC
 
 	return nil
 }
 
+// apiKeyInterceptor returns an interceptor sending the API key required by the
+// gRPC endpoint along with each request.
+func apiKeyInterceptor(apiKey string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, security.APIKeyHeader, apiKey)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
 // Ready performs a health check and returns an error if the client is not ready.
 func (c *Client) Ready() error {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultNodeTimeout)
@@ -456,11 +476,18 @@ func (c *Client) blockTxs(ctx context.Context, height *int64) (crgtypes.BlockTra
 		),
 	}
 
+	// sub-account balance changes are reported at the end of the block,
+	// whichever part of the block they happened in
+	subAccountOps, err := c.subAccountOps(ctx, blockInfo.Block, blockResults)
+	if err != nil {
+		return crgtypes.BlockTransactionsResponse{}, err
+	}
+
 	endBlockTx := &rosettatypes.Transaction{
 		TransactionIdentifier: &rosettatypes.TransactionIdentifier{Hash: c.converter.ToRosetta().EndBlockTxHash(blockInfo.BlockID.Hash)},
 		Operations: AddOperationIndexes(
 			nil,
-			c.converter.ToRosetta().BalanceOps(StatusTxSuccess, blockResults.EndBlockEvents),
+			append(c.converter.ToRosetta().BalanceOps(StatusTxSuccess, blockResults.EndBlockEvents), subAccountOps...),
 		),
 	}
 
@@ -1,10 +1,15 @@
 package rosetta
 
 import (
+	"context"
 	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/server/security"
 )
 
 func TestRegex(t *testing.T) {
@@ -13,3 +18,15 @@ func TestRegex(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, height, int64(5900001))
 }
+
+func TestAPIKeyInterceptor(t *testing.T) {
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := apiKeyInterceptor("secret")(context.Background(), "/method", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Equal(t, []string{"secret"}, md.Get(security.APIKeyHeader))
+}
@@ -0,0 +1,327 @@
+package rosetta
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcoretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/metadata"
+
+	crgerrs "github.com/cosmos/cosmos-sdk/server/rosetta/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// allPages requests every result of a paginated query at once
+var allPages = &query.PageRequest{Limit: query.MaxLimit}
+
+// SubAccountBalances returns the balance of the given sub-account of an address
+func (c *Client) SubAccountBalances(ctx context.Context, addr, subAccount string, height *int64) ([]*rosettatypes.Amount, error) {
+	coins, err := c.subAccountCoins(ctx, addr, subAccount, height)
+	if err != nil {
+		return nil, err
+	}
+
+	availableCoins, err := c.coins(withHeight(ctx, height))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.converter.ToRosetta().Amounts(coins, availableCoins), nil
+}
+
+// subAccountCoins returns the coins of the given sub-account of an address
+func (c *Client) subAccountCoins(ctx context.Context, addr, subAccount string, height *int64) (sdk.Coins, error) {
+	switch subAccount {
+	case SubAccountDelegated:
+		return c.delegatedCoins(withHeight(ctx, height), addr)
+	case SubAccountUnbonding:
+		return c.unbondingCoins(withHeight(ctx, height), addr)
+	default:
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("unknown sub-account: %s", subAccount))
+	}
+}
+
+func (c *Client) delegatedCoins(ctx context.Context, addr string) (sdk.Coins, error) {
+	res, err := c.staking.DelegatorDelegations(ctx, &staking.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: addr,
+		Pagination:    allPages,
+	})
+	if err != nil {
+		return nil, crgerrs.FromGRPCToRosettaError(err)
+	}
+
+	coins := sdk.NewCoins()
+	for _, delegation := range res.DelegationResponses {
+		coins = coins.Add(delegation.Balance)
+	}
+
+	return coins, nil
+}
+
+func (c *Client) unbondingCoins(ctx context.Context, addr string) (sdk.Coins, error) {
+	res, err := c.staking.DelegatorUnbondingDelegations(ctx, &staking.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: addr,
+		Pagination:    allPages,
+	})
+	if err != nil {
+		return nil, crgerrs.FromGRPCToRosettaError(err)
+	}
+
+	total := sdk.ZeroInt()
+	for _, unbonding := range res.UnbondingResponses {
+		for _, entry := range unbonding.Entries {
+			total = total.Add(entry.Balance)
+		}
+	}
+
+	if total.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	params, err := c.staking.Params(ctx, &staking.QueryParamsRequest{})
+	if err != nil {
+		return nil, crgerrs.FromGRPCToRosettaError(err)
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(params.Params.BondDenom, total)), nil
+}
+
+// subAccountOps returns the operations changing the sub-accounts touched in the
+// given block, computed as the difference between their balances at the block
+// and at its parent.
+func (c *Client) subAccountOps(
+	ctx context.Context, block *tmtypes.Block, results *tmcoretypes.ResultBlockResults,
+) ([]*rosettatypes.Operation, error) {
+	blockEvents := make([]abci.Event, 0, len(results.BeginBlockEvents)+len(results.EndBlockEvents))
+	blockEvents = append(blockEvents, results.BeginBlockEvents...)
+	blockEvents = append(blockEvents, results.EndBlockEvents...)
+
+	touches, slashedValidators, err := c.converter.ToRosetta().SubAccountTouches(block.Txs, results.TxsResults, blockEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	// no state exists before the first block
+	height := block.Height
+	parentHeight := height - 1
+	isFirstBlock := block.LastBlockID.IsZero()
+	if isFirstBlock {
+		parentHeight = height
+	}
+
+	touched := make(map[string]bool)
+	var ops []*rosettatypes.Operation
+	for _, touch := range touches {
+		for _, subAccount := range touch.SubAccounts {
+			touched[touch.Address+"/"+subAccount] = true
+
+			after, err := c.subAccountCoins(ctx, touch.Address, subAccount, &height)
+			if err != nil {
+				return nil, err
+			}
+
+			before := sdk.NewCoins()
+			if !isFirstBlock {
+				before, err = c.subAccountCoins(ctx, touch.Address, subAccount, &parentHeight)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			ops = append(ops, c.converter.ToRosetta().SubAccountOps(StatusTxSuccess, touch.Address, subAccount, before, after)...)
+		}
+	}
+
+	if len(slashedValidators) == 0 || isFirstBlock {
+		return ops, nil
+	}
+
+	changes, err := c.slashChanges(ctx, slashedValidators, parentHeight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		// the whole balance change of touched sub-accounts is already known
+		if touched[change.addr+"/"+change.subAccount] {
+			continue
+		}
+
+		ops = append(ops, c.converter.ToRosetta().SubAccountOps(StatusTxSuccess, change.addr, change.subAccount, change.before, change.after)...)
+	}
+
+	return ops, nil
+}
+
+// subAccountChange is the balance of a sub-account before and after a block
+type subAccountChange struct {
+	addr, subAccount string
+	before, after    sdk.Coins
+}
+
+// subAccountChanges gathers the balances of sub-accounts, in the order they are first added
+type subAccountChanges struct {
+	changes []*subAccountChange
+	byKey   map[string]*subAccountChange
+}
+
+func (s *subAccountChanges) add(addr, subAccount string, isAfter bool, coins sdk.Coins) {
+	key := addr + "/" + subAccount
+	change, ok := s.byKey[key]
+	if !ok {
+		change = &subAccountChange{addr: addr, subAccount: subAccount, before: sdk.NewCoins(), after: sdk.NewCoins()}
+		s.byKey[key] = change
+		s.changes = append(s.changes, change)
+	}
+
+	if isAfter {
+		change.after = change.after.Add(coins...)
+	} else {
+		change.before = change.before.Add(coins...)
+	}
+}
+
+// slashChanges returns the changes of the delegated and unbonding sub-accounts
+// of the delegators of the validators with the given consensus addresses, which
+// were slashed at the given height. Rather than querying the sub-accounts of
+// every delegator, the delegations and unbonding delegations of each slashed
+// validator are queried at once, as well as the delegations of the validators
+// they were redelegated to, since these are slashed too.
+//
+// The balances only cover the slashed validators and the redelegation targets,
+// so they are only meaningful as differences for the delegators whose other
+// delegations did not change in the block.
+func (c *Client) slashChanges(ctx context.Context, consAddrs []string, parentHeight, height int64) ([]*subAccountChange, error) {
+	beforeCtx := withHeight(ctx, &parentHeight)
+	heightCtxs := []context.Context{beforeCtx, withHeight(ctx, &height)}
+
+	wanted := make(map[string]bool, len(consAddrs))
+	for _, consAddr := range consAddrs {
+		wanted[consAddr] = true
+	}
+
+	validators, err := c.staking.Validators(beforeCtx, &staking.QueryValidatorsRequest{Pagination: allPages})
+	if err != nil {
+		return nil, crgerrs.FromGRPCToRosettaError(err)
+	}
+
+	params, err := c.staking.Params(beforeCtx, &staking.QueryParamsRequest{})
+	if err != nil {
+		return nil, crgerrs.FromGRPCToRosettaError(err)
+	}
+	bondDenom := params.Params.BondDenom
+
+	changes := &subAccountChanges{byKey: make(map[string]*subAccountChange)}
+
+	// addDelegations adds the delegations to the given validator of the
+	// delegators accepted by the filter, if any
+	addDelegations := func(valAddr string, filter map[string]bool) error {
+		for i, heightCtx := range heightCtxs {
+			res, err := c.staking.ValidatorDelegations(heightCtx, &staking.QueryValidatorDelegationsRequest{
+				ValidatorAddr: valAddr,
+				Pagination:    allPages,
+			})
+			if err != nil {
+				return crgerrs.FromGRPCToRosettaError(err)
+			}
+
+			for _, delegation := range res.DelegationResponses {
+				delegator := delegation.Delegation.DelegatorAddress
+				if filter == nil || filter[delegator] {
+					changes.add(delegator, SubAccountDelegated, i == 1, sdk.NewCoins(delegation.Balance))
+				}
+			}
+		}
+
+		return nil
+	}
+
+	slashed := make(map[string]bool)
+	var dstValidators []string
+	dstDelegators := make(map[string]map[string]bool)
+	for _, validator := range validators.Validators {
+		if err := validator.UnpackInterfaces(c.config.InterfaceRegistry); err != nil {
+			return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+		}
+
+		if !wanted[consAddr.String()] {
+			continue
+		}
+		slashed[validator.OperatorAddress] = true
+
+		if err := addDelegations(validator.OperatorAddress, nil); err != nil {
+			return nil, err
+		}
+
+		for i, heightCtx := range heightCtxs {
+			unbondings, err := c.staking.ValidatorUnbondingDelegations(heightCtx, &staking.QueryValidatorUnbondingDelegationsRequest{
+				ValidatorAddr: validator.OperatorAddress,
+				Pagination:    allPages,
+			})
+			if err != nil {
+				return nil, crgerrs.FromGRPCToRosettaError(err)
+			}
+
+			for _, unbonding := range unbondings.UnbondingResponses {
+				total := sdk.ZeroInt()
+				for _, entry := range unbonding.Entries {
+					total = total.Add(entry.Balance)
+				}
+
+				changes.add(unbonding.DelegatorAddress, SubAccountUnbonding, i == 1, sdk.NewCoins(sdk.NewCoin(bondDenom, total)))
+			}
+		}
+
+		redelegations, err := c.staking.Redelegations(beforeCtx, &staking.QueryRedelegationsRequest{
+			SrcValidatorAddr: validator.OperatorAddress,
+			Pagination:       allPages,
+		})
+		if err != nil {
+			return nil, crgerrs.FromGRPCToRosettaError(err)
+		}
+
+		for _, redelegation := range redelegations.RedelegationResponses {
+			dst := redelegation.Redelegation.ValidatorDstAddress
+			if dstDelegators[dst] == nil {
+				dstDelegators[dst] = make(map[string]bool)
+				dstValidators = append(dstValidators, dst)
+			}
+			dstDelegators[dst][redelegation.Redelegation.DelegatorAddress] = true
+		}
+	}
+
+	for _, dst := range dstValidators {
+		// the delegations to slashed validators are already added
+		if slashed[dst] {
+			continue
+		}
+
+		if err := addDelegations(dst, dstDelegators[dst]); err != nil {
+			return nil, err
+		}
+	}
+
+	return changes.changes, nil
+}
+
+// withHeight returns a context querying the gRPC server at the given height, if any
+func withHeight(ctx context.Context, height *int64) context.Context {
+	if height == nil {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(*height, 10))
+}
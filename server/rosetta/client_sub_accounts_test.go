@@ -0,0 +1,129 @@
+package rosetta
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// stakingQueryClient answers the staking queries of slashChanges from fixed
+// data, by height, and counts them.
+type stakingQueryClient struct {
+	staking.QueryClient
+
+	queries       int
+	validators    []staking.Validator
+	delegations   map[int64]map[string][]staking.DelegationResponse
+	unbondings    map[int64]map[string][]staking.UnbondingDelegation
+	redelegations map[string][]staking.RedelegationResponse
+}
+
+func queryHeight(ctx context.Context) int64 {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	height, _ := strconv.ParseInt(md.Get(grpctypes.GRPCBlockHeightHeader)[0], 10, 64)
+	return height
+}
+
+func (q *stakingQueryClient) Validators(context.Context, *staking.QueryValidatorsRequest, ...grpc.CallOption) (*staking.QueryValidatorsResponse, error) {
+	q.queries++
+	return &staking.QueryValidatorsResponse{Validators: q.validators}, nil
+}
+
+func (q *stakingQueryClient) Params(context.Context, *staking.QueryParamsRequest, ...grpc.CallOption) (*staking.QueryParamsResponse, error) {
+	q.queries++
+	return &staking.QueryParamsResponse{Params: staking.DefaultParams()}, nil
+}
+
+func (q *stakingQueryClient) ValidatorDelegations(
+	ctx context.Context, req *staking.QueryValidatorDelegationsRequest, _ ...grpc.CallOption,
+) (*staking.QueryValidatorDelegationsResponse, error) {
+	q.queries++
+	return &staking.QueryValidatorDelegationsResponse{DelegationResponses: q.delegations[queryHeight(ctx)][req.ValidatorAddr]}, nil
+}
+
+func (q *stakingQueryClient) ValidatorUnbondingDelegations(
+	ctx context.Context, req *staking.QueryValidatorUnbondingDelegationsRequest, _ ...grpc.CallOption,
+) (*staking.QueryValidatorUnbondingDelegationsResponse, error) {
+	q.queries++
+	return &staking.QueryValidatorUnbondingDelegationsResponse{UnbondingResponses: q.unbondings[queryHeight(ctx)][req.ValidatorAddr]}, nil
+}
+
+func (q *stakingQueryClient) Redelegations(
+	_ context.Context, req *staking.QueryRedelegationsRequest, _ ...grpc.CallOption,
+) (*staking.QueryRedelegationsResponse, error) {
+	q.queries++
+	return &staking.QueryRedelegationsResponse{RedelegationResponses: q.redelegations[req.SrcValidatorAddr]}, nil
+}
+
+func TestSlashChanges(t *testing.T) {
+	_, ir := MakeCodec()
+
+	newValidator := func() staking.Validator {
+		pubKey := ed25519.GenPrivKey().PubKey()
+		validator, err := staking.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, staking.Description{})
+		require.NoError(t, err)
+		return validator
+	}
+	slashed, dst, other := newValidator(), newValidator(), newValidator()
+	slashedAddr, dstAddr := slashed.GetOperator(), dst.GetOperator()
+
+	delegator := sdk.AccAddress("delegator")
+	unbonding := sdk.AccAddress("unbonding")
+	redelegator := sdk.AccAddress("redelegator")
+	dstDelegator := sdk.AccAddress("dst_delegator")
+
+	delegation := func(delegator sdk.AccAddress, validator sdk.ValAddress, amount int64) staking.DelegationResponse {
+		return staking.NewDelegationResp(delegator, validator, sdk.NewDec(amount), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	unbondingDelegation := func(amount int64) staking.UnbondingDelegation {
+		return staking.NewUnbondingDelegation(unbonding, slashedAddr, 1, time.Time{}, sdk.NewInt(amount))
+	}
+
+	q := &stakingQueryClient{
+		validators: []staking.Validator{other, slashed, dst},
+		delegations: map[int64]map[string][]staking.DelegationResponse{
+			9: {
+				slashedAddr.String(): {delegation(delegator, slashedAddr, 100)},
+				dstAddr.String():     {delegation(redelegator, dstAddr, 30), delegation(dstDelegator, dstAddr, 40)},
+			},
+			10: {
+				slashedAddr.String(): {delegation(delegator, slashedAddr, 95)},
+				dstAddr.String():     {delegation(redelegator, dstAddr, 28), delegation(dstDelegator, dstAddr, 40)},
+			},
+		},
+		unbondings: map[int64]map[string][]staking.UnbondingDelegation{
+			9:  {slashedAddr.String(): {unbondingDelegation(20)}},
+			10: {slashedAddr.String(): {unbondingDelegation(19)}},
+		},
+		redelegations: map[string][]staking.RedelegationResponse{
+			slashedAddr.String(): {staking.NewRedelegationResponse(redelegator, slashedAddr, dstAddr, nil)},
+		},
+	}
+	c := &Client{config: &Config{InterfaceRegistry: ir}, staking: q}
+
+	consAddr, err := slashed.GetConsAddr()
+	require.NoError(t, err)
+
+	changes, err := c.slashChanges(context.Background(), []string{consAddr.String()}, 9, 10)
+	require.NoError(t, err)
+
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+	require.Equal(t, []*subAccountChange{
+		{addr: delegator.String(), subAccount: SubAccountDelegated, before: stake(100), after: stake(95)},
+		{addr: unbonding.String(), subAccount: SubAccountUnbonding, before: stake(20), after: stake(19)},
+		{addr: redelegator.String(), subAccount: SubAccountDelegated, before: stake(30), after: stake(28)},
+	}, changes)
+
+	// the queries do not depend on the number of delegators
+	require.Equal(t, 9, q.queries)
+}
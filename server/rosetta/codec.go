@@ -5,7 +5,11 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingcodec "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz"
 	bankcodec "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributioncodec "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingcodec "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // MakeCodec generates the codec required to interact
@@ -15,7 +19,11 @@ func MakeCodec() (*codec.ProtoCodec, codectypes.InterfaceRegistry) {
 	cdc := codec.NewProtoCodec(ir)
 
 	authcodec.RegisterInterfaces(ir)
+	vestingcodec.RegisterInterfaces(ir)
+	authzcodec.RegisterInterfaces(ir)
 	bankcodec.RegisterInterfaces(ir)
+	distributioncodec.RegisterInterfaces(ir)
+	stakingcodec.RegisterInterfaces(ir)
 	cryptocodec.RegisterInterfaces(ir)
 
 	return cdc, ir
@@ -38,6 +38,7 @@ const (
 	FlagNetwork            = "network"
 	FlagTendermintEndpoint = "tendermint"
 	FlagGRPCEndpoint       = "grpc"
+	FlagGRPCAPIKey         = "grpc-api-key"
 	FlagAddr               = "addr"
 	FlagRetries            = "retries"
 	FlagOffline            = "offline"
@@ -57,6 +58,9 @@ type Config struct {
 	// GRPCEndpoint defines the cosmos application gRPC endpoint
 	// usually it is located at 9090 port
 	GRPCEndpoint string
+	// GRPCAPIKey defines the API key sent to the gRPC endpoint, when it
+	// requires one
+	GRPCAPIKey string
 	// Addr defines the default address to bind the rosetta server to
 	// defaults to DefaultAddr
 	Addr string
@@ -99,8 +103,10 @@ func (c *Config) validate() error {
 	if c.Network == "" {
 		return fmt.Errorf("network not provided")
 	}
+	// in offline mode, the signers data is provided by the metadata
+	// obtained from an online instance, so the node is never queried
 	if c.Offline {
-		return fmt.Errorf("offline mode is not supported for stargate implementation due to how sigv2 works")
+		return nil
 	}
 
 	// these are optional but it must be online
@@ -141,6 +147,10 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	gRPCAPIKey, err := flags.GetString(FlagGRPCAPIKey)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := flags.GetString(FlagAddr)
 	if err != nil {
 		return nil, err
@@ -158,6 +168,7 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 		Network:       network,
 		TendermintRPC: tendermintRPC,
 		GRPCEndpoint:  gRPCEndpoint,
+		GRPCAPIKey:    gRPCAPIKey,
 		Addr:          addr,
 		Retries:       retries,
 		Offline:       offline,
@@ -198,6 +209,7 @@ func SetFlags(flags *pflag.FlagSet) {
 	flags.String(FlagNetwork, DefaultNetwork, "the network name")
 	flags.String(FlagTendermintEndpoint, DefaultTendermintEndpoint, "the tendermint rpc endpoint, without tcp://")
 	flags.String(FlagGRPCEndpoint, DefaultGRPCEndpoint, "the app gRPC endpoint")
+	flags.String(FlagGRPCAPIKey, "", "the API key sent to the app gRPC endpoint, if it requires one")
 	flags.String(FlagAddr, DefaultAddr, "the address rosetta will bind to")
 	flags.Int(FlagRetries, DefaultRetries, "the number of retries that will be done before quitting")
 	flags.Bool(FlagOffline, DefaultOffline, "run rosetta only with construction API")
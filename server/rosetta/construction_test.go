@@ -0,0 +1,205 @@
+package rosetta_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ConstructionTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	client  *rosetta.Client
+	privKey *secp256k1.PrivKey
+}
+
+func (s *ConstructionTestSuite) SetupSuite() {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+	s.network = network.New(s.T(), cfg)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	val := s.network.Validators[0]
+	client, err := rosetta.NewClient(&rosetta.Config{
+		Network:           cfg.ChainID,
+		TendermintRPC:     val.RPCAddress,
+		GRPCEndpoint:      val.AppConfig.GRPC.Address,
+		Codec:             cfg.Codec.(*codec.ProtoCodec),
+		InterfaceRegistry: cfg.InterfaceRegistry,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(client.Bootstrap())
+	s.client = client
+
+	armor, err := val.ClientCtx.Keyring.ExportPrivKeyArmor(val.Moniker, "")
+	s.Require().NoError(err)
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, "")
+	s.Require().NoError(err)
+	s.privKey = privKey.(*secp256k1.PrivKey)
+}
+
+func (s *ConstructionTestSuite) TearDownSuite() {
+	s.network.Cleanup()
+}
+
+// sign signs the hash of the sign bytes given by a rosetta payload
+func (s *ConstructionTestSuite) sign(hash []byte) []byte {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), s.privKey.Key)
+	sig, err := priv.Sign(hash)
+	s.Require().NoError(err)
+
+	// R || S, each padded to 32 bytes
+	sigBytes := make([]byte, 64)
+	copy(sigBytes[32-len(sig.R.Bytes()):32], sig.R.Bytes())
+	copy(sigBytes[64-len(sig.S.Bytes()):64], sig.S.Bytes())
+	return sigBytes
+}
+
+func (s *ConstructionTestSuite) delegated() *big.Int {
+	val := s.network.Validators[0]
+	balances, err := s.client.SubAccountBalances(context.Background(), val.Address.String(), rosetta.SubAccountDelegated, nil)
+	s.Require().NoError(err)
+
+	for _, balance := range balances {
+		if balance.Currency.Symbol == s.network.Config.BondDenom {
+			value, ok := new(big.Int).SetString(balance.Value, 10)
+			s.Require().True(ok)
+			return value
+		}
+	}
+
+	s.FailNow("no balance of the bond denom")
+	return nil
+}
+
+func (s *ConstructionTestSuite) TestDelegateAndWithdrawRewards() {
+	ctx := context.Background()
+	val := s.network.Validators[0]
+	delegator := val.Address.String()
+
+	delegatedBefore := s.delegated()
+
+	ops := []*rosettatypes.Operation{
+		{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{Index: 0},
+			Type:                rosetta.OpTypeWithdrawRewards,
+			Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata:            map[string]interface{}{"validator_address": val.ValAddress.String()},
+		},
+		{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{Index: 1},
+			Type:                rosetta.OpTypeDelegate,
+			Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata: map[string]interface{}{
+				"validator_address": val.ValAddress.String(),
+				"amount":            sdk.NewInt64Coin(s.network.Config.BondDenom, 1000).String(),
+			},
+		},
+	}
+
+	preprocess, err := s.client.PreprocessOperationsToOptions(ctx, &rosettatypes.ConstructionPreprocessRequest{
+		Operations: ops,
+		Metadata: map[string]interface{}{
+			"gas_limit": 400000,
+			"gas_price": sdk.NewInt64Coin(s.network.Config.BondDenom, 10).String(),
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*rosettatypes.AccountIdentifier{{Address: delegator}}, preprocess.RequiredPublicKeys)
+
+	metadata, err := s.client.ConstructionMetadataFromOptions(ctx, preprocess.Options)
+	s.Require().NoError(err)
+
+	pubKey := &rosettatypes.PublicKey{Bytes: s.privKey.PubKey().Bytes(), CurveType: rosettatypes.Secp256k1}
+	payloads, err := s.client.ConstructionPayload(ctx, &rosettatypes.ConstructionPayloadsRequest{
+		Operations: ops,
+		Metadata:   metadata,
+		PublicKeys: []*rosettatypes.PublicKey{pubKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(payloads.Payloads, 1)
+
+	unsignedTx, err := hex.DecodeString(payloads.UnsignedTransaction)
+	s.Require().NoError(err)
+
+	parsedOps, _, err := s.client.TxOperationsAndSignersAccountIdentifiers(false, unsignedTx)
+	s.Require().NoError(err)
+	s.Require().Len(parsedOps, len(ops))
+	for i, op := range parsedOps {
+		s.Require().Equal(ops[i].Type, op.Type)
+		s.Require().Equal(ops[i].Metadata, op.Metadata)
+	}
+
+	signedTx, err := s.client.SignedTx(ctx, unsignedTx, []*rosettatypes.Signature{{
+		SigningPayload: payloads.Payloads[0],
+		PublicKey:      pubKey,
+		SignatureType:  rosettatypes.Ecdsa,
+		Bytes:          s.sign(payloads.Payloads[0].Bytes),
+	}})
+	s.Require().NoError(err)
+
+	_, signers, err := s.client.TxOperationsAndSignersAccountIdentifiers(true, signedTx)
+	s.Require().NoError(err)
+	s.Require().Equal([]*rosettatypes.AccountIdentifier{{Address: delegator}}, signers)
+
+	txID, _, err := s.client.PostTx(signedTx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	delegatedAfter := s.delegated()
+	s.Require().Equal(new(big.Int).Add(delegatedBefore, big.NewInt(1000)).String(), delegatedAfter.String())
+
+	// the block including the transaction reports the delegated coins
+	status, err := s.network.Validators[0].RPCClient.Status(ctx)
+	s.Require().NoError(err)
+
+	for height := status.SyncInfo.LatestBlockHeight; height > 0; height-- {
+		block, err := s.client.BlockTransactionsByHeight(ctx, &height)
+		s.Require().NoError(err)
+
+		var included bool
+		for _, tx := range block.Transactions {
+			included = included || tx.TransactionIdentifier.Hash == txID.Hash
+		}
+		if !included {
+			continue
+		}
+
+		endBlockTx := block.Transactions[len(block.Transactions)-1]
+		var delegated bool
+		for _, op := range endBlockTx.Operations {
+			if op.Type == rosetta.SubAccountBalanceChangeOpType && op.Account.Address == delegator &&
+				op.Account.SubAccount.Address == rosetta.SubAccountDelegated {
+				s.Require().Equal("1000", op.Amount.Value)
+				delegated = true
+			}
+		}
+		s.Require().True(delegated, "no delegated sub-account operation")
+		return
+	}
+
+	s.FailNow("transaction not found")
+}
+
+func TestConstructionTestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	suite.Run(t, new(ConstructionTestSuite))
+}
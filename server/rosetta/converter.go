@@ -30,7 +30,10 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Converter is a utility that can be used to convert
@@ -78,6 +81,11 @@ type ToRosettaConverter interface {
 	TxIdentifiers(txs []tmtypes.Tx) []*rosettatypes.TransactionIdentifier
 	// BalanceOps converts events to balance operations
 	BalanceOps(status string, events []abci.Event) []*rosettatypes.Operation
+	// SubAccountTouches returns the sub-accounts which might have changed in a block,
+	// and the consensus addresses of the validators slashed in it
+	SubAccountTouches(txs []tmtypes.Tx, txResults []*abci.ResponseDeliverTx, blockEvents []abci.Event) (touches []SubAccountTouch, slashedValidators []string, err error)
+	// SubAccountOps converts the balance change of a sub-account to operations
+	SubAccountOps(status, addr, subAccount string, before, after sdk.Coins) []*rosettatypes.Operation
 	// SyncStatus converts a tendermint status to sync status
 	SyncStatus(status *tmcoretypes.ResultStatus) *rosettatypes.SyncStatus
 	// Peers converts tendermint peers to rosetta
@@ -148,19 +156,9 @@ func (c converter) UnsignedTx(ops []*rosettatypes.Operation) (tx authsigning.Tx,
 	for i := 0; i < len(ops); i++ {
 		op := ops[i]
 
-		protoMessage, err := c.ir.Resolve(op.Type)
+		msg, err := c.opToMsg(op)
 		if err != nil {
-			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation not found: "+op.Type)
-		}
-
-		msg, ok := protoMessage.(sdk.Msg)
-		if !ok {
-			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation is not a valid supported sdk.Msg: "+op.Type)
-		}
-
-		err = c.Msg(op.Metadata, msg)
-		if err != nil {
-			return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+			return nil, err
 		}
 
 		// verify message correctness
@@ -188,19 +186,25 @@ func (c converter) UnsignedTx(ops []*rosettatypes.Operation) (tx authsigning.Tx,
 		// must be with the same name "v1.test.Send" and contain the other signers
 		// then we can just skip their processing
 		for j := 0; j < len(signers)-1; j++ {
-			skipOp := ops[i+j] // get the next index
+			if i+1 >= len(ops) {
+				return nil, crgerrs.WrapError(
+					crgerrs.ErrBadArgument,
+					fmt.Sprintf("operation of type %s expects %d signers, got: %d", op.Type, len(signers), j+1),
+				)
+			}
+			skipOp := ops[i+1] // get the next index
 			// verify that the operation is equal to the new one
 			if skipOp.Type != op.Type {
 				return nil, crgerrs.WrapError(
 					crgerrs.ErrBadArgument,
-					fmt.Sprintf("operation at index %d should have had type %s got: %s", i+j, op.Type, skipOp.Type),
+					fmt.Sprintf("operation at index %d should have had type %s got: %s", i+1, op.Type, skipOp.Type),
 				)
 			}
 
 			if !reflect.DeepEqual(op.Metadata, skipOp.Metadata) {
 				return nil, crgerrs.WrapError(
 					crgerrs.ErrBadArgument,
-					fmt.Sprintf("operation at index %d should have had metadata equal to %#v, got: %#v", i+j, op.Metadata, skipOp.Metadata))
+					fmt.Sprintf("operation at index %d should have had metadata equal to %#v, got: %#v", i+1, op.Metadata, skipOp.Metadata))
 			}
 
 			i++ // increase so we skip it
@@ -214,6 +218,32 @@ func (c converter) UnsignedTx(ops []*rosettatypes.Operation) (tx authsigning.Tx,
 	return builder.GetTx(), nil
 }
 
+// opToMsg converts a single operation to the sdk.Msg it describes, either
+// through a simplified operation type or through the type URL of the message
+func (c converter) opToMsg(op *rosettatypes.Operation) (sdk.Msg, error) {
+	msg, ok, err := stakingOpToMsg(op)
+	if ok {
+		return msg, err
+	}
+
+	protoMessage, err := c.ir.Resolve(op.Type)
+	if err != nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation not found: "+op.Type)
+	}
+
+	msg, ok = protoMessage.(sdk.Msg)
+	if !ok {
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation is not a valid supported sdk.Msg: "+op.Type)
+	}
+
+	err = c.Msg(op.Metadata, msg)
+	if err != nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+	}
+
+	return msg, nil
+}
+
 // Msg unmarshals the rosetta metadata to the given sdk.Msg
 func (c converter) Msg(meta map[string]interface{}, msg sdk.Msg) error {
 	metaBytes, err := json.Marshal(meta)
@@ -240,10 +270,22 @@ func (c converter) Meta(msg sdk.Msg) (meta map[string]interface{}, err error) {
 // Ops will create an operation for each msg signer
 // with the message proto name as type, and the raw fields
 // as metadata
+//
+// Staking and distribution messages which have a simplified operation type
+// are converted to it instead.
 func (c converter) Ops(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
 	opName := sdk.MsgTypeURL(msg)
 
-	meta, err := c.Meta(msg)
+	var (
+		meta map[string]interface{}
+		err  error
+	)
+	if stakingOpType, stakingMeta, ok := stakingMsgToOp(msg); ok {
+		opName = stakingOpType
+		meta, err = stakingMeta.ToMetadata()
+	} else {
+		meta, err = c.Meta(msg)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -405,6 +447,144 @@ func sdkEventToBalanceOperations(status string, event abci.Event) (operations []
 	return operations, true
 }
 
+// SubAccountTouches returns, without duplicates, the sub-accounts which might
+// have changed in a block: the ones of the accounts whose staking messages
+// succeeded, including the ones executed on their behalf
+// through authz, and the unbonding sub-accounts of the delegators whose
+// unbonding completed. The consensus addresses of the slashed validators are
+// returned separately, since the sub-accounts of all their delegators change.
+func (c converter) SubAccountTouches(
+	txs []tmtypes.Tx, txResults []*abci.ResponseDeliverTx, blockEvents []abci.Event,
+) (touches []SubAccountTouch, slashedValidators []string, err error) {
+	var accounts []string
+	touched := make(map[string]map[string]bool)
+	touch := func(addr string, subAccounts ...string) {
+		if addr == "" {
+			return
+		}
+
+		if touched[addr] == nil {
+			touched[addr] = make(map[string]bool)
+			accounts = append(accounts, addr)
+		}
+		for _, subAccount := range subAccounts {
+			touched[addr][subAccount] = true
+		}
+	}
+
+	for i, rawTx := range txs {
+		// failed transactions only pay fees, which do not affect sub-accounts
+		if txResults[i].Code != abci.CodeTypeOK {
+			continue
+		}
+
+		tx, err := c.txDecode(rawTx)
+		if err != nil {
+			return nil, nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+		}
+
+		if err := touchMsgsSubAccounts(tx.GetMsgs(), touch); err != nil {
+			return nil, nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+		}
+	}
+
+	seenValidators := make(map[string]bool)
+	for _, e := range blockEvents {
+		switch e.Type {
+		case stakingtypes.EventTypeCompleteUnbonding:
+			touch(eventAttribute(e, stakingtypes.AttributeKeyDelegator), SubAccountUnbonding)
+		case slashingtypes.EventTypeSlash:
+			consAddr := eventAttribute(e, slashingtypes.AttributeKeyAddress)
+			if consAddr != "" && !seenValidators[consAddr] {
+				seenValidators[consAddr] = true
+				slashedValidators = append(slashedValidators, consAddr)
+			}
+		}
+	}
+
+	for _, addr := range accounts {
+		touch := SubAccountTouch{Address: addr}
+		for _, subAccount := range SubAccounts {
+			if touched[addr][subAccount] {
+				touch.SubAccounts = append(touch.SubAccounts, subAccount)
+			}
+		}
+
+		touches = append(touches, touch)
+	}
+
+	return touches, slashedValidators, nil
+}
+
+// touchMsgsSubAccounts touches the sub-accounts changed by the given messages
+// and by the messages they execute through authz.
+func touchMsgsSubAccounts(msgs []sdk.Msg, touch func(addr string, subAccounts ...string)) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
+			touch(msg.DelegatorAddress, SubAccountDelegated)
+		case *stakingtypes.MsgDelegate:
+			touch(msg.DelegatorAddress, SubAccountDelegated)
+		case *stakingtypes.MsgBeginRedelegate:
+			touch(msg.DelegatorAddress, SubAccountDelegated)
+		case *stakingtypes.MsgUndelegate:
+			touch(msg.DelegatorAddress, SubAccountDelegated, SubAccountUnbonding)
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := touchMsgsSubAccounts(execMsgs, touch); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// eventAttribute returns the value of the first attribute of the event with the given key
+func eventAttribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value)
+		}
+	}
+
+	return ""
+}
+
+// SubAccountOps converts the change of the balance of a sub-account
+// to operations, one for each denom whose amount changed.
+func (c converter) SubAccountOps(status, addr, subAccount string, before, after sdk.Coins) []*rosettatypes.Operation {
+	var ops []*rosettatypes.Operation
+	for _, coin := range before.Add(after...) {
+		change := after.AmountOf(coin.Denom).Sub(before.AmountOf(coin.Denom))
+		if change.IsZero() {
+			continue
+		}
+
+		ops = append(ops, &rosettatypes.Operation{
+			Type:   SubAccountBalanceChangeOpType,
+			Status: &status,
+			Account: &rosettatypes.AccountIdentifier{
+				Address:    addr,
+				SubAccount: &rosettatypes.SubAccountIdentifier{Address: subAccount},
+			},
+			Amount: &rosettatypes.Amount{
+				Value: change.String(),
+				Currency: &rosettatypes.Currency{
+					Symbol:   coin.Denom,
+					Decimals: 0,
+				},
+			},
+		})
+	}
+
+	return ops
+}
+
 // Amounts converts []sdk.Coin to rosetta amounts
 func (c converter) Amounts(ownedCoins []sdk.Coin, availableCoins sdk.Coins) []*rosettatypes.Amount {
 	amounts := make([]*rosettatypes.Amount, len(availableCoins))
@@ -699,7 +879,7 @@ func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMe
 	for i, signer := range signers {
 		// assert that the provided public keys are correctly ordered
 		// by checking if the signer at index i matches the pubkey at index
-		pubKey, err := c.ToSDK().PubKey(rosPubKeys[0])
+		pubKey, err := c.ToSDK().PubKey(rosPubKeys[i])
 		if err != nil {
 			return nil, nil, err
 		}
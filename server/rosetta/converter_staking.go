@@ -0,0 +1,110 @@
+package rosetta
+
+import (
+	"fmt"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+
+	crgerrs "github.com/cosmos/cosmos-sdk/server/rosetta/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distribution "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingOperationTypes lists the simplified operation types
+// building staking and distribution messages
+var StakingOperationTypes = []string{OpTypeDelegate, OpTypeUndelegate, OpTypeRedelegate, OpTypeWithdrawRewards}
+
+// stakingOpToMsg builds the message described by a simplified staking or
+// distribution operation, whose account is the delegator. ok is false if the
+// operation is not one of them.
+func stakingOpToMsg(op *rosettatypes.Operation) (msg sdk.Msg, ok bool, err error) {
+	switch op.Type {
+	case OpTypeDelegate, OpTypeUndelegate, OpTypeRedelegate, OpTypeWithdrawRewards:
+	default:
+		return nil, false, nil
+	}
+
+	if op.Account == nil {
+		return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("%s operation has no account", op.Type))
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(op.Account.Address)
+	if err != nil {
+		return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	meta := new(StakingOperationMetadata)
+	if err := meta.FromMetadata(op.Metadata); err != nil {
+		return nil, true, err
+	}
+
+	if op.Type == OpTypeRedelegate {
+		src, err := sdk.ValAddressFromBech32(meta.SourceValidatorAddress)
+		if err != nil {
+			return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid source validator address: %s", err))
+		}
+
+		dst, err := sdk.ValAddressFromBech32(meta.DestinationValidatorAddress)
+		if err != nil {
+			return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid destination validator address: %s", err))
+		}
+
+		amount, err := sdk.ParseCoinNormalized(meta.Amount)
+		if err != nil {
+			return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid amount: %s", err))
+		}
+
+		return staking.NewMsgBeginRedelegate(delegator, src, dst, amount), true, nil
+	}
+
+	validator, err := sdk.ValAddressFromBech32(meta.ValidatorAddress)
+	if err != nil {
+		return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid validator address: %s", err))
+	}
+
+	if op.Type == OpTypeWithdrawRewards {
+		return distribution.NewMsgWithdrawDelegatorReward(delegator, validator), true, nil
+	}
+
+	amount, err := sdk.ParseCoinNormalized(meta.Amount)
+	if err != nil {
+		return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid amount: %s", err))
+	}
+
+	if op.Type == OpTypeDelegate {
+		return staking.NewMsgDelegate(delegator, validator, amount), true, nil
+	}
+
+	return staking.NewMsgUndelegate(delegator, validator, amount), true, nil
+}
+
+// stakingMsgToOp returns the simplified operation type and metadata of a
+// staking or distribution message. ok is false if the message has none.
+func stakingMsgToOp(msg sdk.Msg) (opType string, meta *StakingOperationMetadata, ok bool) {
+	switch msg := msg.(type) {
+	case *staking.MsgDelegate:
+		return OpTypeDelegate, &StakingOperationMetadata{
+			ValidatorAddress: msg.ValidatorAddress,
+			Amount:           msg.Amount.String(),
+		}, true
+	case *staking.MsgUndelegate:
+		return OpTypeUndelegate, &StakingOperationMetadata{
+			ValidatorAddress: msg.ValidatorAddress,
+			Amount:           msg.Amount.String(),
+		}, true
+	case *staking.MsgBeginRedelegate:
+		return OpTypeRedelegate, &StakingOperationMetadata{
+			SourceValidatorAddress:      msg.ValidatorSrcAddress,
+			DestinationValidatorAddress: msg.ValidatorDstAddress,
+			Amount:                      msg.Amount.String(),
+		}, true
+	case *distribution.MsgWithdrawDelegatorReward:
+		return OpTypeWithdrawRewards, &StakingOperationMetadata{
+			ValidatorAddress: msg.ValidatorAddress,
+		}, true
+	default:
+		return "", nil, false
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
@@ -21,7 +22,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashing "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type ConverterTestSuite struct {
@@ -182,6 +187,84 @@ func (s *ConverterTestSuite) TestOpsAndSigners() {
 	})
 }
 
+func (s *ConverterTestSuite) TestStakingOps() {
+	delegator := sdk.AccAddress("delegator").String()
+	val1 := sdk.ValAddress("validator1").String()
+	val2 := sdk.ValAddress("validator2").String()
+
+	ops := []*rosettatypes.Operation{
+		{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{Index: 0},
+			Type:                rosetta.OpTypeDelegate,
+			Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata:            map[string]interface{}{"validator_address": val1, "amount": "100stake"},
+		},
+		{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{Index: 1},
+			Type:                rosetta.OpTypeUndelegate,
+			Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata:            map[string]interface{}{"validator_address": val1, "amount": "10stake"},
+		},
+		{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{Index: 2},
+			Type:                rosetta.OpTypeRedelegate,
+			Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata: map[string]interface{}{
+				"source_validator_address": val1, "destination_validator_address": val2, "amount": "20stake",
+			},
+		},
+		{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{Index: 3},
+			Type:                rosetta.OpTypeWithdrawRewards,
+			Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata:            map[string]interface{}{"validator_address": val2},
+		},
+	}
+
+	s.Run("success", func() {
+		tx, err := s.c.ToSDK().UnsignedTx(ops)
+		s.Require().NoError(err)
+		s.Require().Len(tx.GetMsgs(), len(ops))
+		s.Require().IsType(&staking.MsgBeginRedelegate{}, tx.GetMsgs()[2])
+
+		txBytes, err := s.txConf.TxEncoder()(tx)
+		s.Require().NoError(err)
+
+		parsedOps, signers, err := s.c.ToRosetta().OpsAndSigners(txBytes)
+		s.Require().NoError(err)
+		s.Require().Len(signers, 1)
+		s.Require().Equal(delegator, signers[0].Address)
+
+		s.Require().Len(parsedOps, len(ops))
+		for i, op := range parsedOps {
+			s.Require().Equal(ops[i].Type, op.Type)
+			s.Require().Equal(ops[i].Account, op.Account)
+			s.Require().Equal(ops[i].Metadata, op.Metadata)
+		}
+	})
+
+	s.Run("invalid metadata", func() {
+		invalidOps := []*rosettatypes.Operation{
+			{
+				OperationIdentifier: &rosettatypes.OperationIdentifier{Index: 0},
+				Type:                rosetta.OpTypeDelegate,
+				Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+				Metadata:            map[string]interface{}{"validator_address": val1, "amount": "invalid"},
+			},
+		}
+		_, err := s.c.ToSDK().UnsignedTx(invalidOps)
+		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
+
+		invalidOps[0].Metadata = map[string]interface{}{"validator_address": delegator, "amount": "1stake"}
+		_, err = s.c.ToSDK().UnsignedTx(invalidOps)
+		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
+
+		invalidOps[0].Account = nil
+		_, err = s.c.ToSDK().UnsignedTx(invalidOps)
+		s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
+	})
+}
+
 func (s *ConverterTestSuite) TestBeginEndBlockAndHashToTxType() {
 	const deliverTxHex = "5229A67AA008B5C5F1A0AEA77D4DEBE146297A30AAEF01777AF10FAD62DD36AB"
 
@@ -338,6 +421,75 @@ func (s *ConverterTestSuite) TestBalanceOps() {
 	})
 }
 
+func (s *ConverterTestSuite) TestSubAccountTouches() {
+	delegator := sdk.AccAddress("delegator")
+	granter := sdk.AccAddress("granter")
+	grantee := sdk.AccAddress("grantee")
+	sender := sdk.AccAddress("sender")
+	receiver := sdk.AccAddress("receiver")
+	unbonded := sdk.AccAddress("unbonded")
+	failed := sdk.AccAddress("failed")
+	consAddr := sdk.ConsAddress("validator")
+
+	encodeTx := func(msgs ...sdk.Msg) tmtypes.Tx {
+		builder := s.txConf.NewTxBuilder()
+		s.Require().NoError(builder.SetMsgs(msgs...))
+		txBytes, err := s.txConf.TxEncoder()(builder.GetTx())
+		s.Require().NoError(err)
+		return txBytes
+	}
+
+	valAddr := sdk.ValAddress("validator")
+	stake := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{staking.NewMsgDelegate(granter, valAddr, stake[0])})
+
+	txs := []tmtypes.Tx{
+		encodeTx(staking.NewMsgDelegate(delegator, valAddr, stake[0])),
+		encodeTx(&exec),
+		encodeTx(bank.NewMsgSend(sender, receiver, stake), vesting.NewMsgCreateVestingAccount(sender, receiver, stake, 1, false)),
+		encodeTx(staking.NewMsgUndelegate(failed, valAddr, stake[0])),
+	}
+	txResults := []*abci.ResponseDeliverTx{{}, {}, {}, {Code: 1}}
+	blockEvents := []abci.Event{
+		(abci.Event)(sdk.NewEvent(staking.EventTypeCompleteUnbonding, sdk.NewAttribute(staking.AttributeKeyDelegator, unbonded.String()))),
+		(abci.Event)(sdk.NewEvent(staking.EventTypeCompleteUnbonding, sdk.NewAttribute(staking.AttributeKeyDelegator, delegator.String()))),
+		(abci.Event)(sdk.NewEvent(staking.EventTypeCompleteRedelegation, sdk.NewAttribute(staking.AttributeKeyDelegator, sender.String()))),
+		(abci.Event)(sdk.NewEvent(slashing.EventTypeSlash, sdk.NewAttribute(slashing.AttributeKeyAddress, consAddr.String()))),
+		(abci.Event)(sdk.NewEvent(slashing.EventTypeSlash, sdk.NewAttribute(slashing.AttributeKeyJailed, consAddr.String()))),
+	}
+
+	touches, slashed, err := s.c.ToRosetta().SubAccountTouches(txs, txResults, blockEvents)
+	s.Require().NoError(err)
+	s.Require().Equal([]rosetta.SubAccountTouch{
+		{Address: delegator.String(), SubAccounts: []string{rosetta.SubAccountDelegated, rosetta.SubAccountUnbonding}},
+		{Address: granter.String(), SubAccounts: []string{rosetta.SubAccountDelegated}},
+		{Address: unbonded.String(), SubAccounts: []string{rosetta.SubAccountUnbonding}},
+	}, touches)
+	s.Require().Equal([]string{consAddr.String()}, slashed)
+}
+
+func (s *ConverterTestSuite) TestSubAccountOps() {
+	addr := sdk.AccAddress("delegator").String()
+	before := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("utxo", 5))
+	after := sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 10))
+
+	ops := s.c.ToRosetta().SubAccountOps(rosetta.StatusTxSuccess, addr, rosetta.SubAccountDelegated, before, after)
+	s.Require().Len(ops, 2)
+
+	for _, op := range ops {
+		s.Require().Equal(rosetta.SubAccountBalanceChangeOpType, op.Type)
+		s.Require().Equal(addr, op.Account.Address)
+		s.Require().Equal(rosetta.SubAccountDelegated, op.Account.SubAccount.Address)
+	}
+
+	s.Require().Equal("atom", ops[0].Amount.Currency.Symbol)
+	s.Require().Equal("3", ops[0].Amount.Value)
+	s.Require().Equal("utxo", ops[1].Amount.Currency.Symbol)
+	s.Require().Equal("-5", ops[1].Amount.Value)
+
+	s.Require().Empty(s.c.ToRosetta().SubAccountOps(rosetta.StatusTxSuccess, addr, rosetta.SubAccountDelegated, before, before))
+}
+
 func TestConverterTestSuite(t *testing.T) {
 	suite.Run(t, new(ConverterTestSuite))
 }
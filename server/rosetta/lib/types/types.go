@@ -19,6 +19,8 @@ type NetworkInformationProvider interface {
 	OperationStatuses() []*types.OperationStatus
 	// Version returns the version of the node
 	Version() string
+	// BalanceExemptions lists the balances which can change without operations
+	BalanceExemptions() []*types.BalanceExemption
 }
 
 // Client defines the API the client implementation should provide.
@@ -37,6 +39,10 @@ type Client interface {
 	// if height is not nil, then the balance will be displayed
 	// at the provided height, otherwise last block balance will be returned
 	Balances(ctx context.Context, addr string, height *int64) ([]*types.Amount, error)
+	// SubAccountBalances fetches the balance of the given sub-account of an address
+	// if height is not nil, then the balance will be displayed
+	// at the provided height, otherwise last block balance will be returned
+	SubAccountBalances(ctx context.Context, addr, subAccount string, height *int64) ([]*types.Amount, error)
 	// BlockByHash gets a block and its transaction at the provided height
 	BlockByHash(ctx context.Context, hash string) (BlockResponse, error)
 	// BlockByHeight gets a block given its height, if height is nil then last block is returned
@@ -0,0 +1,183 @@
+package rosetta_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/client/testutil"
+)
+
+// ReconciliationTestSuite checks, as rosetta-cli does, that the balance changes
+// of the accounts involved in a simapp network are all reported as operations.
+type ReconciliationTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	client  *rosetta.Client
+}
+
+func (s *ReconciliationTestSuite) SetupSuite() {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 2
+	s.network = network.New(s.T(), cfg)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	val := s.network.Validators[0]
+	client, err := rosetta.NewClient(&rosetta.Config{
+		Network:           cfg.ChainID,
+		TendermintRPC:     val.RPCAddress,
+		GRPCEndpoint:      val.AppConfig.GRPC.Address,
+		Codec:             cfg.Codec.(*codec.ProtoCodec),
+		InterfaceRegistry: cfg.InterfaceRegistry,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(client.Bootstrap())
+	s.client = client
+}
+
+func (s *ReconciliationTestSuite) TearDownSuite() {
+	s.network.Cleanup()
+}
+
+// requireTxOK requires the output of a tx command to be a successful tx response
+func (s *ReconciliationTestSuite) requireTxOK(out testutil.BufferWriter, err error) {
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(s.network.Validators[0].ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().Zero(res.Code, res.RawLog)
+}
+
+// balance returns the non-zero amounts of the bank balance of addr, or of its
+// given sub-account, at the given height
+func (s *ReconciliationTestSuite) balance(addr, subAccount string, height int64) map[string]*big.Int {
+	var (
+		amounts []*rosettatypes.Amount
+		err     error
+	)
+	if subAccount == "" {
+		amounts, err = s.client.Balances(context.Background(), addr, &height)
+	} else {
+		amounts, err = s.client.SubAccountBalances(context.Background(), addr, subAccount, &height)
+	}
+	s.Require().NoError(err)
+
+	balance := make(map[string]*big.Int)
+	for _, amount := range amounts {
+		addAmount(balance, amount)
+	}
+
+	return balance
+}
+
+// addAmount adds amount to the balance of its currency, which is removed when zero
+func addAmount(balance map[string]*big.Int, amount *rosettatypes.Amount) {
+	value, ok := new(big.Int).SetString(amount.Value, 10)
+	if !ok {
+		panic(fmt.Sprintf("invalid amount %s", amount.Value))
+	}
+
+	symbol := amount.Currency.Symbol
+	if balance[symbol] != nil {
+		value.Add(value, balance[symbol])
+	}
+
+	if value.Sign() == 0 {
+		delete(balance, symbol)
+	} else {
+		balance[symbol] = value
+	}
+}
+
+func (s *ReconciliationTestSuite) TestReconcileBalances() {
+	ctx := context.Background()
+	val, val2 := s.network.Validators[0], s.network.Validators[1]
+	bondDenom := s.network.Config.BondDenom
+	vestingAddr := sdk.AccAddress("vesting_____________")
+
+	startHeight, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	// undelegating and redelegating withdraw the rewards accrued so far
+	s.requireTxOK(stakingtestutil.MsgUnbondExec(val.ClientCtx, val.Address, val.ValAddress, sdk.NewInt64Coin(bondDenom, 100)))
+	s.requireTxOK(stakingtestutil.MsgRedelegateExec(
+		val.ClientCtx, val.Address, val.ValAddress, val2.ValAddress, sdk.NewInt64Coin(bondDenom, 200),
+	))
+	s.requireTxOK(clitestutil.ExecTestCLICmd(val.ClientCtx, vestingcli.NewMsgCreateVestingAccountCmd(), []string{
+		vestingAddr.String(),
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)).String(),
+		"4070908800",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10)).String()),
+	}))
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	endHeight, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	// the balances at the start height, changed by the operations of the
+	// following blocks
+	accounts := []string{val.Address.String(), val2.Address.String(), vestingAddr.String()}
+	subAccounts := append([]string{""}, rosetta.SubAccounts...)
+	balances := make(map[string]map[string]*big.Int)
+	for _, addr := range accounts {
+		for _, subAccount := range subAccounts {
+			balances[addr+"/"+subAccount] = s.balance(addr, subAccount, startHeight)
+		}
+	}
+
+	for height := startHeight + 1; height <= endHeight; height++ {
+		block, err := s.client.BlockTransactionsByHeight(ctx, &height)
+		s.Require().NoError(err)
+
+		for _, tx := range block.Transactions {
+			for _, op := range tx.Operations {
+				if op.Status == nil || *op.Status != rosetta.StatusTxSuccess || op.Account == nil || op.Amount == nil {
+					continue
+				}
+
+				subAccount := ""
+				if op.Account.SubAccount != nil {
+					subAccount = op.Account.SubAccount.Address
+				}
+
+				if balance, ok := balances[op.Account.Address+"/"+subAccount]; ok {
+					addAmount(balance, op.Amount)
+				}
+			}
+		}
+	}
+
+	for _, addr := range accounts {
+		for _, subAccount := range subAccounts {
+			s.Require().Equal(s.balance(addr, subAccount, endHeight), balances[addr+"/"+subAccount], "%s/%s", addr, subAccount)
+		}
+	}
+
+	// the vesting coins are part of the bank balance
+	s.Require().Equal(big.NewInt(1000), balances[vestingAddr.String()+"/"][bondDenom])
+	s.Require().Equal(big.NewInt(100), balances[val.Address.String()+"/"+rosetta.SubAccountUnbonding][bondDenom])
+}
+
+func TestReconciliationTestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in-process network test in short mode")
+	}
+
+	suite.Run(t, new(ReconciliationTestSuite))
+}
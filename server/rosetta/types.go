@@ -31,6 +31,44 @@ const (
 	BurnerAddressIdentifier = "burner"
 )
 
+// Sub-accounts track the staked coins of an account, which are not part of its
+// bank balance. Their balance changes are reported as operations of type
+// SubAccountBalanceChangeOpType.
+//
+// Delegation rewards and vesting coins have no sub-account: they change at every
+// block without any event, so their operations could not be derived from the
+// blocks. Withdrawn rewards are reported as bank balance changes, and vesting
+// coins are part of the bank balance.
+const (
+	// SubAccountDelegated identifies the coins delegated to validators
+	SubAccountDelegated = "delegated"
+	// SubAccountUnbonding identifies the coins of unbonding delegations
+	SubAccountUnbonding = "unbonding"
+
+	// SubAccountBalanceChangeOpType is the type of the operations changing
+	// the balance of a sub-account.
+	SubAccountBalanceChangeOpType = "sub_account_balance_change"
+)
+
+// SubAccounts lists the supported sub-accounts
+var SubAccounts = []string{SubAccountDelegated, SubAccountUnbonding}
+
+// SubAccountTouch lists the sub-accounts of an account which might have changed in a block
+type SubAccountTouch struct {
+	Address     string
+	SubAccounts []string
+}
+
+// Simplified operation types building staking and distribution messages,
+// as an alternative to the message type URLs. Their account is the delegator,
+// and their metadata is a StakingOperationMetadata.
+const (
+	OpTypeDelegate        = "delegate"
+	OpTypeUndelegate      = "undelegate"
+	OpTypeRedelegate      = "redelegate"
+	OpTypeWithdrawRewards = "withdraw_rewards"
+)
+
 // TransactionType is used to distinguish if a rosetta provided hash
 // represents endblock, beginblock or deliver tx
 type TransactionType int
@@ -77,6 +115,25 @@ func (c *PreprocessOperationsOptionsResponse) FromMetadata(meta map[string]inter
 	return unmarshalMetadata(meta, c)
 }
 
+// StakingOperationMetadata is the metadata of the simplified staking and
+// distribution operations. Amount is a coin such as "100stake", which is not
+// set as the amount of the operation since it does not change the balance of
+// the delegator by itself: the balance operations of the transaction do.
+type StakingOperationMetadata struct {
+	ValidatorAddress            string `json:"validator_address,omitempty"`
+	SourceValidatorAddress      string `json:"source_validator_address,omitempty"`
+	DestinationValidatorAddress string `json:"destination_validator_address,omitempty"`
+	Amount                      string `json:"amount,omitempty"`
+}
+
+func (c StakingOperationMetadata) ToMetadata() (map[string]interface{}, error) {
+	return marshalMetadata(c)
+}
+
+func (c *StakingOperationMetadata) FromMetadata(meta map[string]interface{}) error {
+	return unmarshalMetadata(meta, c)
+}
+
 // SignerData contains information on the signers when the request
 // is being created, used to populate the account information
 type SignerData struct {
@@ -41,3 +41,8 @@ func marshalMetadata(o interface{}) (meta map[string]interface{}, err error) {
 
 	return
 }
+
+// stringPtr returns a pointer to the given string
+func stringPtr(s string) *string {
+	return &s
+}
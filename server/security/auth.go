@@ -0,0 +1,48 @@
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// APIKeyHeader defines the HTTP header, and gRPC metadata key, that carries
+// the client API key.
+const APIKeyHeader = "x-api-key"
+
+// apiKeys holds the set of accepted API keys.
+type apiKeys [][]byte
+
+func newAPIKeys(cfg config.AuthConfig) apiKeys {
+	keys := make(apiKeys, len(cfg.APIKeys))
+	for i, k := range cfg.APIKeys {
+		keys[i] = []byte(k)
+	}
+
+	return keys
+}
+
+// valid returns true if the given key is accepted. Keys are compared in
+// constant time and all keys are always compared to avoid leaking timing
+// information.
+func (ks apiKeys) valid(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	found := 0
+	for _, k := range ks {
+		found |= subtle.ConstantTimeCompare(k, []byte(key))
+	}
+
+	return found == 1
+}
+
+// apiKeyID returns a non-reversible client identifier derived from an API key,
+// so keys are never kept in the rate limiter state in plaintext.
+func apiKeyID(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return "key:" + hex.EncodeToString(h[:8])
+}
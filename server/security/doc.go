@@ -0,0 +1,12 @@
+/*
+Package security implements the transport security settings shared by the
+gRPC and REST API servers: TLS and mutual TLS, API-key authentication and
+per-client token-bucket rate limiting.
+
+Rate limited clients are identified by their API key when API keys are
+enforced, by the subject of their verified client certificate when mutual TLS
+is enabled, and by their remote IP address otherwise. Each client is given one
+bucket per matching method override plus one bucket shared by all other
+methods.
+*/
+package security
@@ -0,0 +1,97 @@
+package security
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// GRPCServerOptions returns the gRPC server options enforcing the TLS,
+// authentication and rate limiting settings of the given configuration.
+func GRPCServerOptions(cfg config.GRPCConfig) ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+
+	tlsCfg, err := NewTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	if tlsCfg != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	if g := newGuard(cfg.Auth, cfg.RateLimit); g != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(g.unaryInterceptor),
+			grpc.ChainStreamInterceptor(g.streamInterceptor),
+		)
+	}
+
+	return opts, nil
+}
+
+func (g *guard) unaryInterceptor(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := g.checkGRPC(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (g *guard) streamInterceptor(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	if err := g.checkGRPC(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+func (g *guard) checkGRPC(ctx context.Context, method string) error {
+	req := request{method: method}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(APIKeyHeader); len(vals) > 0 {
+			req.apiKey = vals[0]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			req.remoteAddr = hostOf(p.Addr.String())
+		}
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			req.certID = clientCertID(&info.State)
+		}
+	}
+
+	switch err := g.check(req); err {
+	case nil:
+		return nil
+	case errUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+}
+
+// hostOf strips the port from the given address, so that all the connections
+// of a client share the same rate limit.
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
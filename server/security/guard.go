@@ -0,0 +1,71 @@
+package security
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+var (
+	errUnauthenticated = errors.New("missing or invalid API key")
+	errRateLimited     = errors.New("rate limit exceeded")
+)
+
+// guard enforces API-key authentication and rate limiting on incoming
+// requests, independently of the transport they were received on.
+type guard struct {
+	keys    apiKeys
+	limiter *RateLimiter
+}
+
+// newGuard returns a guard for the given configuration, or nil if neither
+// authentication nor rate limiting is enabled.
+func newGuard(auth config.AuthConfig, rateLimit config.RateLimitConfig) *guard {
+	if !auth.Enabled() && !rateLimit.Enable {
+		return nil
+	}
+
+	g := &guard{keys: newAPIKeys(auth)}
+	if rateLimit.Enable {
+		g.limiter = NewRateLimiter(rateLimit)
+	}
+
+	return g
+}
+
+// request holds the transport agnostic information of an incoming request.
+type request struct {
+	method     string
+	apiKey     string
+	certID     string
+	remoteAddr string
+}
+
+// clientID returns the identifier used to rate limit the request's client.
+// Authenticated identities take precedence over the remote address. The API
+// key only identifies the client when API keys are enforced, as it has been
+// validated by then: otherwise any header value would get a fresh bucket.
+func (g *guard) clientID(req request) string {
+	switch {
+	case len(g.keys) > 0 && req.apiKey != "":
+		return apiKeyID(req.apiKey)
+	case req.certID != "":
+		return req.certID
+	default:
+		return "addr:" + req.remoteAddr
+	}
+}
+
+// check returns errUnauthenticated or errRateLimited if the request must be
+// rejected, and nil otherwise.
+func (g *guard) check(req request) error {
+	if len(g.keys) > 0 && !g.keys.valid(req.apiKey) {
+		return errUnauthenticated
+	}
+
+	if g.limiter != nil && !g.limiter.Allow(g.clientID(req), req.method) {
+		return errRateLimited
+	}
+
+	return nil
+}
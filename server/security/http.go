@@ -0,0 +1,36 @@
+package security
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// HTTPMiddleware wraps the given handler so that it enforces the
+// authentication and rate limiting settings of the given configuration. The
+// handler is returned unchanged if neither of them is enabled.
+func HTTPMiddleware(auth config.AuthConfig, rateLimit config.RateLimitConfig, next http.Handler) http.Handler {
+	g := newGuard(auth, rateLimit)
+	if g == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{
+			method:     r.URL.Path,
+			apiKey:     r.Header.Get(APIKeyHeader),
+			certID:     clientCertID(r.TLS),
+			remoteAddr: hostOf(r.RemoteAddr),
+		}
+
+		switch err := g.check(req); err {
+		case nil:
+			next.ServeHTTP(w, r)
+		case errUnauthenticated:
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+		default:
+			rest.WriteErrorResponse(w, http.StatusTooManyRequests, err.Error())
+		}
+	})
+}
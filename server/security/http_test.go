@@ -0,0 +1,63 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serve := func(h http.Handler, apiKey, remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/balances/foo", nil)
+		req.RemoteAddr = remoteAddr
+		if apiKey != "" {
+			req.Header.Set(APIKeyHeader, apiKey)
+		}
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		h := HTTPMiddleware(config.AuthConfig{}, config.RateLimitConfig{}, okHandler)
+		require.Equal(t, http.StatusOK, serve(h, "", "1.2.3.4:1000"))
+	})
+
+	t.Run("api keys", func(t *testing.T) {
+		h := HTTPMiddleware(config.AuthConfig{APIKeys: []string{"secret", "other"}}, config.RateLimitConfig{}, okHandler)
+		require.Equal(t, http.StatusUnauthorized, serve(h, "", "1.2.3.4:1000"))
+		require.Equal(t, http.StatusUnauthorized, serve(h, "wrong", "1.2.3.4:1000"))
+		require.Equal(t, http.StatusOK, serve(h, "secret", "1.2.3.4:1000"))
+		require.Equal(t, http.StatusOK, serve(h, "other", "1.2.3.4:1000"))
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		h := HTTPMiddleware(config.AuthConfig{}, config.RateLimitConfig{Enable: true, RequestsPerSecond: 0.001, Burst: 1}, okHandler)
+		require.Equal(t, http.StatusOK, serve(h, "", "1.2.3.4:1000"))
+		// a new connection from the same host shares the bucket
+		require.Equal(t, http.StatusTooManyRequests, serve(h, "", "1.2.3.4:2000"))
+		require.Equal(t, http.StatusOK, serve(h, "", "5.6.7.8:1000"))
+		// unvalidated API keys don't get a bucket of their own
+		require.Equal(t, http.StatusTooManyRequests, serve(h, "random", "1.2.3.4:1000"))
+		require.Equal(t, http.StatusTooManyRequests, serve(h, "other random", "1.2.3.4:1000"))
+	})
+
+	t.Run("rate limit with api keys", func(t *testing.T) {
+		h := HTTPMiddleware(config.AuthConfig{APIKeys: []string{"secret", "other"}}, config.RateLimitConfig{Enable: true, RequestsPerSecond: 0.001, Burst: 1}, okHandler)
+		require.Equal(t, http.StatusOK, serve(h, "secret", "1.2.3.4:1000"))
+		require.Equal(t, http.StatusTooManyRequests, serve(h, "secret", "1.2.3.4:1000"))
+		// validated API keys are limited independently from the remote address
+		require.Equal(t, http.StatusOK, serve(h, "other", "1.2.3.4:1000"))
+		require.Equal(t, http.StatusTooManyRequests, serve(h, "secret", "5.6.7.8:1000"))
+		require.Equal(t, http.StatusUnauthorized, serve(h, "random", "5.6.7.8:1000"))
+	})
+}
@@ -0,0 +1,132 @@
+package security
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// idleBucketTTL defines how long an unused client bucket is retained before it
+// is garbage collected.
+const idleBucketTTL = 10 * time.Minute
+
+// limit defines the refill rate and capacity of a token bucket.
+type limit struct {
+	prefix string
+	rate   float64
+	burst  float64
+}
+
+// unlimited returns true if requests matching the limit are never throttled.
+func (l limit) unlimited() bool {
+	return l.rate <= 0
+}
+
+type bucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+type bucketKey struct {
+	client string
+	prefix string
+}
+
+// RateLimiter implements a per-client token-bucket rate limiter with per-method
+// overrides. Each client gets a separate bucket for every override it hits, in
+// addition to one bucket for all the remaining methods. It is safe for
+// concurrent use.
+type RateLimiter struct {
+	defaultLimit limit
+	// overrides are sorted by decreasing prefix length so the first match is
+	// the longest one.
+	overrides []limit
+
+	mtx       sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter from the given configuration.
+func NewRateLimiter(cfg config.RateLimitConfig) *RateLimiter {
+	overrides := make([]limit, len(cfg.Overrides))
+	for i, o := range cfg.Overrides {
+		overrides[i] = limit{prefix: o.Method, rate: o.RequestsPerSecond, burst: float64(o.Burst)}
+	}
+
+	sort.SliceStable(overrides, func(i, j int) bool {
+		return len(overrides[i].prefix) > len(overrides[j].prefix)
+	})
+
+	return &RateLimiter{
+		defaultLimit: limit{rate: cfg.RequestsPerSecond, burst: float64(cfg.Burst)},
+		overrides:    overrides,
+		buckets:      make(map[bucketKey]*bucket),
+		now:          time.Now,
+	}
+}
+
+// Allow reports whether the given client may call the given method now, and
+// consumes a token from the matching bucket if so.
+func (rl *RateLimiter) Allow(client, method string) bool {
+	l := rl.limitFor(method)
+	if l.unlimited() {
+		return true
+	}
+
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	now := rl.now()
+	rl.sweep(now)
+
+	key := bucketKey{client: client, prefix: l.prefix}
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, lastSeen: now}
+		rl.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.lastSeen).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.lastSeen = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+func (rl *RateLimiter) limitFor(method string) limit {
+	for _, o := range rl.overrides {
+		if strings.HasPrefix(method, o.prefix) {
+			return o
+		}
+	}
+
+	return rl.defaultLimit
+}
+
+// sweep removes the buckets that have not been used for idleBucketTTL. The
+// caller must hold the mutex.
+func (rl *RateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < idleBucketTTL {
+		return
+	}
+
+	for k, b := range rl.buckets {
+		if now.Sub(b.lastSeen) >= idleBucketTTL {
+			delete(rl.buckets, k)
+		}
+	}
+
+	rl.lastSweep = now
+}
@@ -0,0 +1,83 @@
+package security
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func newTestRateLimiter(cfg config.RateLimitConfig) (*RateLimiter, *time.Time) {
+	now := time.Unix(1_000_000, 0)
+	rl := NewRateLimiter(cfg)
+	rl.now = func() time.Time { return now }
+
+	return rl, &now
+}
+
+func TestRateLimiterBurstAndRefill(t *testing.T) {
+	rl, now := newTestRateLimiter(config.RateLimitConfig{Enable: true, RequestsPerSecond: 2, Burst: 3})
+
+	for i := 0; i < 3; i++ {
+		require.True(t, rl.Allow("a", "/foo"))
+	}
+	require.False(t, rl.Allow("a", "/foo"))
+
+	// other clients have their own bucket
+	require.True(t, rl.Allow("b", "/foo"))
+
+	*now = now.Add(500 * time.Millisecond)
+	require.True(t, rl.Allow("a", "/foo"))
+	require.False(t, rl.Allow("a", "/foo"))
+
+	// refill is capped by the burst
+	*now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.True(t, rl.Allow("a", "/foo"))
+	}
+	require.False(t, rl.Allow("a", "/foo"))
+}
+
+func TestRateLimiterOverrides(t *testing.T) {
+	rl, _ := newTestRateLimiter(config.RateLimitConfig{
+		Enable:            true,
+		RequestsPerSecond: 1,
+		Burst:             1,
+		Overrides: []config.RateLimitOverride{
+			{Method: "/cosmos.bank.v1beta1.Query/", RequestsPerSecond: 1, Burst: 2},
+			{Method: "/cosmos.bank.v1beta1.Query/AllBalances", RequestsPerSecond: 1, Burst: 3},
+			{Method: "/cosmos.base.tendermint", RequestsPerSecond: 0},
+		},
+	})
+
+	// longest prefix wins
+	for i := 0; i < 3; i++ {
+		require.True(t, rl.Allow("a", "/cosmos.bank.v1beta1.Query/AllBalances"))
+	}
+	require.False(t, rl.Allow("a", "/cosmos.bank.v1beta1.Query/AllBalances"))
+
+	// overrides do not share buckets with each other nor with the default limit
+	require.True(t, rl.Allow("a", "/cosmos.bank.v1beta1.Query/Balance"))
+	require.True(t, rl.Allow("a", "/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, rl.Allow("a", "/cosmos.bank.v1beta1.Query/Balance"))
+	require.True(t, rl.Allow("a", "/cosmos.auth.v1beta1.Query/Account"))
+	require.False(t, rl.Allow("a", "/cosmos.staking.v1beta1.Query/Validators"))
+
+	// non-positive rates are unlimited
+	for i := 0; i < 100; i++ {
+		require.True(t, rl.Allow("a", "/cosmos.base.tendermint.v1beta1.Service/GetLatestBlock"))
+	}
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	rl, now := newTestRateLimiter(config.RateLimitConfig{Enable: true, RequestsPerSecond: 1, Burst: 1})
+
+	require.True(t, rl.Allow("a", "/foo"))
+	require.Len(t, rl.buckets, 1)
+
+	*now = now.Add(idleBucketTTL)
+	require.True(t, rl.Allow("b", "/foo"))
+	require.Len(t, rl.buckets, 1)
+}
@@ -0,0 +1,56 @@
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// NewTLSConfig builds a server side *tls.Config from the given configuration.
+// It returns nil if TLS is not enabled. When a client CA bundle is configured,
+// clients are required to present a certificate signed by one of its CAs.
+func NewTLSConfig(cfg config.TLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		bz, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("no valid certificates found in client CA file %s", cfg.ClientCAFile)
+		}
+
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+// clientCertID returns an identifier for the verified client certificate of
+// the given connection state, or an empty string if there is none.
+func clientCertID(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.PeerCertificates) == 0 {
+		return ""
+	}
+
+	return "cert:" + state.PeerCertificates[0].Subject.String()
+}
@@ -3,6 +3,8 @@ package server
 // DONTCOVER
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -30,6 +32,7 @@ import (
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 const (
@@ -55,6 +58,8 @@ const (
 	FlagMinRetainBlocks   = "min-retain-blocks"
 	FlagIAVLCacheSize     = "iavl-cache-size"
 	FlagIAVLFastNode      = "iavl-disable-fastnode"
+	FlagQueryCacheSize    = "query-cache-size"
+	FlagModuleLogLevels   = "module-log-levels"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -125,7 +130,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 			}
 
 			// amino is needed here for backwards compatibility of REST routes
-			err = startInProcess(serverCtx, clientCtx, appCreator)
+			err = startInProcess(serverCtx, clientCtx, appCreator, nil)
 			errCode, ok := err.(ErrorCode)
 			if !ok {
 				return err
@@ -166,6 +171,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
 	cmd.Flags().Bool(FlagIAVLFastNode, true, "Enable fast node for IAVL tree")
+	cmd.Flags().Uint64(FlagQueryCacheSize, 0, "Number of gRPC query responses to keep in the height-keyed query cache (0 disables the cache)")
+	cmd.Flags().String(FlagModuleLogLevels, "", "Comma-separated module:level pairs overriding the log level of modules, e.g. x/bank:debug,consensus:error")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -212,7 +219,12 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	return WaitForQuitSignals()
 }
 
-func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
+// startInProcess starts the application in-process with Tendermint. When
+// prepareApp is non-nil it is called with the freshly created application
+// before the Tendermint node is created.
+func startInProcess(
+	ctx *Context, clientCtx client.Context, appCreator types.AppCreator, prepareApp func(types.Application) error,
+) error {
 	cfg := ctx.Config
 	home := cfg.RootDir
 	var cpuProfileCleanup func()
@@ -257,7 +269,17 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			"(SDK v0.45). Please explicitly put the desired minimum-gas-prices in your app.toml.")
 	}
 
+	shutdownTracing, err := telemetry.NewTracerProvider(config.Telemetry)
+	if err != nil {
+		return err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
+	if prepareApp != nil {
+		if err := prepareApp(app); err != nil {
+			return err
+		}
+	}
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
@@ -342,7 +364,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	)
 
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC)
 		if err != nil {
 			return err
 		}
@@ -373,11 +395,23 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			offlineMode = true
 		}
 
+		// The rosetta client connects to the gRPC server without TLS, and
+		// cannot present the client certificate required by mutual TLS.
+		if !offlineMode && config.GRPC.TLS.Enabled() {
+			return errors.New("rosetta cannot connect to a gRPC server with TLS enabled: disable [grpc.tls], or enable rosetta offline mode")
+		}
+
+		var grpcAPIKey string
+		if config.GRPC.Auth.Enabled() {
+			grpcAPIKey = config.GRPC.Auth.APIKeys[0]
+		}
+
 		conf := &rosetta.Config{
 			Blockchain:        config.Rosetta.Blockchain,
 			Network:           config.Rosetta.Network,
 			TendermintRPC:     ctx.Config.RPC.ListenAddress,
 			GRPCEndpoint:      config.GRPC.Address,
+			GRPCAPIKey:        grpcAPIKey,
 			Addr:              config.Rosetta.Address,
 			Retries:           config.Rosetta.Retries,
 			Offline:           offlineMode,
@@ -414,6 +448,10 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			cpuProfileCleanup()
 		}
 
+		if err := shutdownTracing(context.Background()); err != nil {
+			ctx.Logger.Error("failed to flush traces", "err", err)
+		}
+
 		if apiSrv != nil {
 			_ = apiSrv.Close()
 		}
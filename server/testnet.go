@@ -0,0 +1,300 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/node"
+	pvm "github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	// in-place testnet flags
+	FlagAccountsToFund = "accounts-to-fund"
+	FlagFundAmount     = "fund-amount"
+	FlagVotingPeriod   = "voting-period"
+
+	// testnetValidatorPower is the consensus power given to the single
+	// validator of an in-place testnet.
+	testnetValidatorPower int64 = 1000000
+)
+
+// genesisDocKey is the key of the genesis doc in Tendermint's state database.
+var genesisDocKey = []byte("genesisDoc")
+
+// InPlaceTestnetCmd creates a command that rewrites an existing data directory
+// into a local testnet driven by the node's own validator key, then starts the
+// node. It accepts the same flags as the start command.
+func InPlaceTestnetCmd(
+	appCreator types.AppCreator, testnetCreator types.InPlaceTestnetCreator, defaultNodeHome string,
+) *cobra.Command {
+	cmd := StartCmd(appCreator, defaultNodeHome)
+	cmd.Use = "in-place-testnet [new-chain-id] [new-operator-address]"
+	cmd.Short = "Fork a local testnet from the existing state of the node"
+	cmd.Long = `Rewrite the state of an existing data directory so that the validator whose
+key is in priv_validator_key.json can continue the chain on its own, then start
+the node. This is intended to rehearse upgrades against a copy of the state of
+a live network.
+
+The application state is modified so that every existing validator is jailed and
+unbonded, and a new validator is created with the operator address given as
+argument and the consensus key of this node. The accounts given with
+'--accounts-to-fund' receive '--fund-amount', and '--voting-period' shortens the
+governance voting period, including the one of proposals currently being voted.
+
+Tendermint's state is updated to match: the chain-id is replaced in the genesis
+file and in the state database, the validator sets only contain this node, the
+commit of the last block is re-signed with this node's key and the address book
+is cleared.
+
+The data directory must have been stopped cleanly. Never run this command on a
+copy of the data directory of a validator of the original network.
+`
+	cmd.Example = fmt.Sprintf("%s in-place-testnet localchain-1 cosmosvaloper1... --accounts-to-fund cosmos1...,cosmos1... --voting-period 60s", version.AppName)
+	cmd.Args = cobra.ExactArgs(2)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		serverCtx := GetServerContextFromCmd(cmd)
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		testnetArgs, err := parseInPlaceTestnetArgs(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		err = startInProcess(serverCtx, clientCtx, appCreator, func(app types.Application) error {
+			return testnetify(serverCtx, app, testnetCreator, testnetArgs)
+		})
+		errCode, ok := err.(ErrorCode)
+		if !ok {
+			return err
+		}
+
+		serverCtx.Logger.Debug(fmt.Sprintf("received quit signal: %d", errCode.Code))
+		return nil
+	}
+
+	cmd.Flags().StringSlice(FlagAccountsToFund, nil, "Comma-separated list of account addresses to fund")
+	cmd.Flags().String(FlagFundAmount, "", "Amount sent to each of the accounts to fund (defaults to an amount of the bond denom chosen by the application)")
+	cmd.Flags().Duration(FlagVotingPeriod, 0, "Governance voting period of the testnet (0 keeps the current one)")
+
+	return cmd
+}
+
+func parseInPlaceTestnetArgs(cmd *cobra.Command, args []string) (types.InPlaceTestnetArgs, error) {
+	chainID := args[0]
+	if chainID == "" {
+		return types.InPlaceTestnetArgs{}, errors.New("new chain-id cannot be empty")
+	}
+
+	operator, err := sdk.ValAddressFromBech32(args[1])
+	if err != nil {
+		return types.InPlaceTestnetArgs{}, fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	rawAccounts, err := cmd.Flags().GetStringSlice(FlagAccountsToFund)
+	if err != nil {
+		return types.InPlaceTestnetArgs{}, err
+	}
+
+	accounts := make([]sdk.AccAddress, len(rawAccounts))
+	for i, raw := range rawAccounts {
+		if accounts[i], err = sdk.AccAddressFromBech32(raw); err != nil {
+			return types.InPlaceTestnetArgs{}, fmt.Errorf("invalid account to fund %s: %w", raw, err)
+		}
+	}
+
+	rawAmount, err := cmd.Flags().GetString(FlagFundAmount)
+	if err != nil {
+		return types.InPlaceTestnetArgs{}, err
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(rawAmount)
+	if err != nil {
+		return types.InPlaceTestnetArgs{}, fmt.Errorf("invalid fund amount: %w", err)
+	}
+
+	votingPeriod, err := cmd.Flags().GetDuration(FlagVotingPeriod)
+	if err != nil {
+		return types.InPlaceTestnetArgs{}, err
+	}
+
+	return types.InPlaceTestnetArgs{
+		ChainID:         chainID,
+		ValidatorPower:  testnetValidatorPower,
+		OperatorAddress: operator,
+		AccountsToFund:  accounts,
+		FundAmount:      amount,
+		VotingPeriod:    votingPeriod,
+	}, nil
+}
+
+// testnetify lets the application rewrite its state so that the node's own
+// validator is the only validator of the chain, then rewrites Tendermint's
+// genesis file, block store and state store accordingly.
+//
+// The application only keeps its rewritten state once the next block is
+// committed, so that the data directory is left untouched if the rewrite fails.
+// Tendermint's state is only modified once the application state has been
+// rewritten.
+func testnetify(
+	ctx *Context, app types.Application, testnetCreator types.InPlaceTestnetCreator, args types.InPlaceTestnetArgs,
+) error {
+	cfg := ctx.Config
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	state, _, err := node.LoadStateFromDBOrGenesisDocProvider(stateDB, node.DefaultGenesisDocProviderFunc(cfg))
+	if err != nil {
+		return err
+	}
+
+	height := state.LastBlockHeight
+	if height == 0 {
+		return errors.New("cannot fork a testnet from a chain without blocks")
+	}
+
+	appHeight := app.Info(proxy.RequestInfo).LastBlockHeight
+	if appHeight != height {
+		return fmt.Errorf("application height %d does not match Tendermint state height %d; start the node and stop it cleanly first", appHeight, height)
+	}
+
+	// A block saved but not yet applied has been committed by the original
+	// validator set and can no longer be replayed: it is forgotten below.
+	blockStoreState := store.LoadBlockStoreState(blockStoreDB)
+	if blockStoreState.Height != height && blockStoreState.Height != height+1 {
+		return fmt.Errorf("block store height %d does not match Tendermint state height %d", blockStoreState.Height, height)
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	genDoc.ChainID = args.ChainID
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return err
+	}
+
+	privValidator := pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+	pubKey, err := privValidator.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	args.ValidatorPubKey = pubKey
+	if err := testnetCreator(app, args); err != nil {
+		return fmt.Errorf("failed to rewrite the application state: %w", err)
+	}
+
+	if appHeight := app.Info(proxy.RequestInfo).LastBlockHeight; appHeight != height {
+		return fmt.Errorf("application committed its rewritten state at height %d; it must keep it uncommitted at height %d", appHeight, height)
+	}
+
+	// Re-sign the last block so that the next one carries a valid last commit.
+	// As with Tendermint's BFT time, the vote cannot be earlier than the last
+	// block time plus the time iota, since it gives the next block its time.
+	timestamp := tmtime.Now()
+	minTimestamp := state.LastBlockTime.Add(time.Duration(state.ConsensusParams.Block.TimeIotaMs) * time.Millisecond)
+	if timestamp.Before(minTimestamp) {
+		timestamp = minTimestamp
+	}
+
+	vote := tmtypes.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           height,
+		Round:            0,
+		BlockID:          state.LastBlockID,
+		Timestamp:        timestamp,
+		ValidatorAddress: pubKey.Address(),
+		ValidatorIndex:   0,
+	}
+
+	voteProto := vote.ToProto()
+	if err := privValidator.SignVote(args.ChainID, voteProto); err != nil {
+		return fmt.Errorf("failed to sign the last commit: %w", err)
+	}
+
+	seenCommit := tmtypes.NewCommit(height, vote.Round, state.LastBlockID, []tmtypes.CommitSig{{
+		BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+		ValidatorAddress: vote.ValidatorAddress,
+		Timestamp:        voteProto.Timestamp,
+		Signature:        voteProto.Signature,
+	}})
+
+	validators := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, args.ValidatorPower)})
+
+	state.ChainID = args.ChainID
+	state.Validators = validators
+	state.LastValidators = validators.Copy()
+	state.NextValidators = validators.Copy()
+	state.LastHeightValidatorsChanged = height
+
+	// From here on, Tendermint's state is modified.
+	if blockStoreState.Height == height+1 {
+		blockStoreState.Height = height
+		store.SaveBlockStoreState(&blockStoreState, blockStoreDB)
+	}
+
+	if err := store.NewBlockStore(blockStoreDB).SaveSeenCommit(height, seenCommit); err != nil {
+		return err
+	}
+
+	// Bootstrap saves the validator sets of the last, current and next heights
+	// along with the state.
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false})
+	if err := stateStore.Bootstrap(state); err != nil {
+		return err
+	}
+
+	if err := genDoc.SaveAs(cfg.GenesisFile()); err != nil {
+		return err
+	}
+
+	// Tendermint keeps a copy of the genesis doc in its state database, which
+	// it only reads from the genesis file when it is missing.
+	if err := stateDB.DeleteSync(genesisDocKey); err != nil {
+		return err
+	}
+	if _, _, err := node.LoadStateFromDBOrGenesisDocProvider(stateDB, node.DefaultGenesisDocProviderFunc(cfg)); err != nil {
+		return err
+	}
+
+	// peers of the original network must not be dialed
+	if err := os.Remove(cfg.P2P.AddrBookFile()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	ctx.Logger.Info(
+		"forked in-place testnet", "chain-id", args.ChainID, "height", height,
+		"validator", pubKey.Address().String(), "operator", args.OperatorAddress.String(),
+	)
+
+	return nil
+}
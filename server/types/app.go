@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -90,4 +91,32 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
+
+	// InPlaceTestnetArgs defines the parameters of an in-place testnet, i.e. a
+	// local network forked from the existing state of another chain.
+	InPlaceTestnetArgs struct {
+		// ChainID is the chain-id of the new network.
+		ChainID string
+		// ValidatorPubKey is the consensus public key of the single validator
+		// of the new network.
+		ValidatorPubKey crypto.PubKey
+		// ValidatorPower is the consensus power Tendermint assigns to that
+		// validator.
+		ValidatorPower int64
+		// OperatorAddress is the operator address of that validator.
+		OperatorAddress sdk.ValAddress
+		// AccountsToFund are the accounts that receive FundAmount.
+		AccountsToFund []sdk.AccAddress
+		// FundAmount is the amount sent to each of AccountsToFund. When empty,
+		// the application chooses a default.
+		FundAmount sdk.Coins
+		// VotingPeriod, when positive, replaces the governance voting period,
+		// including the one of proposals currently in their voting period.
+		VotingPeriod time.Duration
+	}
+
+	// InPlaceTestnetCreator is a function that rewrites the state of an
+	// application so that the validator described by InPlaceTestnetArgs can
+	// continue the chain on its own.
+	InPlaceTestnetCreator func(Application, InPlaceTestnetArgs) error
 )
@@ -16,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -57,7 +58,7 @@ func NewDefaultContext() *Context {
 	return NewContext(
 		viper.New(),
 		tmcfg.DefaultConfig(),
-		ZeroLogWrapper{log.Logger},
+		ZeroLogWrapper{Logger: log.Logger},
 	)
 }
 
@@ -151,7 +152,12 @@ func InterceptConfigsPreRunHandler(cmd *cobra.Command, customAppConfigTemplate s
 		return fmt.Errorf("failed to parse log level (%s): %w", logLvlStr, err)
 	}
 
-	serverCtx.Logger = ZeroLogWrapper{zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()}
+	moduleLogLevels, err := ParseModuleLogLevels(serverCtx.Viper.GetString(FlagModuleLogLevels))
+	if err != nil {
+		return err
+	}
+
+	serverCtx.Logger = NewZeroLogWrapper(zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger(), moduleLogLevels)
 
 	return SetCmdServerContext(cmd, serverCtx)
 }
@@ -287,6 +293,18 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 	)
 }
 
+// AddTestnetCreatorCommand adds the in-place-testnet command to the root
+// command. It shares the flags of the start command, including the ones added
+// by addStartFlags.
+func AddTestnetCreatorCommand(
+	rootCmd *cobra.Command, defaultNodeHome string, appCreator types.AppCreator,
+	testnetCreator types.InPlaceTestnetCreator, addStartFlags types.ModuleInitFlags,
+) {
+	testnetCmd := InPlaceTestnetCmd(appCreator, testnetCreator, defaultNodeHome)
+	addStartFlags(testnetCmd)
+	rootCmd.AddCommand(testnetCmd)
+}
+
 // https://stackoverflow.com/questions/23558425/how-do-i-get-the-local-ip-address-in-go
 // TODO there must be a better way to get external IP
 func ExternalIP() (string, error) {
@@ -375,6 +393,21 @@ func addrToIP(addr net.Addr) net.IP {
 	return ip
 }
 
+// GenesisFile returns the path of the genesis file of the node whose home
+// directory is homePath, given by the genesis_file option of its Tendermint
+// config as Config.GenesisFile does.
+func GenesisFile(homePath string, appOpts types.AppOptions) string {
+	genesisFile := cast.ToString(appOpts.Get("genesis_file"))
+	if genesisFile == "" {
+		genesisFile = tmcfg.DefaultBaseConfig().Genesis
+	}
+	if filepath.IsAbs(genesisFile) {
+		return genesisFile
+	}
+
+	return filepath.Join(homePath, genesisFile)
+}
+
 func openDB(rootDir string) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return sdk.NewLevelDB("application", dataDir)
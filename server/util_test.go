@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -400,3 +401,27 @@ func TestInterceptConfigsWithBadPermissions(t *testing.T) {
 		t.Fatalf("Failed to catch permissions error, got: [%T] %v", err, err)
 	}
 }
+
+func TestGenesisFile(t *testing.T) {
+	home := filepath.Join("/", "node")
+
+	testCases := []struct {
+		genesisFile string
+		expected    string
+	}{
+		{"", filepath.Join(home, "config", "genesis.json")},
+		{filepath.Join("other", "genesis.json"), filepath.Join(home, "other", "genesis.json")},
+		{filepath.Join("/", "genesis.json"), filepath.Join("/", "genesis.json")},
+	}
+
+	for _, tc := range testCases {
+		v := viper.New()
+		if tc.genesisFile != "" {
+			v.Set("genesis_file", tc.genesisFile)
+		}
+
+		if genesisFile := server.GenesisFile(home, v); genesisFile != tc.expected {
+			t.Errorf("genesis file %q: expected %s, got %s", tc.genesisFile, tc.expected, genesisFile)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -33,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
@@ -144,8 +146,11 @@ type SimApp struct {
 	legacyAmino       *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
+	homePath       string
+	genesisFile    string
 
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
@@ -225,7 +230,10 @@ func NewSimApp(
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
+		homePath:          homePath,
+		genesisFile:       server.GenesisFile(homePath, appOpts),
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
@@ -245,6 +253,14 @@ func NewSimApp(
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
 	)
+	authenticators := app.AccountKeeper.AuthenticatorRegistry()
+	authenticators.Register(
+		authenticator.NewSignatureKey(appCodec),
+		authenticator.NewMessageFilter(),
+		authenticator.NewTimeWindow(),
+		authenticator.NewSpendLimit(banktypes.SpentCoins),
+		authenticator.NewAllOf(authenticators),
+	)
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
 	)
@@ -441,6 +457,26 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 		panic(err)
 	}
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+
+	// the genesis state may be split into a directory, relative to the
+	// directory holding the genesis file
+	genesisDir, ok, err := module.GenesisDirFromAppState(genesisState)
+	if err != nil {
+		panic(err)
+	}
+	if ok {
+		if !filepath.IsAbs(genesisDir.Path) {
+			genesisDir.Path = filepath.Join(filepath.Dir(app.genesisFile), genesisDir.Path)
+		}
+
+		res, err := app.mm.InitGenesisFromDir(ctx, app.appCodec, app.txConfig, genesisDir)
+		if err != nil {
+			panic(err)
+		}
+
+		return res
+	}
+
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
@@ -1,25 +1,33 @@
 package simapp
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -32,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -262,3 +271,109 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 		require.Equal(t, vm[v], i.ConsensusVersion())
 	}
 }
+
+func TestSimAppExportAndInitGenesisDir(t *testing.T) {
+	privVal := tmed25519.GenPrivKey()
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(privVal.PubKey(), 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	AddTestAddrs(app, ctx, 5, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+	app.EndBlock(abci.RequestEndBlock{Height: app.LastBlockHeight() + 1})
+	app.Commit()
+
+	expected, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	// the genesis file of the node is next to the directory
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	genesisDir := filepath.Join(filepath.Dir(genesisFile), "genesis")
+	exported, err := app.ExportAppStateAndValidatorsToDir(false, []string{}, genesisDir)
+	require.NoError(t, err)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+	dir, ok, err := module.GenesisDirFromAppState(appState)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, genesisDir, dir.Path)
+	require.NoError(t, dir.Verify())
+
+	for _, name := range []string{
+		authtypes.ModuleName + "." + authkeeper.GenesisAccountsCollection,
+		banktypes.ModuleName + "." + bankkeeper.GenesisBalancesCollection,
+		stakingtypes.ModuleName + "." + staking.GenesisDelegationsCollection,
+	} {
+		_, err := os.Stat(filepath.Join(genesisDir, name+".jsonl"))
+		require.NoError(t, err, name)
+		require.Contains(t, dir.Files, name+".jsonl")
+	}
+
+	initChain := func(appState json.RawMessage) *SimApp {
+		appOpts := appOptions{"genesis_file": genesisFile}
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeTestEncodingConfig(), appOpts)
+		app.InitChain(abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: DefaultConsensusParams,
+			AppStateBytes:   appState,
+		})
+		return app
+	}
+
+	// the files must match their checksums
+	tampered := dir
+	tampered.Files = make(map[string]string, len(dir.Files))
+	for name, checksum := range dir.Files {
+		tampered.Files[name] = checksum
+	}
+	tampered.Files[banktypes.ModuleName+".json"] = hex.EncodeToString(make([]byte, 32))
+	require.Panics(t, func() { initChain(tampered.AppState()) })
+
+	// the genesis state of every module must be in the directory
+	missing := dir
+	missing.Files = make(map[string]string, len(dir.Files))
+	for name, checksum := range dir.Files {
+		if name != banktypes.ModuleName+".json" {
+			missing.Files[name] = checksum
+		}
+	}
+	missing.Path = t.TempDir()
+	for name := range missing.Files {
+		bz, err := os.ReadFile(filepath.Join(genesisDir, name))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(missing.Path, name), bz, 0o644))
+	}
+	require.Panics(t, func() { initChain(missing.AppState()) })
+
+	app2 := initChain(exported.AppState)
+	app2.Commit()
+
+	actual, err := app2.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected.AppState), string(actual.AppState))
+
+	// a relative directory is relative to the genesis file of the node, not
+	// to its home directory
+	relative := dir
+	relative.Path = "genesis"
+	app3 := initChain(relative.AppState())
+	app3.Commit()
+
+	actual, err = app3.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected.AppState), string(actual.AppState))
+}
+
+// appOptions are the app options of a test app.
+type appOptions map[string]interface{}
+
+func (ao appOptions) Get(o string) interface{} {
+	return ao[o]
+}
@@ -17,6 +17,22 @@ import (
 // file.
 func (app *SimApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string,
+) (servertypes.ExportedApp, error) {
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, "")
+}
+
+// ExportAppStateAndValidatorsToDir exports the state of the application like
+// ExportAppStateAndValidators, except that the genesis state of each module is
+// written to its own file in genesisDir instead of being returned as the app
+// state.
+func (app *SimApp) ExportAppStateAndValidatorsToDir(
+	forZeroHeight bool, jailAllowedAddrs []string, genesisDir string,
+) (servertypes.ExportedApp, error) {
+	return app.exportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, genesisDir)
+}
+
+func (app *SimApp) exportAppStateAndValidators(
+	forZeroHeight bool, jailAllowedAddrs []string, genesisDir string,
 ) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
@@ -29,10 +45,21 @@ func (app *SimApp) ExportAppStateAndValidators(
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	}
 
-	genState := app.mm.ExportGenesis(ctx, app.appCodec)
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
-		return servertypes.ExportedApp{}, err
+	var appState json.RawMessage
+	if genesisDir != "" {
+		dir, err := app.mm.ExportGenesisToDir(ctx, app.appCodec, genesisDir)
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		appState = dir.AppState()
+	} else {
+		genState := app.mm.ExportGenesis(ctx, app.appCodec)
+
+		var err error
+		appState, err = json.MarshalIndent(genState, "", "  ")
+		if err != nil {
+			return servertypes.ExportedApp{}, err
+		}
 	}
 
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
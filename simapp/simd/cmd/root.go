@@ -159,6 +159,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	)
 
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	server.AddTestnetCreatorCommand(rootCmd, simapp.DefaultNodeHome, a.newApp, a.initTestnet, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -214,6 +215,8 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetBundleCommand(),
+		authcmd.GetComposeCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
@@ -277,11 +280,22 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(server.FlagIAVLFastNode))),
+		baseapp.SetQueryCacheSize(cast.ToInt(appOpts.Get(server.FlagQueryCacheSize))),
 	)
 }
 
 // appExport creates a new simapp (optionally at a given height)
 // and exports state.
+// initTestnet rewrites the state of a SimApp for an in-place testnet.
+func (a appCreator) initTestnet(app servertypes.Application, args servertypes.InPlaceTestnetArgs) error {
+	simApp, ok := app.(*simapp.SimApp)
+	if !ok {
+		return errors.New("application is not a SimApp")
+	}
+
+	return simApp.InitForTestnet(args)
+}
+
 func (a appCreator) appExport(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailAllowedAddrs []string,
 	appOpts servertypes.AppOptions,
@@ -302,5 +316,9 @@ func (a appCreator) appExport(
 		simApp = simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
 	}
 
+	if genesisDir := cast.ToString(appOpts.Get(server.ExportGenesisDirKey)); genesisDir != "" {
+		return simApp.ExportAppStateAndValidatorsToDir(forZeroHeight, jailAllowedAddrs, genesisDir)
+	}
+
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
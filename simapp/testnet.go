@@ -0,0 +1,146 @@
+package simapp
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// testnetFundPower is the default amount, in consensus power, sent to the
+// accounts funded by an in-place testnet.
+const testnetFundPower = 1000
+
+// InitForTestnet rewrites the latest state of the application so that the
+// validator described by args is the only bonded validator. Every existing
+// validator is jailed and unbonded without emitting validator updates, since
+// Tendermint only knows about the new validator.
+//
+// The changes are written to the latest state without being committed; they
+// are committed with the next block.
+func (app *SimApp) InitForTestnet(args servertypes.InPlaceTestnetArgs) error {
+	ctx := app.NewUncachedContext(false, tmproto.Header{
+		Height:  app.LastBlockHeight(),
+		ChainID: args.ChainID,
+		Time:    tmtime.Now(),
+	})
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	powerReduction := app.StakingKeeper.PowerReduction(ctx)
+
+	// STAKING
+	bondedTokens := sdk.ZeroInt()
+	for _, validator := range app.StakingKeeper.GetAllValidators(ctx) {
+		app.StakingKeeper.DeleteLastValidatorPower(ctx, validator.GetOperator())
+
+		if !validator.IsJailed() {
+			consAddr, err := validator.GetConsAddr()
+			if err != nil {
+				return err
+			}
+
+			app.StakingKeeper.Jail(ctx, consAddr)
+			validator, _ = app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+		}
+
+		if validator.IsBonded() {
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+			app.StakingKeeper.SetValidator(ctx, validator.UpdateStatus(stakingtypes.Unbonded))
+		}
+	}
+
+	if bondedTokens.IsPositive() {
+		err := app.BankKeeper.SendCoinsFromModuleToModule(
+			ctx, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName,
+			sdk.NewCoins(sdk.NewCoin(bondDenom, bondedTokens)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The new validator is created unbonded and gets bonded by the staking
+	// end blocker of the next block, which reports its power to Tendermint.
+	selfDelegation := sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(args.ValidatorPower, powerReduction))
+	if err := app.mintTo(ctx, sdk.AccAddress(args.OperatorAddress), sdk.NewCoins(selfDelegation)); err != nil {
+		return err
+	}
+
+	pubKey, err := cryptocodec.FromTmPubKeyInterface(args.ValidatorPubKey)
+	if err != nil {
+		return err
+	}
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		args.OperatorAddress, pubKey, selfDelegation,
+		stakingtypes.NewDescription(args.ChainID, "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.OneDec()),
+		sdk.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return err
+	}
+
+	// SLASHING AND DISTRIBUTION
+	// The next block carries a commit signed by the new validator only, which
+	// must already be known to the begin blockers.
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	app.SlashingKeeper.SetValidatorSigningInfo(
+		ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0),
+	)
+	app.DistrKeeper.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// BANK
+	fundAmount := args.FundAmount
+	if fundAmount.Empty() {
+		fundAmount = sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.TokensFromConsensusPower(testnetFundPower, powerReduction)))
+	}
+
+	for _, addr := range args.AccountsToFund {
+		if err := app.mintTo(ctx, addr, fundAmount); err != nil {
+			return err
+		}
+	}
+
+	// GOV
+	if args.VotingPeriod > 0 {
+		votingParams := app.GovKeeper.GetVotingParams(ctx)
+		votingParams.VotingPeriod = args.VotingPeriod
+		app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+		for _, proposal := range app.GovKeeper.GetProposals(ctx) {
+			if proposal.Status != govtypes.StatusVotingPeriod {
+				continue
+			}
+
+			app.GovKeeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			proposal.VotingEndTime = ctx.BlockTime().Add(args.VotingPeriod)
+			app.GovKeeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			app.GovKeeper.SetProposal(ctx, proposal)
+		}
+	}
+
+	return nil
+}
+
+// mintTo mints amount and sends it to addr.
+func (app *SimApp) mintTo(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) error {
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amount)
+}
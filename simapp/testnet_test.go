@@ -0,0 +1,86 @@
+package simapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestInitForTestnet(t *testing.T) {
+	oldValPubKey := tmed25519.GenPrivKey().PubKey()
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(oldValPubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, balance)
+	app.EndBlock(abci.RequestEndBlock{Height: app.LastBlockHeight() + 1})
+	app.Commit()
+
+	newValPubKey := tmed25519.GenPrivKey().PubKey()
+	operator := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	funded := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fundAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 42))
+
+	require.NoError(t, app.InitForTestnet(servertypes.InPlaceTestnetArgs{
+		ChainID:         "testnet-1",
+		ValidatorPubKey: newValPubKey,
+		ValidatorPower:  100,
+		OperatorAddress: operator,
+		AccountsToFund:  []sdk.AccAddress{funded},
+		FundAmount:      fundAmount,
+		VotingPeriod:    time.Minute,
+	}))
+
+	// the next block is signed by the new validator only
+	height := app.LastBlockHeight() + 1
+	app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{Height: height, ChainID: "testnet-1", Time: time.Now()},
+		LastCommitInfo: abci.LastCommitInfo{Votes: []abci.VoteInfo{{
+			Validator:       abci.Validator{Address: newValPubKey.Address(), Power: 100},
+			SignedLastBlock: true,
+		}}},
+	})
+	res := app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+
+	// only the new validator is reported to Tendermint
+	require.Len(t, res.ValidatorUpdates, 1)
+	require.Equal(t, int64(100), res.ValidatorUpdates[0].Power)
+	require.Equal(t, newValPubKey.Bytes(), res.ValidatorUpdates[0].PubKey.GetEd25519())
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 2)
+	for _, validator := range validators {
+		if validator.GetOperator().Equals(operator) {
+			require.Equal(t, stakingtypes.Bonded, validator.GetStatus())
+			require.False(t, validator.IsJailed())
+		} else {
+			require.Equal(t, stakingtypes.Unbonded, validator.GetStatus())
+			require.True(t, validator.IsJailed())
+		}
+	}
+
+	require.Equal(t, fundAmount, app.BankKeeper.GetAllBalances(ctx, funded))
+	require.Equal(t, time.Minute, app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+}
@@ -921,6 +921,16 @@ func (rs *Store) RollbackToVersion(target int64) error {
 		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
 
+	// Check every store before overwriting any of them, so that a pruned
+	// target does not leave the multistore partially rolled back.
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			if !rs.GetCommitKVStore(key).(*iavl.Store).VersionExists(target) {
+				return fmt.Errorf("version %d of store %s does not exist or has been pruned", target, key.Name())
+			}
+		}
+	}
+
 	for key, store := range rs.stores {
 		if store.GetStoreType() == types.StoreTypeIAVL {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
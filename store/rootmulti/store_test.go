@@ -752,6 +752,29 @@ func TestTraceConcurrency(t *testing.T) {
 }
 
 //-----------------------------------------------------------------------
+func TestMultiStore_RollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	// version 5 has been pruned: nothing is rolled back
+	err := ms.RollbackToVersion(5)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "pruned")
+	require.Equal(t, int64(10), ms.LastCommitID().Version)
+
+	require.NoError(t, ms.RollbackToVersion(8))
+	require.Equal(t, int64(8), ms.LastCommitID().Version)
+
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, int64(8), ms.LastCommitID().Version)
+}
+
 // utils
 
 var (
@@ -52,6 +52,25 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// TracingEnabled enables OpenTelemetry tracing of blocks, transactions and
+	// queries.
+	TracingEnabled bool `mapstructure:"tracing-enabled"`
+
+	// TracingExporter defines where spans are exported to, one of "otlp",
+	// "stdout" or "file".
+	TracingExporter string `mapstructure:"tracing-exporter"`
+
+	// TracingEndpoint defines the URL of the OTLP/HTTP traces endpoint spans
+	// are sent to by the "otlp" exporter.
+	TracingEndpoint string `mapstructure:"tracing-endpoint"`
+
+	// TracingFile defines the file spans are appended to by the "file" exporter.
+	TracingFile string `mapstructure:"tracing-file"`
+
+	// TracingSampleRate defines the fraction of blocks and queries, between 0
+	// and 1, whose traces are sampled.
+	TracingSampleRate float64 `mapstructure:"tracing-sample-rate"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
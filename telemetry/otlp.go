@@ -0,0 +1,266 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// OTLPExporter is a span exporter sending spans to an OTLP/HTTP traces endpoint
+// encoded as JSON, e.g. to a local OpenTelemetry collector or Jaeger.
+type OTLPExporter struct {
+	endpoint string
+	client   *http.Client
+}
+
+var _ sdktrace.SpanExporter = (*OTLPExporter)(nil)
+
+// NewOTLPExporter returns an exporter posting spans to the given OTLP/HTTP
+// traces endpoint, e.g. http://localhost:4318/v1/traces. If client is nil, the
+// default HTTP client is used.
+func NewOTLPExporter(endpoint string, client *http.Client) *OTLPExporter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &OTLPExporter{endpoint: endpoint, client: client}
+}
+
+// ExportSpans implements sdktrace.SpanExporter.
+func (e *OTLPExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("failed to export spans to %s: %s: %s", e.endpoint, res.Status, msg)
+	}
+
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.
+func (e *OTLPExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// The types below follow the JSON encoding of the OTLP trace protobuf messages,
+// in which trace and span ids are hex strings and 64 bit integers are strings.
+
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpValue `json:"values"`
+}
+
+// otlpRequest groups the given spans by resource and instrumentation scope.
+func otlpRequest(spans []sdktrace.ReadOnlySpan) otlpExportRequest {
+	var (
+		req           otlpExportRequest
+		resourceIndex = map[attribute.Distinct]int{}
+		scopeIndex    = map[attribute.Distinct]map[otlpScope]int{}
+	)
+
+	for _, span := range spans {
+		res := span.Resource().Equivalent()
+		i, ok := resourceIndex[res]
+		if !ok {
+			i = len(req.ResourceSpans)
+			resourceIndex[res] = i
+			scopeIndex[res] = map[otlpScope]int{}
+			req.ResourceSpans = append(req.ResourceSpans, otlpResourceSpans{
+				Resource: otlpResource{Attributes: otlpAttributes(span.Resource().Attributes())},
+			})
+		}
+
+		scope := otlpScope{Name: span.InstrumentationScope().Name, Version: span.InstrumentationScope().Version}
+		j, ok := scopeIndex[res][scope]
+		if !ok {
+			j = len(req.ResourceSpans[i].ScopeSpans)
+			scopeIndex[res][scope] = j
+			req.ResourceSpans[i].ScopeSpans = append(req.ResourceSpans[i].ScopeSpans, otlpScopeSpans{Scope: scope})
+		}
+
+		req.ResourceSpans[i].ScopeSpans[j].Spans = append(req.ResourceSpans[i].ScopeSpans[j].Spans, otlpSpanOf(span))
+	}
+
+	return req
+}
+
+func otlpSpanOf(span sdktrace.ReadOnlySpan) otlpSpan {
+	s := otlpSpan{
+		TraceID:           span.SpanContext().TraceID().String(),
+		SpanID:            span.SpanContext().SpanID().String(),
+		Name:              span.Name(),
+		Kind:              int(span.SpanKind()),
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime().UnixNano(), 10),
+		Attributes:        otlpAttributes(span.Attributes()),
+		Status:            otlpStatusOf(span.Status()),
+	}
+
+	if span.Parent().HasSpanID() {
+		s.ParentSpanID = span.Parent().SpanID().String()
+	}
+
+	for _, event := range span.Events() {
+		s.Events = append(s.Events, otlpEvent{
+			TimeUnixNano: strconv.FormatInt(event.Time.UnixNano(), 10),
+			Name:         event.Name,
+			Attributes:   otlpAttributes(event.Attributes),
+		})
+	}
+
+	return s
+}
+
+// otlpStatusOf converts a span status, whose codes are ordered differently in
+// OTLP.
+func otlpStatusOf(status sdktrace.Status) otlpStatus {
+	switch status.Code {
+	case codes.Ok:
+		return otlpStatus{Code: 1}
+	case codes.Error:
+		return otlpStatus{Code: 2, Message: status.Description}
+	default:
+		return otlpStatus{}
+	}
+}
+
+func otlpAttributes(attrs []attribute.KeyValue) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	kvs := make([]otlpKeyValue, len(attrs))
+	for i, attr := range attrs {
+		kvs[i] = otlpKeyValue{Key: string(attr.Key), Value: otlpValueOf(attr.Value)}
+	}
+
+	return kvs
+}
+
+func otlpValueOf(v attribute.Value) otlpValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return otlpValue{BoolValue: &b}
+	case attribute.INT64:
+		i := strconv.FormatInt(v.AsInt64(), 10)
+		return otlpValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return otlpValue{DoubleValue: &f}
+	case attribute.BOOLSLICE:
+		var values []otlpValue
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, otlpValueOf(attribute.BoolValue(b)))
+		}
+		return otlpValue{ArrayValue: &otlpArrayValue{Values: values}}
+	case attribute.INT64SLICE:
+		var values []otlpValue
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, otlpValueOf(attribute.Int64Value(i)))
+		}
+		return otlpValue{ArrayValue: &otlpArrayValue{Values: values}}
+	case attribute.FLOAT64SLICE:
+		var values []otlpValue
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, otlpValueOf(attribute.Float64Value(f)))
+		}
+		return otlpValue{ArrayValue: &otlpArrayValue{Values: values}}
+	case attribute.STRINGSLICE:
+		var values []otlpValue
+		for _, s := range v.AsStringSlice() {
+			values = append(values, otlpValueOf(attribute.StringValue(s)))
+		}
+		return otlpValue{ArrayValue: &otlpArrayValue{Values: values}}
+	default:
+		s := v.Emit()
+		return otlpValue{StringValue: &s}
+	}
+}
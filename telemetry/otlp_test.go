@@ -0,0 +1,78 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestOTLPExporter(t *testing.T) {
+	var (
+		contentType string
+		req         otlpExportRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		req = otlpExportRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+	}))
+	defer server.Close()
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(NewOTLPExporter(server.URL, nil)))
+	tracer := tp.Tracer("test")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.SetAttributes(
+		attribute.Int64("height", 42),
+		attribute.Bool("cached", true),
+		attribute.StringSlice("denoms", []string{"atom", "stake"}),
+	)
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+
+	require.Equal(t, "application/json", contentType)
+	require.Len(t, req.ResourceSpans, 1)
+	require.Len(t, req.ResourceSpans[0].ScopeSpans, 1)
+	require.Equal(t, "test", req.ResourceSpans[0].ScopeSpans[0].Scope.Name)
+
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 1)
+	span := spans[0]
+	require.Equal(t, "child", span.Name)
+	require.Equal(t, parent.SpanContext().TraceID().String(), span.TraceID)
+	require.Equal(t, parent.SpanContext().SpanID().String(), span.ParentSpanID)
+	require.Equal(t, otlpStatus{Code: 2, Message: "failed"}, span.Status)
+
+	require.Len(t, span.Attributes, 3)
+	require.Equal(t, "42", *span.Attributes[0].Value.IntValue)
+	require.True(t, *span.Attributes[1].Value.BoolValue)
+	require.Len(t, span.Attributes[2].Value.ArrayValue.Values, 2)
+	require.Equal(t, "stake", *span.Attributes[2].Value.ArrayValue.Values[1].StringValue)
+
+	parent.End()
+	require.Equal(t, "parent", req.ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+	require.Empty(t, req.ResourceSpans[0].ScopeSpans[0].Spans[0].ParentSpanID)
+}
+
+func TestOTLPExporter_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(server.URL, nil)
+	tp := sdktrace.NewTracerProvider()
+	_, span := tp.Tracer("test").Start(context.Background(), "span")
+	span.End()
+
+	err := exporter.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{span.(sdktrace.ReadOnlySpan)})
+	require.ErrorContains(t, err, "503")
+}
@@ -0,0 +1,106 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Tracing exporter types.
+const (
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+	TracingExporterFile   = "file"
+)
+
+// defaultServiceName is the name traces are reported under when no service
+// name is configured.
+const defaultServiceName = "cosmos-sdk"
+
+// NewTracerProvider creates an OpenTelemetry tracer provider exporting spans as
+// configured and registers it globally, so that spans created by BaseApp and by
+// the gRPC query handlers are recorded. The returned function flushes pending
+// spans and releases the exporter. When tracing is disabled, the global no-op
+// provider is kept and a no-op function is returned.
+func NewTracerProvider(cfg Config) (func(context.Context) error, error) {
+	if !cfg.TracingEnabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if cfg.TracingSampleRate < 0 || cfg.TracingSampleRate > 1 {
+		return nil, fmt.Errorf("invalid tracing sample rate %v: must be between 0 and 1", cfg.TracingSampleRate)
+	}
+
+	exporter, closer, err := newSpanExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSampleRate))),
+		sdktrace.WithResource(resource.NewWithAttributes("", attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if closer != nil {
+			if cerr := closer.Close(); err == nil {
+				err = cerr
+			}
+		}
+
+		return err
+	}, nil
+}
+
+// newSpanExporter returns the span exporter of the configured type along with
+// the file it writes to, if any.
+func newSpanExporter(cfg Config) (sdktrace.SpanExporter, io.Closer, error) {
+	switch cfg.TracingExporter {
+	case TracingExporterOTLP, "":
+		if cfg.TracingEndpoint == "" {
+			return nil, nil, fmt.Errorf("no endpoint configured for the %s tracing exporter", TracingExporterOTLP)
+		}
+
+		return NewOTLPExporter(cfg.TracingEndpoint, nil), nil, nil
+
+	case TracingExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		return exporter, nil, err
+
+	case TracingExporterFile:
+		if cfg.TracingFile == "" {
+			return nil, nil, fmt.Errorf("no file configured for the %s tracing exporter", TracingExporterFile)
+		}
+
+		f, err := os.OpenFile(cfg.TracingFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+
+		return exporter, f, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter: %s", cfg.TracingExporter)
+	}
+}
@@ -0,0 +1,61 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestNewTracerProvider_Disabled(t *testing.T) {
+	shutdown, err := NewTracerProvider(Config{TracingEnabled: false})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestNewTracerProvider_Invalid(t *testing.T) {
+	_, err := NewTracerProvider(Config{TracingEnabled: true, TracingSampleRate: 2})
+	require.Error(t, err)
+
+	_, err = NewTracerProvider(Config{TracingEnabled: true, TracingSampleRate: 1, TracingExporter: "jaeger"})
+	require.Error(t, err)
+
+	_, err = NewTracerProvider(Config{TracingEnabled: true, TracingSampleRate: 1, TracingExporter: TracingExporterFile})
+	require.Error(t, err)
+}
+
+func TestNewTracerProvider_File(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "traces.json")
+
+	shutdown, err := NewTracerProvider(Config{
+		ServiceName:       "test",
+		TracingEnabled:    true,
+		TracingExporter:   TracingExporterFile,
+		TracingFile:       file,
+		TracingSampleRate: 1,
+	})
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "span")
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	var exported struct {
+		Name     string
+		Resource []struct {
+			Key   string
+			Value struct{ Value string }
+		}
+	}
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Equal(t, "span", exported.Name)
+	require.Equal(t, "service.name", exported.Resource[0].Key)
+	require.Equal(t, "test", exported.Resource[0].Value.Value)
+}
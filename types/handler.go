@@ -1,5 +1,16 @@
 package types
 
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// anteTracer creates the spans of the ante decorators run in traced transactions.
+var anteTracer = otel.Tracer("github.com/cosmos/cosmos-sdk/types")
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -37,10 +48,36 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 
 	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
-		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		next := ChainAnteDecorators(chain[1:]...)
+		if (chain[0] == Terminator{}) || !trace.SpanFromContext(ctx.Context()).IsRecording() {
+			return chain[0].AnteHandle(ctx, tx, simulate, next)
+		}
+
+		return traceAnteDecorator(chain[0], ctx, tx, simulate, next)
 	}
 }
 
+// traceAnteDecorator runs the decorator within a span named after its type,
+// which includes the decorators further along the chain.
+func traceAnteDecorator(decorator AnteDecorator, ctx Context, tx Tx, simulate bool, next AnteHandler) (Context, error) {
+	parent := ctx.Context()
+	spanCtx, span := anteTracer.Start(parent, fmt.Sprintf("%T", decorator))
+	defer span.End()
+
+	newCtx, err := decorator.AnteHandle(ctx.WithContext(spanCtx), tx, simulate, next)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	// the span does not outlive the decorator
+	if !newCtx.IsZero() && newCtx.Context() == spanCtx {
+		newCtx = newCtx.WithContext(parent)
+	}
+
+	return newCtx, err
+}
+
 // Terminator AnteDecorator will get added to the chain to simplify decorator code
 // Don't need to check if next == nil further up the chain
 //
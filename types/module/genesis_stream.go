@@ -0,0 +1,277 @@
+package module
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisDirAppStateKey is the app state key holding the path of the
+// directory the genesis state was split into. When present, the rest of the
+// app state is ignored and each module genesis state is read from its own file
+// in that directory (see Manager.InitGenesisFromDir).
+const GenesisDirAppStateKey = "genesis_dir"
+
+// AppModuleGenesisStream is an extension interface for modules whose genesis
+// state holds collections too large to be kept in memory at once. When the
+// genesis state is split into a directory, these collections are streamed one
+// item per line instead of being part of the module genesis JSON.
+type AppModuleGenesisStream interface {
+	AppModuleGenesis
+
+	// ExportGenesisStream writes the streamed collections of the module to
+	// stream and returns the remainder of the module genesis state.
+	ExportGenesisStream(sdk.Context, codec.JSONCodec, *GenesisStream) (json.RawMessage, error)
+
+	// InitGenesisStream initializes the module from the genesis state returned by
+	// ExportGenesisStream and the collections read from stream.
+	InitGenesisStream(sdk.Context, codec.JSONCodec, json.RawMessage, *GenesisStream) ([]abci.ValidatorUpdate, error)
+
+	// ValidateGenesisStream validates the genesis state returned by
+	// ExportGenesisStream along with the collections read from stream.
+	ValidateGenesisStream(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage, *GenesisStream) error
+}
+
+// GenesisStream reads and writes the streamed collections of a module genesis
+// state. Each collection is stored in the <module>.<collection>.jsonl file of
+// the genesis directory, holding one JSON encoded item per line.
+type GenesisStream struct {
+	cdc       codec.JSONCodec
+	dir       string
+	module    string
+	writers   map[string]*genesisStreamWriter
+	checksums map[string]string
+}
+
+type genesisStreamWriter struct {
+	file *os.File
+	buf  *bufio.Writer
+	hash hash.Hash
+}
+
+// NewGenesisStream returns a GenesisStream for the collections of module in
+// dir. The stream must be closed once all the collections are written.
+func NewGenesisStream(cdc codec.JSONCodec, dir, module string) *GenesisStream {
+	return &GenesisStream{
+		cdc:       cdc,
+		dir:       dir,
+		module:    module,
+		writers:   make(map[string]*genesisStreamWriter),
+		checksums: make(map[string]string),
+	}
+}
+
+// CollectionPath returns the path of the file holding the given collection.
+func (s *GenesisStream) CollectionPath(collection string) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%s.%s.jsonl", s.module, collection))
+}
+
+// Write appends item to the given collection.
+func (s *GenesisStream) Write(collection string, item proto.Message) error {
+	w, ok := s.writers[collection]
+	if !ok {
+		f, err := os.Create(s.CollectionPath(collection))
+		if err != nil {
+			return err
+		}
+
+		h := sha256.New()
+		w = &genesisStreamWriter{file: f, buf: bufio.NewWriter(io.MultiWriter(f, h)), hash: h}
+		s.writers[collection] = w
+	}
+
+	bz, err := s.cdc.MarshalJSON(item)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.buf.Write(bz); err != nil {
+		return err
+	}
+
+	return w.buf.WriteByte('\n')
+}
+
+// Read decodes the items of the given collection into item one at a time,
+// calling cb after each of them. A missing collection is treated as empty.
+func (s *GenesisStream) Read(collection string, item proto.Message, cb func() error) error {
+	return s.ReadJSON(collection, func(bz json.RawMessage) error {
+		item.Reset()
+		if err := s.cdc.UnmarshalJSON(bz, item); err != nil {
+			return err
+		}
+
+		return cb()
+	})
+}
+
+// ReadJSON calls cb with the JSON encoding of each item of the given
+// collection, for items which are decoded as interfaces. A missing collection
+// is treated as empty.
+func (s *GenesisStream) ReadJSON(collection string, cb func(json.RawMessage) error) error {
+	f, err := os.Open(s.CollectionPath(collection))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		bz, err := r.ReadBytes('\n')
+		if len(bytes.TrimSpace(bz)) > 0 {
+			if err := cb(bz); err != nil {
+				return fmt.Errorf("%s line %d: %w", s.CollectionPath(collection), line, err)
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Close flushes and closes the collection files opened for writing.
+func (s *GenesisStream) Close() error {
+	var firstErr error
+	for _, w := range s.writers {
+		if err := w.buf.Flush(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if err := w.file.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+
+		s.checksums[filepath.Base(w.file.Name())] = hex.EncodeToString(w.hash.Sum(nil))
+	}
+
+	s.writers = make(map[string]*genesisStreamWriter)
+	return firstErr
+}
+
+// Checksums returns the SHA-256 checksums of the collection files written and
+// closed, by file name.
+func (s *GenesisStream) Checksums() map[string]string {
+	return s.checksums
+}
+
+// GenesisDir is a genesis state split into a directory, as referenced by the
+// app state of the genesis file. The app state holds the checksum of each file
+// of the directory, so that the genesis file hash commits to the whole genesis
+// state.
+type GenesisDir struct {
+	// Path is the path of the directory. It may be relative to the directory of
+	// the genesis file.
+	Path string `json:"genesis_dir"`
+	// Files are the hex encoded SHA-256 checksums of the files of the
+	// directory, by file name.
+	Files map[string]string `json:"genesis_files"`
+}
+
+// AppState returns the app state pointing to the genesis directory, to be used
+// as the app state of a split genesis file.
+func (d GenesisDir) AppState() json.RawMessage {
+	bz, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Verify checks that the files of the genesis directory are the ones whose
+// checksums are recorded, and that the directory holds no other file.
+func (d GenesisDir) Verify() error {
+	entries, err := os.ReadDir(d.Path)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if _, ok := d.Files[entry.Name()]; !ok {
+			return fmt.Errorf("%s is not part of the genesis state", filepath.Join(d.Path, entry.Name()))
+		}
+	}
+
+	names := make([]string, 0, len(d.Files))
+	for name := range d.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if filepath.Base(name) != name {
+			return fmt.Errorf("invalid genesis file name %s", name)
+		}
+
+		checksum, err := fileChecksum(filepath.Join(d.Path, name))
+		if err != nil {
+			return err
+		}
+		if checksum != d.Files[name] {
+			return fmt.Errorf("checksum mismatch for genesis file %s: expected %s, got %s", filepath.Join(d.Path, name), d.Files[name], checksum)
+		}
+	}
+
+	return nil
+}
+
+// GenesisDirFromAppState returns the genesis directory the given app state
+// points to, if any.
+func GenesisDirFromAppState(appState map[string]json.RawMessage) (GenesisDir, bool, error) {
+	if _, ok := appState[GenesisDirAppStateKey]; !ok {
+		return GenesisDir{}, false, nil
+	}
+
+	bz, err := json.Marshal(appState)
+	if err != nil {
+		return GenesisDir{}, false, err
+	}
+
+	var dir GenesisDir
+	if err := json.Unmarshal(bz, &dir); err != nil {
+		return GenesisDir{}, false, fmt.Errorf("invalid %s: %w", GenesisDirAppStateKey, err)
+	}
+
+	return dir, true, nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func moduleGenesisPath(dir, moduleName string) string {
+	return filepath.Join(dir, moduleName+".json")
+}
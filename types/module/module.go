@@ -29,8 +29,13 @@ needlessly defining many placeholder functions
 package module
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 
 	"github.com/gorilla/mux"
@@ -341,6 +346,63 @@ func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData
 	}
 }
 
+// InitGenesisFromDir performs init genesis functionality for modules from a
+// genesis state split into a directory by ExportGenesisToDir. The checksums of
+// the files are verified, and the genesis state of every module is validated
+// before any module is initialized.
+func (m *Manager) InitGenesisFromDir(ctx sdk.Context, cdc codec.JSONCodec, txEncCfg client.TxEncodingConfig, genesisDir GenesisDir) (abci.ResponseInitChain, error) {
+	if err := genesisDir.Verify(); err != nil {
+		return abci.ResponseInitChain{}, err
+	}
+
+	for _, moduleName := range m.OrderInitGenesis {
+		bz, err := os.ReadFile(moduleGenesisPath(genesisDir.Path, moduleName))
+		if err != nil {
+			return abci.ResponseInitChain{}, fmt.Errorf("failed to read %s genesis: %w", moduleName, err)
+		}
+
+		if sm, ok := m.Modules[moduleName].(AppModuleGenesisStream); ok {
+			err = sm.ValidateGenesisStream(cdc, txEncCfg, bz, NewGenesisStream(cdc, genesisDir.Path, moduleName))
+		} else {
+			err = m.Modules[moduleName].ValidateGenesis(cdc, txEncCfg, bz)
+		}
+		if err != nil {
+			return abci.ResponseInitChain{}, fmt.Errorf("invalid %s genesis: %w", moduleName, err)
+		}
+	}
+
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, moduleName := range m.OrderInitGenesis {
+		bz, err := os.ReadFile(moduleGenesisPath(genesisDir.Path, moduleName))
+		if err != nil {
+			return abci.ResponseInitChain{}, fmt.Errorf("failed to read %s genesis: %w", moduleName, err)
+		}
+
+		var moduleValUpdates []abci.ValidatorUpdate
+		if sm, ok := m.Modules[moduleName].(AppModuleGenesisStream); ok {
+			moduleValUpdates, err = sm.InitGenesisStream(ctx, cdc, bz, NewGenesisStream(cdc, genesisDir.Path, moduleName))
+			if err != nil {
+				return abci.ResponseInitChain{}, fmt.Errorf("failed to init %s genesis: %w", moduleName, err)
+			}
+		} else {
+			moduleValUpdates = m.Modules[moduleName].InitGenesis(ctx, cdc, bz)
+		}
+
+		// use these validator updates if provided, the module manager assumes
+		// only one module will update the validator set
+		if len(moduleValUpdates) > 0 {
+			if len(validatorUpdates) > 0 {
+				return abci.ResponseInitChain{}, errors.New("validator InitGenesis updates already set by a previous module")
+			}
+			validatorUpdates = moduleValUpdates
+		}
+	}
+
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}, nil
+}
+
 // ExportGenesis performs export genesis functionality for modules
 func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) map[string]json.RawMessage {
 	genesisData := make(map[string]json.RawMessage)
@@ -351,6 +413,59 @@ func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) map[string
 	return genesisData
 }
 
+// ExportGenesisToDir performs export genesis functionality for modules, writing
+// the genesis state of each module to its own <module>.json file in dir, so
+// that only one module genesis state is held in memory at a time. Modules
+// implementing AppModuleGenesisStream additionally stream their large
+// collections to JSON lines files. dir must be empty or not exist yet. The
+// returned GenesisDir holds the checksums of the files written.
+func (m *Manager) ExportGenesisToDir(ctx sdk.Context, cdc codec.JSONCodec, dir string) (GenesisDir, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return GenesisDir{}, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return GenesisDir{}, err
+	}
+	if len(entries) > 0 {
+		return GenesisDir{}, fmt.Errorf("genesis directory %s is not empty", dir)
+	}
+
+	genesisDir := GenesisDir{Path: dir, Files: make(map[string]string)}
+	for _, moduleName := range m.OrderExportGenesis {
+		var bz json.RawMessage
+		if sm, ok := m.Modules[moduleName].(AppModuleGenesisStream); ok {
+			stream := NewGenesisStream(cdc, dir, moduleName)
+
+			var err error
+			bz, err = sm.ExportGenesisStream(ctx, cdc, stream)
+			if closeErr := stream.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return GenesisDir{}, fmt.Errorf("failed to export %s genesis: %w", moduleName, err)
+			}
+
+			for name, checksum := range stream.Checksums() {
+				genesisDir.Files[name] = checksum
+			}
+		} else {
+			bz = m.Modules[moduleName].ExportGenesis(ctx, cdc)
+		}
+
+		path := moduleGenesisPath(dir, moduleName)
+		if err := os.WriteFile(path, bz, 0o644); err != nil {
+			return GenesisDir{}, err
+		}
+
+		checksum := sha256.Sum256(bz)
+		genesisDir.Files[filepath.Base(path)] = hex.EncodeToString(checksum[:])
+	}
+
+	return genesisDir, nil
+}
+
 // assertNoForgottenModules checks that we didn't forget any modules in the
 // SetOrder* functions.
 func (m *Manager) assertNoForgottenModules(setOrderFnName string, moduleNames []string) {
@@ -3,6 +3,8 @@ package module_test
 import (
 	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -241,6 +243,64 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Equal(t, want, mm.ExportGenesis(ctx, cdc))
 }
 
+func TestManager_ExportAndInitGenesisDir(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	ctx := sdk.Context{}
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	genesis1, genesis2 := json.RawMessage(`{"key1": "value1"}`), json.RawMessage(`{"key2": "value2"}`)
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(genesis1)
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(genesis2)
+
+	dir := t.TempDir()
+	genesisDir, err := mm.ExportGenesisToDir(ctx, cdc, dir)
+	require.NoError(t, err)
+	require.Equal(t, dir, genesisDir.Path)
+	require.Len(t, genesisDir.Files, 2)
+
+	_, err = mm.ExportGenesisToDir(ctx, cdc, dir)
+	require.Error(t, err, "the directory is not empty")
+
+	// no module is initialized if the genesis state of one of them is invalid
+	mockAppModule1.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Nil(), gomock.Eq(genesis1)).Times(1).Return(nil)
+	mockAppModule2.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Nil(), gomock.Eq(genesis2)).Times(1).Return(errFoo)
+	_, err = mm.InitGenesisFromDir(ctx, cdc, nil, genesisDir)
+	require.ErrorIs(t, err, errFoo)
+
+	mockAppModule1.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Nil(), gomock.Eq(genesis1)).Times(1).Return(nil)
+	mockAppModule2.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Nil(), gomock.Eq(genesis2)).Times(1).Return(nil)
+	mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis1)).Times(1).Return(nil)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis2)).Times(1).Return(nil)
+	_, err = mm.InitGenesisFromDir(ctx, cdc, nil, genesisDir)
+	require.NoError(t, err)
+
+	// the files must match their checksums, and no other file is allowed
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "module1.json"), []byte(`{"key1": "other"}`), 0o644))
+	_, err = mm.InitGenesisFromDir(ctx, cdc, nil, genesisDir)
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "module1.json"), genesis1, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "module3.json"), genesis1, 0o644))
+	_, err = mm.InitGenesisFromDir(ctx, cdc, nil, genesisDir)
+	require.ErrorContains(t, err, "not part of the genesis state")
+
+	// the genesis state of every module is required
+	require.NoError(t, os.Remove(filepath.Join(dir, "module3.json")))
+	require.NoError(t, os.Remove(filepath.Join(dir, "module2.json")))
+	delete(genesisDir.Files, "module2.json")
+	mockAppModule1.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Nil(), gomock.Eq(genesis1)).Times(1).Return(nil)
+	_, err = mm.InitGenesisFromDir(ctx, cdc, nil, genesisDir)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -0,0 +1,1023 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tx/v1beta1/fee_estimator.proto
+
+package tx
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EstimateFeeRequest is the request type for the FeeEstimator.EstimateFee
+// RPC method.
+type EstimateFeeRequest struct {
+	// blocks is the number of recent blocks to sample, 20 if zero. At most 100
+	// blocks are sampled.
+	Blocks uint32 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()    {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445499569e5a68e4, []int{0}
+}
+func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeRequest.Merge(m, src)
+}
+func (m *EstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateFeeRequest) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// EstimateFeeResponse is the response type for the FeeEstimator.EstimateFee
+// RPC method.
+type EstimateFeeResponse struct {
+	// height is the height of the most recent block sampled.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// blocks is the number of blocks sampled.
+	Blocks uint32 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// estimates are the gas prices suggested for each denom fees were paid in,
+	// sorted by decreasing number of samples.
+	Estimates []GasPriceEstimate `protobuf:"bytes,3,rep,name=estimates,proto3" json:"estimates"`
+}
+
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445499569e5a68e4, []int{1}
+}
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(m, src)
+}
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
+
+func (m *EstimateFeeResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetEstimates() []GasPriceEstimate {
+	if m != nil {
+		return m.Estimates
+	}
+	return nil
+}
+
+// GasPriceEstimate holds the gas prices suggested in a denom.
+type GasPriceEstimate struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// low is the 25th percentile of the fee per gas paid in the denom.
+	Low github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low"`
+	// medium is the median of the fee per gas paid in the denom.
+	Medium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=medium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"medium"`
+	// high is the 90th percentile of the fee per gas paid in the denom.
+	High github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high"`
+	// samples is the number of transactions sampled which paid fees in the denom.
+	Samples uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *GasPriceEstimate) Reset()         { *m = GasPriceEstimate{} }
+func (m *GasPriceEstimate) String() string { return proto.CompactTextString(m) }
+func (*GasPriceEstimate) ProtoMessage()    {}
+func (*GasPriceEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_445499569e5a68e4, []int{2}
+}
+func (m *GasPriceEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceEstimate.Merge(m, src)
+}
+func (m *GasPriceEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceEstimate proto.InternalMessageInfo
+
+func (m *GasPriceEstimate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GasPriceEstimate) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EstimateFeeRequest)(nil), "cosmos.tx.v1beta1.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "cosmos.tx.v1beta1.EstimateFeeResponse")
+	proto.RegisterType((*GasPriceEstimate)(nil), "cosmos.tx.v1beta1.GasPriceEstimate")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tx/v1beta1/fee_estimator.proto", fileDescriptor_445499569e5a68e4)
+}
+
+var fileDescriptor_445499569e5a68e4 = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x33, 0x9b, 0x74, 0x65, 0xa7, 0x0a, 0x3a, 0x16, 0x09, 0x8b, 0x64, 0xd7, 0xc8, 0xd6,
+	0x3d, 0xe8, 0x0c, 0xad, 0x1f, 0x40, 0x09, 0xba, 0xbd, 0x4a, 0x8e, 0x5e, 0x4a, 0x92, 0x7d, 0x9b,
+	0x0c, 0x4d, 0xf2, 0xc6, 0xcc, 0xac, 0xae, 0x57, 0xc1, 0xab, 0x14, 0x04, 0x3f, 0x53, 0x8f, 0x05,
+	0x2f, 0xe2, 0xa1, 0xc8, 0xae, 0x1f, 0x44, 0xf2, 0x0f, 0x57, 0xb7, 0x50, 0xe8, 0x29, 0xf3, 0x84,
+	0xdf, 0xf3, 0xcc, 0xbc, 0x7f, 0xe8, 0x24, 0x42, 0x95, 0xa1, 0x12, 0x7a, 0x29, 0xde, 0x1f, 0x84,
+	0xa0, 0x83, 0x03, 0x71, 0x02, 0x70, 0x0c, 0x4a, 0xcb, 0x2c, 0xd0, 0x58, 0xf2, 0xa2, 0x44, 0x8d,
+	0xec, 0x5e, 0x83, 0x71, 0xbd, 0xe4, 0x2d, 0x36, 0x7c, 0x18, 0x23, 0xc6, 0x29, 0x88, 0xa0, 0x90,
+	0x22, 0xc8, 0x73, 0xd4, 0x81, 0x96, 0x98, 0xab, 0xc6, 0x30, 0xdc, 0x8b, 0x31, 0xc6, 0xfa, 0x28,
+	0xaa, 0x53, 0xf3, 0xd7, 0x7d, 0x4a, 0xd9, 0xeb, 0x26, 0x19, 0x66, 0x00, 0x3e, 0xbc, 0x5b, 0x80,
+	0xd2, 0xec, 0x01, 0xed, 0x87, 0x29, 0x46, 0xa7, 0xca, 0x26, 0x63, 0x32, 0xbd, 0xe3, 0xb7, 0xca,
+	0xfd, 0x42, 0xe8, 0xfd, 0x7f, 0x70, 0x55, 0x60, 0xae, 0xa0, 0xe2, 0x13, 0x90, 0x71, 0xa2, 0x6b,
+	0xde, 0xf4, 0x5b, 0xb5, 0x91, 0xd3, 0xdb, 0xcc, 0x61, 0x47, 0x74, 0xd0, 0xd6, 0x03, 0xca, 0x36,
+	0xc7, 0xe6, 0x74, 0xf7, 0xf0, 0x31, 0xdf, 0x2a, 0x88, 0x1f, 0x05, 0xea, 0x4d, 0x29, 0x23, 0xe8,
+	0xae, 0xf4, 0xac, 0xf3, 0xcb, 0x91, 0xe1, 0xff, 0xf5, 0xba, 0x67, 0x3d, 0x7a, 0xf7, 0x7f, 0x8a,
+	0xed, 0xd1, 0x9d, 0x39, 0xe4, 0x98, 0xd5, 0x8f, 0x19, 0xf8, 0x8d, 0x60, 0x2f, 0xa9, 0x99, 0xe2,
+	0x87, 0xfa, 0x21, 0x03, 0x8f, 0x57, 0x41, 0x3f, 0x2f, 0x47, 0xfb, 0xb1, 0xd4, 0xc9, 0x22, 0xe4,
+	0x11, 0x66, 0xa2, 0xed, 0x7b, 0xf3, 0x79, 0xa6, 0xe6, 0xa7, 0x42, 0x7f, 0x2c, 0x40, 0xf1, 0x57,
+	0x10, 0xf9, 0x95, 0x95, 0xcd, 0x68, 0x3f, 0x83, 0xb9, 0x5c, 0x64, 0xb6, 0x79, 0xa3, 0x90, 0xd6,
+	0xcd, 0x3c, 0x6a, 0x25, 0x32, 0x4e, 0x6c, 0xeb, 0x46, 0x29, 0xb5, 0x97, 0xd9, 0xf4, 0x96, 0x0a,
+	0xb2, 0x22, 0x05, 0x65, 0xef, 0x8c, 0xc9, 0xd4, 0xf2, 0x3b, 0x79, 0xf8, 0x8d, 0xd0, 0xdb, 0x33,
+	0xe8, 0xba, 0x81, 0x25, 0xfb, 0x4c, 0xe8, 0xee, 0xc6, 0xd0, 0xd8, 0xe4, 0x8a, 0x4e, 0x6f, 0xef,
+	0xc0, 0x70, 0xff, 0x3a, 0xac, 0x99, 0xbd, 0xfb, 0xe4, 0xd3, 0xf7, 0xdf, 0x5f, 0x7b, 0x8f, 0xd8,
+	0x48, 0x6c, 0x2f, 0x6e, 0x37, 0xa8, 0xe3, 0x13, 0x00, 0xef, 0xc5, 0xf9, 0xca, 0x21, 0x17, 0x2b,
+	0x87, 0xfc, 0x5a, 0x39, 0xe4, 0x6c, 0xed, 0x18, 0x17, 0x6b, 0xc7, 0xf8, 0xb1, 0x76, 0x8c, 0xb7,
+	0x93, 0xeb, 0x4b, 0x17, 0x7a, 0x19, 0xf6, 0xeb, 0x95, 0x7d, 0xfe, 0x67, 0x00, 0x6c, 0xa7, 0x96,
+	0xc8, 0x22, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FeeEstimatorClient is the client API for FeeEstimator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FeeEstimatorClient interface {
+	// EstimateFee returns low, medium and high gas prices for each denom fees
+	// were paid in, sampled from the fee per gas of the transactions included in
+	// recent blocks.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+}
+
+type feeEstimatorClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewFeeEstimatorClient(cc grpc1.ClientConn) FeeEstimatorClient {
+	return &feeEstimatorClient{cc}
+}
+
+func (c *feeEstimatorClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.FeeEstimator/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeeEstimatorServer is the server API for FeeEstimator service.
+type FeeEstimatorServer interface {
+	// EstimateFee returns low, medium and high gas prices for each denom fees
+	// were paid in, sampled from the fee per gas of the transactions included in
+	// recent blocks.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+}
+
+// UnimplementedFeeEstimatorServer can be embedded to have forward compatible implementations.
+type UnimplementedFeeEstimatorServer struct {
+}
+
+func (*UnimplementedFeeEstimatorServer) EstimateFee(ctx context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+
+func RegisterFeeEstimatorServer(s grpc1.Server, srv FeeEstimatorServer) {
+	s.RegisterService(&_FeeEstimator_serviceDesc, srv)
+}
+
+func _FeeEstimator_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeEstimatorServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.FeeEstimator/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeEstimatorServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FeeEstimator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.tx.v1beta1.FeeEstimator",
+	HandlerType: (*FeeEstimatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EstimateFee",
+			Handler:    _FeeEstimator_EstimateFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/tx/v1beta1/fee_estimator.proto",
+}
+
+func (m *EstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Estimates) > 0 {
+		for iNdEx := len(m.Estimates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Estimates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Medium.Size()
+		i -= size
+		if _, err := m.Medium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeEstimator(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeEstimator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeEstimator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovFeeEstimator(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *EstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeeEstimator(uint64(m.Height))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovFeeEstimator(uint64(m.Blocks))
+	}
+	if len(m.Estimates) > 0 {
+		for _, e := range m.Estimates {
+			l = e.Size()
+			n += 1 + l + sovFeeEstimator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPriceEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeEstimator(uint64(l))
+	}
+	l = m.Low.Size()
+	n += 1 + l + sovFeeEstimator(uint64(l))
+	l = m.Medium.Size()
+	n += 1 + l + sovFeeEstimator(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovFeeEstimator(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovFeeEstimator(uint64(m.Samples))
+	}
+	return n
+}
+
+func sovFeeEstimator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeEstimator(x uint64) (n int) {
+	return sovFeeEstimator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Estimates = append(m.Estimates, GasPriceEstimate{})
+			if err := m.Estimates[len(m.Estimates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Medium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeEstimator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeEstimator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeEstimator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeEstimator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeEstimator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeEstimator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeEstimator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeEstimator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeEstimator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,15 +11,18 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -27,6 +30,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -81,6 +85,12 @@ const (
 	// BROADCAST_MODE_ASYNC defines a tx broadcasting mode where the client returns
 	// immediately.
 	BroadcastMode_BROADCAST_MODE_ASYNC BroadcastMode = 3
+	// BROADCAST_MODE_WAIT defines a tx broadcasting mode where the client waits for
+	// a CheckTx execution response, then for the tx to be committed in a block,
+	// and returns the response of its execution.
+	//
+	// Since: cosmos-sdk 0.45.11
+	BroadcastMode_BROADCAST_MODE_WAIT BroadcastMode = 4
 )
 
 var BroadcastMode_name = map[int32]string{
@@ -88,6 +98,7 @@ var BroadcastMode_name = map[int32]string{
 	1: "BROADCAST_MODE_BLOCK",
 	2: "BROADCAST_MODE_SYNC",
 	3: "BROADCAST_MODE_ASYNC",
+	4: "BROADCAST_MODE_WAIT",
 }
 
 var BroadcastMode_value = map[string]int32{
@@ -95,6 +106,7 @@ var BroadcastMode_value = map[string]int32{
 	"BROADCAST_MODE_BLOCK":       1,
 	"BROADCAST_MODE_SYNC":        2,
 	"BROADCAST_MODE_ASYNC":       3,
+	"BROADCAST_MODE_WAIT":        4,
 }
 
 func (x BroadcastMode) String() string {
@@ -240,6 +252,11 @@ type BroadcastTxRequest struct {
 	// tx_bytes is the raw transaction.
 	TxBytes []byte        `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
 	Mode    BroadcastMode `protobuf:"varint,2,opt,name=mode,proto3,enum=cosmos.tx.v1beta1.BroadcastMode" json:"mode,omitempty"`
+	// timeout is the time to wait for the tx to be committed in
+	// BROADCAST_MODE_WAIT, the node's default if unset.
+	//
+	// Since: cosmos-sdk 0.45.11
+	Timeout *time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
 }
 
 func (m *BroadcastTxRequest) Reset()         { *m = BroadcastTxRequest{} }
@@ -289,6 +306,13 @@ func (m *BroadcastTxRequest) GetMode() BroadcastMode {
 	return BroadcastMode_BROADCAST_MODE_UNSPECIFIED
 }
 
+func (m *BroadcastTxRequest) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
 // BroadcastTxResponse is the response type for the
 // Service.BroadcastTx method.
 type BroadcastTxResponse struct {
@@ -718,68 +742,72 @@ func init() {
 }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1a, 0x47,
-	0x14, 0xf7, 0x2e, 0xb6, 0x21, 0x0f, 0x3b, 0x21, 0x63, 0xd7, 0x26, 0x24, 0xc5, 0x64, 0x53, 0x6c,
-	0x07, 0xc9, 0xbb, 0x0a, 0x4d, 0xa5, 0xaa, 0xea, 0xc5, 0xfc, 0x89, 0x8b, 0xda, 0x84, 0x68, 0x70,
-	0x15, 0xa5, 0xaa, 0x84, 0x16, 0x98, 0x2c, 0xab, 0x98, 0x1d, 0xbc, 0x33, 0x58, 0x8b, 0x1c, 0xab,
-	0x52, 0x8f, 0x3d, 0x55, 0xed, 0xa1, 0x1f, 0xa2, 0x5f, 0xa2, 0xc7, 0x1e, 0x2d, 0xf5, 0xd2, 0x63,
-	0x65, 0xf7, 0x03, 0xf4, 0x23, 0x54, 0x3b, 0x3b, 0xc0, 0x82, 0x97, 0x38, 0x8d, 0x7a, 0x81, 0x99,
-	0x9d, 0xdf, 0x7b, 0xef, 0x37, 0xbf, 0xd9, 0xdf, 0x9b, 0x85, 0xad, 0x36, 0x65, 0x3d, 0xca, 0x0c,
-	0xee, 0x19, 0x27, 0x8f, 0x5a, 0x84, 0x9b, 0x8f, 0x0c, 0x46, 0xdc, 0x13, 0xbb, 0x4d, 0xf4, 0xbe,
-	0x4b, 0x39, 0x45, 0xb7, 0x03, 0x80, 0xce, 0x3d, 0x5d, 0x02, 0x32, 0xf7, 0x2c, 0x4a, 0xad, 0x23,
-	0x62, 0x98, 0x7d, 0xdb, 0x30, 0x1d, 0x87, 0x72, 0x93, 0xdb, 0xd4, 0x61, 0x41, 0x40, 0xe6, 0x81,
-	0xcc, 0xd8, 0x32, 0x19, 0x31, 0xcc, 0x56, 0xdb, 0x1e, 0x27, 0xf6, 0x27, 0x12, 0x94, 0xb9, 0x5a,
-	0x96, 0x7b, 0x72, 0x6d, 0xdd, 0xa2, 0x16, 0x15, 0x43, 0xc3, 0x1f, 0xc9, 0xa7, 0x85, 0x70, 0xda,
-	0xe3, 0x01, 0x71, 0x87, 0xe3, 0xc8, 0xbe, 0x69, 0xd9, 0x8e, 0xe0, 0x20, 0xb1, 0xf7, 0x38, 0x71,
-	0x3a, 0xc4, 0xed, 0xd9, 0x0e, 0x37, 0xf8, 0xb0, 0x4f, 0x98, 0xd1, 0x3a, 0xa2, 0xed, 0xd7, 0x73,
-	0x57, 0xc5, 0x6f, 0xb0, 0xaa, 0xfd, 0xaa, 0x00, 0x3a, 0x20, 0xfc, 0xd0, 0x63, 0xd5, 0x13, 0xe2,
-	0x70, 0x4c, 0x8e, 0x07, 0x84, 0x71, 0xb4, 0x01, 0xcb, 0xc4, 0x9f, 0xb3, 0xb4, 0x92, 0x8b, 0xed,
-	0xde, 0xc0, 0x72, 0x86, 0x9e, 0x00, 0x4c, 0xca, 0xa7, 0xd5, 0x9c, 0xb2, 0x9b, 0x2c, 0x6e, 0xeb,
-	0x52, 0x33, 0x9f, 0xab, 0x2e, 0xb8, 0x8e, 0xb4, 0xd3, 0x9f, 0x9b, 0x16, 0x91, 0x39, 0x71, 0x28,
-	0x12, 0x7d, 0x02, 0x09, 0xea, 0x76, 0x88, 0xdb, 0x6c, 0x0d, 0xd3, 0xb1, 0x9c, 0xb2, 0x7b, 0xb3,
-	0x98, 0xd1, 0xaf, 0x28, 0xaf, 0xd7, 0x7d, 0x48, 0x69, 0x88, 0xe3, 0x34, 0x18, 0x68, 0xe7, 0x0a,
-	0xac, 0x4d, 0xb1, 0x65, 0x7d, 0xea, 0x30, 0x82, 0x76, 0x20, 0xc6, 0xbd, 0x80, 0x6b, 0xb2, 0xf8,
-	0x41, 0x44, 0xa6, 0x43, 0x0f, 0xfb, 0x08, 0x74, 0x00, 0x2b, 0xdc, 0x6b, 0xba, 0x32, 0x8e, 0xa5,
-	0x55, 0x11, 0xf1, 0xd1, 0xd4, 0x0e, 0xc4, 0xb9, 0x85, 0x02, 0x25, 0x18, 0x27, 0xf9, 0x78, 0xec,
-	0x27, 0x0a, 0x0b, 0x11, 0x13, 0x42, 0xec, 0x5c, 0x2b, 0x84, 0xcc, 0x14, 0x0a, 0xd5, 0x08, 0xa0,
-	0x92, 0x4b, 0xcd, 0x4e, 0xdb, 0x64, 0xdc, 0x2f, 0x16, 0xe8, 0x7f, 0x07, 0x12, 0xdc, 0x6b, 0xb6,
-	0x86, 0x9c, 0xf8, 0xbb, 0x52, 0x76, 0x57, 0x70, 0x9c, 0x7b, 0x25, 0x7f, 0x8a, 0x1e, 0xc3, 0x62,
-	0x8f, 0x76, 0x88, 0x10, 0xff, 0x66, 0x31, 0x17, 0xb1, 0xd9, 0x71, 0xbe, 0xa7, 0xb4, 0x43, 0xb0,
-	0x40, 0x6b, 0xdf, 0xc2, 0xda, 0x54, 0x19, 0x29, 0x5c, 0x15, 0x92, 0x21, 0x3d, 0x44, 0xa9, 0x77,
-	0x95, 0x03, 0x26, 0x72, 0x68, 0x2f, 0xe0, 0x56, 0xc3, 0xee, 0x0d, 0x8e, 0x4c, 0x3e, 0x3a, 0x6d,
-	0xf4, 0x10, 0x54, 0xee, 0xc9, 0x84, 0xd1, 0x27, 0x52, 0x52, 0xd3, 0x0a, 0x56, 0xb9, 0x37, 0xb5,
-	0x59, 0x75, 0x6a, 0xb3, 0xda, 0x0f, 0x0a, 0xa4, 0x26, 0x99, 0x25, 0xe9, 0xcf, 0x21, 0x61, 0x99,
-	0xac, 0x69, 0x3b, 0xaf, 0xa8, 0x2c, 0x70, 0x7f, 0x3e, 0xe3, 0x03, 0x93, 0xd5, 0x9c, 0x57, 0x14,
-	0xc7, 0xad, 0x60, 0x80, 0x3e, 0x85, 0x65, 0x97, 0xb0, 0xc1, 0x11, 0x97, 0xaf, 0x6f, 0x6e, 0x7e,
-	0x2c, 0x16, 0x38, 0x2c, 0xf1, 0x9a, 0x06, 0x2b, 0xe2, 0xe5, 0x1b, 0x6d, 0x11, 0xc1, 0x62, 0xd7,
-	0x64, 0x5d, 0xc1, 0xe1, 0x06, 0x16, 0x63, 0xed, 0x0c, 0x56, 0x25, 0x46, 0x92, 0xcd, 0x5f, 0xab,
-	0x83, 0xd0, 0x60, 0xe6, 0x20, 0xd4, 0xf7, 0x3c, 0x08, 0x0f, 0x36, 0x0e, 0x08, 0x2f, 0xf9, 0xf6,
-	0x7f, 0x61, 0xf3, 0xee, 0xa1, 0xc7, 0x42, 0x8e, 0xee, 0x12, 0xdb, 0xea, 0x72, 0xc1, 0x25, 0x86,
-	0xe5, 0xec, 0xff, 0x72, 0xb4, 0xf6, 0x8f, 0x02, 0x9b, 0x57, 0x4a, 0xff, 0x57, 0x7b, 0x3e, 0x86,
-	0x84, 0x68, 0x5d, 0x4d, 0xbb, 0x23, 0xa9, 0xdc, 0xd1, 0x27, 0xed, 0x4b, 0x0f, 0x1a, 0x97, 0x28,
-	0x51, 0xab, 0xe0, 0xb8, 0x80, 0xd6, 0x3a, 0x68, 0x0f, 0x96, 0xc4, 0x50, 0xda, 0x70, 0x73, 0x4e,
-	0x08, 0x0e, 0x50, 0x33, 0xd6, 0x5d, 0x7c, 0x6f, 0xeb, 0x16, 0xbe, 0x80, 0xb8, 0xec, 0x50, 0x28,
-	0x0d, 0xeb, 0x75, 0x5c, 0xa9, 0xe2, 0x66, 0xe9, 0x65, 0xf3, 0xeb, 0x67, 0x8d, 0xe7, 0xd5, 0x72,
-	0xed, 0x49, 0xad, 0x5a, 0x49, 0x2d, 0xa0, 0x14, 0xac, 0x8c, 0x57, 0xf6, 0x1b, 0xe5, 0x94, 0x82,
-	0x6e, 0xc3, 0xea, 0xf8, 0x49, 0xa5, 0xda, 0x28, 0xa7, 0xd4, 0xc2, 0x1b, 0x58, 0x9d, 0x32, 0x2d,
-	0xca, 0x42, 0xa6, 0x84, 0xeb, 0xfb, 0x95, 0xf2, 0x7e, 0xe3, 0xb0, 0xf9, 0xb4, 0x5e, 0xa9, 0xce,
-	0x64, 0x4d, 0xc3, 0xfa, 0xcc, 0x7a, 0xe9, 0xab, 0x7a, 0xf9, 0xcb, 0x94, 0x82, 0x36, 0x61, 0x6d,
-	0x66, 0xa5, 0xf1, 0xf2, 0x59, 0x39, 0xa5, 0x46, 0x84, 0xec, 0x8b, 0x95, 0x58, 0xf1, 0xa7, 0x25,
-	0x88, 0x37, 0x82, 0x5b, 0x10, 0x9d, 0x42, 0x62, 0xe4, 0x37, 0xa4, 0x45, 0x9c, 0xd4, 0x8c, 0xcd,
-	0x33, 0x0f, 0xde, 0x8a, 0x91, 0x6f, 0xe5, 0xf6, 0xf7, 0x7f, 0xfc, 0xfd, 0xb3, 0x9a, 0xd3, 0xee,
-	0x1a, 0x11, 0xd7, 0xaf, 0x04, 0x7f, 0xa6, 0x14, 0xd0, 0x31, 0x2c, 0x09, 0xf3, 0xa0, 0xad, 0x88,
-	0xac, 0x61, 0xeb, 0x65, 0x72, 0xf3, 0x01, 0xb2, 0x66, 0x5e, 0xd4, 0xdc, 0x42, 0x1f, 0x1a, 0x51,
-	0x77, 0x2f, 0x33, 0x4e, 0x7d, 0xbb, 0x9e, 0xa1, 0xef, 0x20, 0x19, 0xea, 0x8b, 0x28, 0xff, 0xb6,
-	0x76, 0x3a, 0x29, 0xbf, 0x7d, 0x1d, 0x4c, 0x92, 0xb8, 0x2f, 0x48, 0xdc, 0xd5, 0x36, 0xa2, 0x49,
-	0xf8, 0x7b, 0x7e, 0x03, 0xc9, 0xd0, 0x8d, 0x16, 0x49, 0xe0, 0xea, 0xfd, 0x1c, 0x49, 0x20, 0xe2,
-	0x62, 0xd4, 0xb2, 0x82, 0x40, 0x1a, 0xcd, 0x21, 0x80, 0x7e, 0x51, 0xe0, 0xd6, 0x8c, 0x6b, 0xd1,
-	0xc3, 0xe8, 0xdc, 0x11, 0x4d, 0x25, 0x53, 0x78, 0x17, 0xa8, 0xa4, 0xb2, 0x27, 0xa8, 0xec, 0xa0,
-	0xfc, 0x9c, 0x03, 0x11, 0xe6, 0x34, 0x4e, 0x83, 0xb6, 0x74, 0x56, 0x2a, 0xff, 0x7e, 0x91, 0x55,
-	0xce, 0x2f, 0xb2, 0xca, 0x5f, 0x17, 0x59, 0xe5, 0xc7, 0xcb, 0xec, 0xc2, 0x6f, 0x97, 0x59, 0xe5,
-	0xfc, 0x32, 0xbb, 0xf0, 0xe7, 0x65, 0x76, 0xe1, 0x9b, 0xbc, 0x65, 0xf3, 0xee, 0xa0, 0xa5, 0xb7,
-	0x69, 0x6f, 0x94, 0x2e, 0xf8, 0xdb, 0x63, 0x9d, 0xd7, 0xa3, 0xcf, 0x1c, 0xaf, 0xb5, 0x2c, 0x3e,
-	0x72, 0x3e, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xaa, 0xba, 0x0d, 0xf7, 0x09, 0x00, 0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd8, 0x69, 0x9c, 0x3e, 0x27, 0xad, 0x3b, 0x09, 0x89, 0xe3, 0x16, 0xc7, 0xdd, 0x92,
+	0x3f, 0x8d, 0x94, 0x5d, 0x35, 0x14, 0x09, 0x10, 0x97, 0xac, 0xed, 0x06, 0x0b, 0xda, 0x54, 0x63,
+	0xa3, 0xa8, 0x08, 0xc9, 0x5a, 0xdb, 0x93, 0xf5, 0xaa, 0xf1, 0x4e, 0xb2, 0x33, 0x8e, 0x36, 0x0a,
+	0x11, 0x12, 0x47, 0x4e, 0x08, 0x84, 0xe0, 0xc8, 0x9d, 0x2f, 0xc1, 0x91, 0x63, 0x24, 0x2e, 0xdc,
+	0x40, 0x09, 0x1f, 0x80, 0x8f, 0x80, 0x76, 0x76, 0x6c, 0xaf, 0x9d, 0x75, 0x53, 0x2a, 0x2e, 0xc9,
+	0xcc, 0xbe, 0xdf, 0x7b, 0xef, 0x37, 0xbf, 0x79, 0xef, 0x8d, 0x61, 0xb9, 0xc9, 0x78, 0x87, 0x71,
+	0x43, 0xf8, 0xc6, 0xf1, 0xa3, 0x06, 0x15, 0xd6, 0x23, 0x83, 0x53, 0xef, 0xd8, 0x69, 0x52, 0xfd,
+	0xd0, 0x63, 0x82, 0xe1, 0x3b, 0x21, 0x40, 0x17, 0xbe, 0xae, 0x00, 0xb9, 0x7b, 0x36, 0x63, 0xf6,
+	0x01, 0x35, 0xac, 0x43, 0xc7, 0xb0, 0x5c, 0x97, 0x09, 0x4b, 0x38, 0xcc, 0xe5, 0xa1, 0x43, 0x2e,
+	0xaf, 0xac, 0x72, 0xd7, 0xe8, 0xee, 0x1b, 0xad, 0xae, 0x27, 0x01, 0xca, 0xfe, 0x40, 0x65, 0x6c,
+	0x58, 0x9c, 0x1a, 0x56, 0xa3, 0xe9, 0xf4, 0x13, 0x07, 0x1b, 0x05, 0xca, 0x5d, 0xa5, 0x25, 0x7c,
+	0x65, 0x9b, 0xb7, 0x99, 0xcd, 0xe4, 0xd2, 0x08, 0x56, 0xea, 0xeb, 0x46, 0x34, 0xec, 0x51, 0x97,
+	0x7a, 0x27, 0x7d, 0xcf, 0x43, 0xcb, 0x76, 0xdc, 0x28, 0x85, 0x7b, 0x82, 0xba, 0x2d, 0xea, 0x75,
+	0x1c, 0x57, 0x18, 0xe2, 0xe4, 0x90, 0x72, 0xa3, 0x71, 0xc0, 0x9a, 0x2f, 0xc7, 0x5a, 0xe5, 0xdf,
+	0xd0, 0xaa, 0xfd, 0x82, 0x00, 0xef, 0x50, 0x51, 0xf3, 0x79, 0xf9, 0x98, 0xba, 0x82, 0xd0, 0xa3,
+	0x2e, 0xe5, 0x02, 0x2f, 0xc0, 0x14, 0x0d, 0xf6, 0x3c, 0x8b, 0x0a, 0xc9, 0xf5, 0x9b, 0x44, 0xed,
+	0xf0, 0x13, 0x80, 0x41, 0xfa, 0x6c, 0xa2, 0x80, 0xd6, 0xd3, 0x5b, 0xab, 0xba, 0xd2, 0x34, 0xe0,
+	0xaa, 0x4b, 0xae, 0x3d, 0x6d, 0xf5, 0xe7, 0x96, 0x4d, 0x55, 0x4c, 0x12, 0xf1, 0xc4, 0xef, 0xc1,
+	0x34, 0xf3, 0x5a, 0xd4, 0xab, 0x37, 0x4e, 0xb2, 0xc9, 0x02, 0x5a, 0xbf, 0xb5, 0x95, 0xd3, 0xaf,
+	0xdc, 0x8c, 0xbe, 0x1b, 0x40, 0xcc, 0x13, 0x92, 0x62, 0xe1, 0x42, 0x3b, 0x47, 0x30, 0x37, 0xc4,
+	0x96, 0x1f, 0x32, 0x97, 0x53, 0xbc, 0x06, 0x49, 0xe1, 0x87, 0x5c, 0xd3, 0x5b, 0x6f, 0xc5, 0x44,
+	0xaa, 0xf9, 0x24, 0x40, 0xe0, 0x1d, 0x98, 0x11, 0x7e, 0xdd, 0x53, 0x7e, 0x3c, 0x9b, 0x90, 0x1e,
+	0xef, 0x0c, 0x9d, 0x40, 0xde, 0x5b, 0xc4, 0x51, 0x81, 0x49, 0x5a, 0xf4, 0xd7, 0x41, 0xa0, 0xa8,
+	0x10, 0x49, 0x29, 0xc4, 0xda, 0xb5, 0x42, 0xa8, 0x48, 0x11, 0x57, 0xed, 0x67, 0x04, 0xd8, 0xf4,
+	0x98, 0xd5, 0x6a, 0x5a, 0x5c, 0xd4, 0x7c, 0x25, 0x16, 0x5e, 0x82, 0x69, 0xe1, 0xd7, 0x1b, 0x27,
+	0x82, 0x06, 0xc7, 0x42, 0xeb, 0x33, 0x24, 0x25, 0x7c, 0x33, 0xd8, 0xe2, 0xc7, 0x30, 0xd9, 0x61,
+	0x2d, 0x2a, 0xd5, 0xbf, 0xb5, 0x55, 0x88, 0x39, 0x6d, 0x3f, 0xde, 0x53, 0xd6, 0xa2, 0x44, 0xa2,
+	0xf1, 0x07, 0x90, 0x12, 0x4e, 0x87, 0xb2, 0xae, 0x50, 0x6c, 0x97, 0xf4, 0xb0, 0xb2, 0xf5, 0x5e,
+	0x65, 0xeb, 0x25, 0x55, 0xd9, 0xe6, 0xe4, 0x4f, 0x7f, 0x2e, 0x23, 0xd2, 0xc3, 0x6b, 0x5f, 0xc0,
+	0xdc, 0x10, 0x43, 0x25, 0x7a, 0x19, 0xd2, 0x11, 0x2d, 0x25, 0xcb, 0xd7, 0x95, 0x12, 0x06, 0x52,
+	0x6a, 0x7b, 0x70, 0xbb, 0xea, 0x74, 0xba, 0x07, 0x96, 0xe8, 0x55, 0x0a, 0x7e, 0x08, 0x09, 0xe1,
+	0xab, 0x80, 0xf1, 0xb7, 0x69, 0x26, 0xb2, 0x88, 0x24, 0x84, 0x3f, 0xa4, 0x53, 0x62, 0x48, 0x27,
+	0xed, 0x1b, 0x04, 0x99, 0x41, 0x64, 0x45, 0xfa, 0x23, 0x98, 0xb6, 0x2d, 0x5e, 0x77, 0xdc, 0x7d,
+	0xa6, 0x12, 0xdc, 0x1f, 0xcf, 0x78, 0xc7, 0xe2, 0x15, 0x77, 0x9f, 0x91, 0x94, 0x1d, 0x2e, 0xf0,
+	0xfb, 0x30, 0xe5, 0x51, 0xde, 0x3d, 0x10, 0xaa, 0xf4, 0x0b, 0xe3, 0x7d, 0x89, 0xc4, 0x11, 0x85,
+	0xd7, 0x34, 0x98, 0x91, 0x85, 0xdb, 0x3b, 0x22, 0x86, 0xc9, 0xb6, 0xc5, 0xdb, 0x92, 0xc3, 0x4d,
+	0x22, 0xd7, 0xda, 0x19, 0xcc, 0x2a, 0x8c, 0x22, 0xbb, 0x72, 0xad, 0x0e, 0x52, 0x83, 0x91, 0x8b,
+	0x48, 0xbc, 0xe1, 0x45, 0xf8, 0xb0, 0xb0, 0x43, 0x85, 0x19, 0x8c, 0x8e, 0x3d, 0x47, 0xb4, 0x6b,
+	0x3e, 0x8f, 0x4c, 0x83, 0x36, 0x75, 0xec, 0xb6, 0x90, 0x5c, 0x92, 0x44, 0xed, 0xfe, 0xaf, 0x69,
+	0xa0, 0xfd, 0x83, 0x60, 0xf1, 0x4a, 0xea, 0xff, 0xda, 0xda, 0x8f, 0x61, 0x5a, 0x8e, 0xbd, 0xba,
+	0xd3, 0x52, 0x54, 0x96, 0xf4, 0xc1, 0xe8, 0xd3, 0xc3, 0xa1, 0x27, 0x53, 0x54, 0x4a, 0x24, 0x25,
+	0xa1, 0x95, 0x16, 0xde, 0x84, 0x1b, 0x72, 0xa9, 0x9a, 0x62, 0x71, 0x8c, 0x0b, 0x09, 0x51, 0x23,
+	0x6d, 0x3f, 0xf9, 0xc6, 0x6d, 0xbf, 0xf1, 0x31, 0xa4, 0xd4, 0x74, 0xc3, 0x59, 0x98, 0xdf, 0x25,
+	0xa5, 0x32, 0xa9, 0x9b, 0x2f, 0xea, 0x9f, 0x3d, 0xab, 0x3e, 0x2f, 0x17, 0x2b, 0x4f, 0x2a, 0xe5,
+	0x52, 0x66, 0x02, 0x67, 0x60, 0xa6, 0x6f, 0xd9, 0xae, 0x16, 0x33, 0x08, 0xdf, 0x81, 0xd9, 0xfe,
+	0x97, 0x52, 0xb9, 0x5a, 0xcc, 0x24, 0x36, 0x7e, 0x40, 0x30, 0x3b, 0xd4, 0xf0, 0x38, 0x0f, 0x39,
+	0x93, 0xec, 0x6e, 0x97, 0x8a, 0xdb, 0xd5, 0x5a, 0xfd, 0xe9, 0x6e, 0xa9, 0x3c, 0x12, 0x36, 0x0b,
+	0xf3, 0x23, 0x76, 0xf3, 0xd3, 0xdd, 0xe2, 0x27, 0x19, 0x84, 0x17, 0x61, 0x6e, 0xc4, 0x52, 0x7d,
+	0xf1, 0xac, 0x98, 0x49, 0xc4, 0xb8, 0x6c, 0x4b, 0x4b, 0x32, 0xc6, 0x65, 0x6f, 0xbb, 0x52, 0xcb,
+	0x4c, 0x6e, 0x7d, 0x77, 0x03, 0x52, 0xd5, 0xf0, 0xed, 0xc5, 0xa7, 0x30, 0xdd, 0xeb, 0x44, 0xac,
+	0xc5, 0xdc, 0xe1, 0xc8, 0x00, 0xc8, 0x3d, 0x78, 0x25, 0x46, 0xd5, 0xeb, 0xea, 0xd7, 0xbf, 0xff,
+	0xfd, 0x7d, 0xa2, 0xa0, 0xdd, 0x35, 0x62, 0x1e, 0x7d, 0x05, 0xfe, 0x10, 0x6d, 0xe0, 0x23, 0xb8,
+	0x21, 0xdb, 0x0a, 0x2f, 0xc7, 0x44, 0x8d, 0x36, 0x65, 0xae, 0x30, 0x1e, 0xa0, 0x72, 0xae, 0xc8,
+	0x9c, 0xcb, 0xf8, 0x6d, 0x23, 0xee, 0x45, 0xe7, 0xc6, 0x69, 0xd0, 0xc8, 0x67, 0xf8, 0x2b, 0x48,
+	0x47, 0x26, 0x26, 0x5e, 0x79, 0xd5, 0x8c, 0x1e, 0xa4, 0x5f, 0xbd, 0x0e, 0xa6, 0x48, 0xdc, 0x97,
+	0x24, 0xee, 0x6a, 0x0b, 0xf1, 0x24, 0x82, 0x33, 0x7f, 0x09, 0xe9, 0xc8, 0x3b, 0x19, 0x4b, 0xe0,
+	0xea, 0xab, 0x9f, 0x5b, 0xbd, 0x0e, 0xa6, 0x08, 0xe4, 0x25, 0x81, 0x2c, 0x1e, 0x43, 0x00, 0xff,
+	0x88, 0xe0, 0xf6, 0x48, 0x3f, 0xe3, 0x87, 0xf1, 0xb1, 0x63, 0xc6, 0x4d, 0x6e, 0xe3, 0x75, 0xa0,
+	0x8a, 0xca, 0xa6, 0xa4, 0xb2, 0x86, 0x57, 0xc6, 0x5c, 0x88, 0x6c, 0x5b, 0xe3, 0x34, 0x1c, 0x58,
+	0x67, 0x66, 0xf1, 0xb7, 0x8b, 0x3c, 0x3a, 0xbf, 0xc8, 0xa3, 0xbf, 0x2e, 0xf2, 0xe8, 0xdb, 0xcb,
+	0xfc, 0xc4, 0xaf, 0x97, 0x79, 0x74, 0x7e, 0x99, 0x9f, 0xf8, 0xe3, 0x32, 0x3f, 0xf1, 0xf9, 0x8a,
+	0xed, 0x88, 0x76, 0xb7, 0xa1, 0x37, 0x59, 0xa7, 0x17, 0x2e, 0xfc, 0xb7, 0xc9, 0x5b, 0x2f, 0x7b,
+	0x3f, 0x9e, 0xfc, 0xc6, 0x94, 0x7c, 0x31, 0xdf, 0xfd, 0x77, 0x00, 0xaa, 0xbe, 0x90, 0xaf, 0x6d,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1152,6 +1180,16 @@ func (m *BroadcastTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintService(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Mode != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.Mode))
 		i--
@@ -1552,6 +1590,10 @@ func (m *BroadcastTxRequest) Size() (n int) {
 	if m.Mode != 0 {
 		n += 1 + sovService(uint64(m.Mode))
 	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
@@ -2054,6 +2096,42 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
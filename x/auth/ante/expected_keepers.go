@@ -2,6 +2,7 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -12,6 +13,8 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	HasAuthenticators(ctx sdk.Context, addr sdk.AccAddress) bool
+	Authenticate(ctx sdk.Context, req authenticator.Request) error
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -6,15 +6,17 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -74,20 +76,41 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
+		// Only make check if simulate=false. The pubkey of an account rotated
+		// by MsgRotatePubKey, or of a signer authenticated by the authenticators
+		// of its account, doesn't match its address: the account is only looked
+		// up then, so that a mismatching pubkey is reported before an unknown
+		// account.
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+			if acc := spkd.ak.GetAccount(ctx, signers[i]); acc == nil || acc.GetPubKey() == nil ||
+				(!acc.GetPubKey().Equals(pk) && !usesAuthenticators(ctx, spkd.ak, acc, pk)) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+			}
 		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
+		// the pubkey of a signer authenticated by the authenticators of its
+		// account is not the pubkey of the account
+		if usesAuthenticators(ctx, spkd.ak, acc, pk) {
+			continue
+		}
+
 		// account already has pubkey set,no need to reset
 		if acc.GetPubKey() != nil {
 			continue
 		}
+		// the proofs of possession of the keys of aggregated multisigs are
+		// verified once, when the pubkey is set. The params are only read for
+		// multisigs so that the first tx of other accounts costs no more gas.
+		if _, ok := pk.(multisig.PubKey); ok {
+			if err := types.VerifyProofsOfPossession(ctx.GasMeter(), pk, spkd.ak.GetParams(ctx)); err != nil {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+			}
+		}
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -165,6 +188,9 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 
 		pubKey := signerAcc.GetPubKey()
+		if usesAuthenticators(ctx, sgcd.ak, signerAcc, sig.PubKey) {
+			pubKey = sig.PubKey
+		}
 
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
@@ -191,7 +217,12 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 }
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
-// the SigVerificationDecorator will not check signatures on ReCheck.
+// the SigVerificationDecorator will not check signatures on ReCheck. The single
+// signatures of the ed25519 and bls12381 keys are batch verified.
+//
+// The signature of a signer whose key is not the pubkey of its account is
+// verified with the key of the signer, and the tx must then be authenticated by
+// one of the authenticators of the account.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
@@ -248,6 +279,14 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// the single signatures of the keys supporting it are batch verified once
+	// all the signers have been checked
+	var batches sigBatches
+
+	// the signers authenticated by the authenticators of their account are
+	// authenticated once all the signatures have been verified
+	var authReqs []authenticator.Request
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -256,6 +295,15 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
+		if usesAuthenticators(ctx, svd.ak, acc, sig.PubKey) {
+			pubKey = sig.PubKey
+			authReqs = append(authReqs, authenticator.Request{
+				Account: signerAddrs[i],
+				PubKey:  pubKey,
+				Msgs:    tx.GetMsgs(),
+				Fee:     feePaidBy(tx, signerAddrs[i]),
+			})
+		}
 		if !simulate && pubKey == nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
@@ -283,25 +331,125 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
-			if err != nil {
-				var errMsg string
-				if OnlyLegacyAminoSigners(sig.Data) {
-					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
-					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
-				} else {
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
+			var errMsg string
+			if OnlyLegacyAminoSigners(sig.Data) {
+				// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+				// and therefore communicate sequence number as a potential cause of error.
+				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+			} else {
+				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
+			}
+			sigErr := sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+
+			if single, ok := sig.Data.(*signing.SingleSignatureData); ok {
+				if batch := batches.batchOf(pubKey); batch != nil {
+					signBytes, err := svd.signModeHandler.GetSignBytes(single.SignMode, signerData, tx)
+					if err != nil {
+						return ctx, err
+					}
+					if err := batch.add(pubKey, signBytes, single.Signature, sigErr); err != nil {
+						return ctx, err
+					}
+					continue
 				}
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+			}
 
+			if err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx); err != nil {
+				return ctx, sigErr
 			}
 		}
 	}
 
+	if err := batches.verify(); err != nil {
+		return ctx, err
+	}
+
+	for _, req := range authReqs {
+		if err := svd.ak.Authenticate(ctx, req); err != nil {
+			return ctx, err
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
 
+// sigBatches are the batches of the signatures of a tx, one per key type
+// supporting batch verification, in the order of their first signature.
+type sigBatches []*sigBatch
+
+// sigBatch is a batch of signatures, with the error returned for each of them
+// if it is invalid.
+type sigBatch struct {
+	keyType  string
+	verifier cryptotypes.BatchVerifier
+	errs     []error
+}
+
+// batchOf returns the batch of the signatures of pubKey, or nil if they
+// can't be batch verified.
+func (b *sigBatches) batchOf(pubKey cryptotypes.PubKey) *sigBatch {
+	for _, batch := range *b {
+		if batch.keyType == pubKey.Type() {
+			return batch
+		}
+	}
+
+	verifier := newBatchVerifier(pubKey)
+	if verifier == nil {
+		return nil
+	}
+
+	batch := &sigBatch{keyType: pubKey.Type(), verifier: verifier}
+	*b = append(*b, batch)
+
+	return batch
+}
+
+func (b *sigBatch) add(pubKey cryptotypes.PubKey, msg, sig []byte, sigErr error) error {
+	if err := b.verifier.Add(pubKey, msg, sig); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	b.errs = append(b.errs, sigErr)
+
+	return nil
+}
+
+// verify verifies each batch, returning the error of the first invalid
+// signature of the first invalid batch.
+func (b sigBatches) verify() error {
+	for _, batch := range b {
+		ok, valid := batch.verifier.Verify()
+		if ok {
+			continue
+		}
+
+		for i, v := range valid {
+			if !v {
+				return batch.errs[i]
+			}
+		}
+
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+	}
+
+	return nil
+}
+
+// newBatchVerifier returns a batch verifier for the signatures of pubKey, or
+// nil if its key type doesn't support batch verification.
+func newBatchVerifier(pubKey cryptotypes.PubKey) cryptotypes.BatchVerifier {
+	switch pubKey.(type) {
+	case *ed25519.PubKey:
+		return ed25519.NewBatchVerifier()
+
+	case *bls12381.PubKey:
+		return bls12381.NewBatchVerifier()
+
+	default:
+		return nil
+	}
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -398,6 +546,27 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *webauthn.PubKey:
+		// the hashing and the parsing of the client data are negligible next
+		// to the verification of the secp256r1 signature
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: webauthn")
+		return nil
+
+	case *bls12381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBLS12381(), "ante verify: bls12381")
+		return nil
+
+	case *bls12381.MultisigPubKey:
+		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
+		if !ok {
+			return fmt.Errorf("expected %T, got, %T", &signing.MultiSignatureData{}, sig.Data)
+		}
+		// a single signature aggregates the signatures of the signers
+		signers := uint64(multisignature.BitArray.NumTrueBitsBefore(multisignature.BitArray.Count()))
+		meter.ConsumeGas(signers*params.SigVerifyCostBLS12381Aggregation(), "ante verify: bls12381 aggregation")
+		meter.ConsumeGas(params.SigVerifyCostBLS12381(), "ante verify: bls12381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -441,6 +610,24 @@ func ConsumeMultisignatureVerificationGas(
 	return nil
 }
 
+// usesAuthenticators returns true if a signature of pubKey for acc is
+// authenticated by the authenticators of acc, i.e. if acc has authenticators and
+// pubKey is not its pubkey.
+func usesAuthenticators(ctx sdk.Context, ak AccountKeeper, acc types.AccountI, pubKey cryptotypes.PubKey) bool {
+	return pubKey != nil && acc.GetPubKey() != nil && !acc.GetPubKey().Equals(pubKey) &&
+		ak.HasAuthenticators(ctx, acc.GetAddress())
+}
+
+// feePaidBy returns the fee of tx paid by the account at addr, if any.
+func feePaidBy(tx sdk.Tx, addr sdk.AccAddress) sdk.Coins {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() != nil || !feeTx.FeePayer().Equals(addr) {
+		return nil
+	}
+
+	return feeTx.GetFee()
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types.AccountI, error) {
@@ -453,7 +640,7 @@ func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types
 
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub cryptotypes.PubKey) int {
-	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
@@ -1,13 +1,17 @@
 package ante_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
 // This benchmark is used to asses the ante.Secp256k1ToR1GasFactor value
@@ -42,3 +46,63 @@ func BenchmarkSig(b *testing.B) {
 		}
 	})
 }
+
+// This benchmark compares the verification of the signatures of a tx one at a
+// time with their batch verification, as done by the SigVerificationDecorator
+func BenchmarkBatchSig(b *testing.B) {
+	keyTypes := []struct {
+		name        string
+		genPrivKey  func() cryptotypes.PrivKey
+		newVerifier func() cryptotypes.BatchVerifier
+	}{
+		{
+			"ed25519",
+			func() cryptotypes.PrivKey { return ed25519.GenPrivKey() },
+			func() cryptotypes.BatchVerifier { return ed25519.NewBatchVerifier() },
+		},
+		{
+			"bls12381",
+			func() cryptotypes.PrivKey { return bls12381.GenPrivKey() },
+			func() cryptotypes.BatchVerifier { return bls12381.NewBatchVerifier() },
+		},
+	}
+
+	for _, keyType := range keyTypes {
+		for _, n := range []int{1, 4, 16, 64} {
+			require := require.New(b)
+			pubKeys := make([]cryptotypes.PubKey, n)
+			msgs := make([][]byte, n)
+			sigs := make([][]byte, n)
+			for i := 0; i < n; i++ {
+				privKey := keyType.genPrivKey()
+				pubKeys[i] = privKey.PubKey()
+				msgs[i] = tmcrypto.CRandBytes(1000)
+
+				var err error
+				sigs[i], err = privKey.Sign(msgs[i])
+				require.NoError(err)
+			}
+
+			b.Run(fmt.Sprintf("%s/%d/single", keyType.name, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					for j := 0; j < n; j++ {
+						require.True(pubKeys[j].VerifySignature(msgs[j], sigs[j]))
+					}
+				}
+			})
+
+			b.Run(fmt.Sprintf("%s/%d/batch", keyType.name, n), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					verifier := keyType.newVerifier()
+					for j := 0; j < n; j++ {
+						require.NoError(verifier.Add(pubKeys[j], msgs[j], sigs[j]))
+					}
+					ok, _ := verifier.Verify()
+					require.True(ok)
+				}
+			})
+		}
+	}
+}
@@ -1,23 +1,37 @@
 package ante_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *AnteTestSuite) TestSetPubKey() {
@@ -64,6 +78,25 @@ func (suite *AnteTestSuite) TestSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSetPubKeyUnknownAccount() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// the signer does not exist and its address does not match the key
+	_, _, addr := testdata.KeyTestPubAddr()
+	require := suite.Require()
+	require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{secp256k1.GenPrivKey()}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+	require.NoError(err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetPubKeyDecorator(suite.app.AccountKeeper))
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -83,6 +116,16 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 		suite.Require().NoError(err)
 	}
 
+	blsPrivs, blsMultisigKey := generateBLSMultisig(suite, 5, 3)
+	blsMultisignature := multisig.NewMultisig(5)
+	for _, i := range []int{0, 2, 4} {
+		sig, err := blsPrivs[i].Sign(msg)
+		suite.Require().NoError(err)
+		multisig.AddSignature(blsMultisignature, &signing.SingleSignatureData{Signature: sig}, i)
+	}
+	blsMultisignature, err := blsMultisigKey.AggregateMultisignature(blsMultisignature)
+	suite.Require().NoError(err)
+
 	type args struct {
 		meter  sdk.GasMeter
 		sig    signing.SignatureData
@@ -98,7 +141,10 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyWebAuthn", args{sdk.NewInfiniteGasMeter(), nil, &webauthn.PubKey{Key: skR1.PubKey().Bytes(), RPID: "example.com"}, params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyBls12381", args{sdk.NewInfiniteGasMeter(), nil, blsPrivs[0].PubKey(), params}, p.SigVerifyCostBLS12381(), false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
+		{"Bls12381Multisig", args{sdk.NewInfiniteGasMeter(), blsMultisignature, blsMultisigKey, params}, p.SigVerifyCostBLS12381() + 3*p.SigVerifyCostBLS12381Aggregation(), false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
@@ -317,6 +363,434 @@ func (suite *AnteTestSuite) TestSigIntegration() {
 	suite.Require().Equal(initialSigCost*uint64(len(privs)), doubleCost-initialCost)
 }
 
+func (suite *AnteTestSuite) TestSigVerificationBatch() {
+	suite.SetupTest(true) // setup
+	require := suite.Require()
+	// Make block-height non-zero to include accNum in SignBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	// the signatures of each key type supporting it are batch verified, the
+	// others one at a time
+	privs := []cryptotypes.PrivKey{
+		bls12381.GenPrivKey(), ed25519.GenPrivKey(), secp256k1.GenPrivKey(), bls12381.GenPrivKey(), ed25519.GenPrivKey(),
+	}
+	msgs := make([]sdk.Msg, len(privs))
+	accNums := make([]uint64, len(privs))
+	accSeqs := make([]uint64, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		require.NoError(acc.SetAccountNumber(uint64(i)))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+		accNums[i] = uint64(i)
+	}
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name     string
+		invalid  []int
+		expError string
+	}{
+		{"valid signatures", nil, ""},
+		{"invalid bls12381 signature", []int{3}, "account number (3)"},
+		{"invalid ed25519 signatures", []int{4, 1}, "account number (1)"},
+		{"invalid signatures of each type", []int{4, 2, 3}, "account number (2)"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			require.NoError(err)
+
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(err)
+			for _, i := range tc.invalid {
+				sigs[i].Data.(*signing.SingleSignatureData).Signature[7] ^= byte(0x01)
+			}
+			require.NoError(suite.txBuilder.SetSignatures(sigs...))
+
+			cacheCtx, _ := suite.ctx.CacheContext()
+			_, err = antehandler(cacheCtx, suite.txBuilder.GetTx(), false)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+				require.Contains(err.Error(), tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestWebAuthn() {
+	suite.SetupTest(true) // setup
+	require := suite.Require()
+	// Make block-height non-zero to include accNum in SignBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	credential, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	pubKey := &webauthn.PubKey{Key: elliptic.MarshalCompressed(elliptic.P256(), credential.X, credential.Y), RPID: "wallet.example.com"}
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	// newTx returns a tx whose signature is the assertion of a passkey of the
+	// relying party rpID, whose challenge is the hash of challengeBytes
+	newTx := func(rpID string, challengeBytes func([]byte) []byte) authsigning.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+		require.NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Data: sigData}))
+		signerData := authsigning.SignerData{ChainID: suite.ctx.ChainID(), AccountNumber: acc.GetAccountNumber()}
+		signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+			signing.SignMode_SIGN_MODE_DIRECT, signerData, suite.txBuilder.GetTx())
+		require.NoError(err)
+
+		challenge := sha256.Sum256(challengeBytes(signBytes))
+		clientDataJSON := fmt.Sprintf(`{"type":"webauthn.get","challenge":%q,"origin":"https://%s"}`,
+			base64.RawURLEncoding.EncodeToString(challenge[:]), rpID)
+		clientDataHash := sha256.Sum256([]byte(clientDataJSON))
+		rpIDHash := sha256.Sum256([]byte(rpID))
+		authData := append(rpIDHash[:], 0x05, 0, 0, 0, 1)
+		h := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+		ecdsaSig, err := ecdsa.SignASN1(rand.Reader, credential, h[:])
+		require.NoError(err)
+
+		sigData.Signature, err = (&webauthn.Signature{
+			AuthenticatorData: authData, ClientDataJSON: []byte(clientDataJSON), Signature: ecdsaSig,
+		}).Marshal()
+		require.NoError(err)
+		require.NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Data: sigData}))
+
+		return suite.txBuilder.GetTx()
+	}
+	signBytes := func(bz []byte) []byte { return bz }
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, newTx(pubKey.RPID, func([]byte) []byte { return []byte("other sign bytes") }), false)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, newTx("evil.example.com", signBytes), false)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = antehandler(suite.ctx, newTx(pubKey.RPID, signBytes), false)
+	require.NoError(err)
+
+	accPubKey, err := suite.app.AccountKeeper.GetPubKey(suite.ctx, addr)
+	require.NoError(err)
+	require.True(pubKey.Equals(accPubKey))
+}
+
+func (suite *AnteTestSuite) TestRotatedPubKey() {
+	suite.SetupTest(false) // setup
+	require := suite.Require()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(1)
+	addr, oldPriv := accounts[0].acc.GetAddress(), accounts[0].priv
+	require.NoError(accounts[0].acc.SetPubKey(oldPriv.PubKey()))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, accounts[0].acc)
+
+	newPriv := secp256k1.GenPrivKey()
+	newPubKeySig, err := newPriv.Sign(types.PubKeyRotationSignBytes(suite.ctx.ChainID(), addr, oldPriv.PubKey()))
+	require.NoError(err)
+	require.NoError(suite.app.AccountKeeper.RotatePubKey(suite.ctx, addr, newPriv.PubKey(), &signing.SingleSignatureData{Signature: newPubKeySig}))
+
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	accNums, accSeqs := []uint64{0}, []uint64{0}
+
+	testCases := []struct {
+		desc string
+		priv cryptotypes.PrivKey
+		tc   TestCase
+	}{
+		{"signed by the old key", oldPriv, TestCase{expPass: false, expErr: sdkerrors.ErrUnauthorized}},
+		{"signed by another key", secp256k1.GenPrivKey(), TestCase{expPass: false, expErr: sdkerrors.ErrInvalidPubKey}},
+		{"signed by the new key", newPriv, TestCase{expPass: true}},
+	}
+
+	for _, tc := range testCases {
+		tc.tc.desc = tc.desc
+		suite.RunTestCase([]cryptotypes.PrivKey{tc.priv}, msgs, feeAmount, gasLimit, accNums, accSeqs, suite.ctx.ChainID(), tc.tc)
+	}
+}
+
+func (suite *AnteTestSuite) TestRotatedPubKeyVestingAccount() {
+	suite.SetupTest(false) // setup
+	require := suite.Require()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(2)
+	addr, oldPriv := accounts[0].acc.GetAddress(), accounts[0].priv
+	baseAcc := types.NewBaseAccount(addr, oldPriv.PubKey(), accounts[0].acc.GetAccountNumber(), 0)
+	vesting := sdk.NewCoins(sdk.NewInt64Coin("atom", 5000000))
+	endTime := suite.ctx.BlockTime().Add(time.Hour).Unix()
+	suite.app.AccountKeeper.SetAccount(suite.ctx, vestingtypes.NewContinuousVestingAccount(baseAcc, vesting, suite.ctx.BlockTime().Unix(), endTime))
+
+	newPriv := secp256k1.GenPrivKey()
+	newPubKeySig, err := newPriv.Sign(types.PubKeyRotationSignBytes(suite.ctx.ChainID(), addr, oldPriv.PubKey()))
+	require.NoError(err)
+	require.NoError(suite.app.AccountKeeper.RotatePubKey(suite.ctx, addr, newPriv.PubKey(), &signing.SingleSignatureData{Signature: newPubKeySig}))
+
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr)}
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 150))
+	gasLimit := testdata.NewTestGasLimit()
+	accNums, accSeqs := []uint64{accounts[0].acc.GetAccountNumber()}, []uint64{0}
+
+	suite.RunTestCase([]cryptotypes.PrivKey{oldPriv}, msgs, feeAmount, gasLimit, accNums, accSeqs, suite.ctx.ChainID(), TestCase{
+		desc: "signed by the old key", expPass: false, expErr: sdkerrors.ErrUnauthorized,
+	})
+	suite.RunTestCase([]cryptotypes.PrivKey{newPriv}, msgs, feeAmount, gasLimit, accNums, accSeqs, suite.ctx.ChainID(), TestCase{
+		desc: "signed by the new key", expPass: true,
+	})
+
+	// the account is still vesting
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	require.True(ok)
+	require.True(newPriv.PubKey().Equals(acc.GetPubKey()))
+	require.Equal(vesting, acc.GetOriginalVesting())
+	require.Equal(endTime, acc.GetEndTime())
+}
+
+func (suite *AnteTestSuite) TestSessionKey() {
+	suite.SetupTest(false) // setup
+	require := suite.Require()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(2)
+	addr, priv := accounts[0].acc.GetAddress(), accounts[0].priv
+	require.NoError(accounts[0].acc.SetPubKey(priv.PubKey()))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, accounts[0].acc)
+
+	// the session key can only send up to 200atom, fees included
+	sessionPriv := secp256k1.GenPrivKey()
+	sessionPubKey, err := suite.app.AppCodec().MarshalInterfaceJSON(sessionPriv.PubKey())
+	require.NoError(err)
+	config := fmt.Sprintf(`[
+		{"type":"SignatureKey","config":%s},
+		{"type":"MessageFilter","config":["/cosmos.bank.v1beta1.MsgSend"]},
+		{"type":"SpendLimit","config":{"limit":[{"denom":"atom","amount":"200"}]}}
+	]`, sessionPubKey)
+	id, err := suite.app.AccountKeeper.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, []byte(config))
+	require.NoError(err)
+
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addr, accounts[1].acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("atom", amount)))
+	}
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+	gasLimit := testdata.NewTestGasLimit()
+	accNums := []uint64{0}
+
+	testCases := []struct {
+		desc   string
+		priv   cryptotypes.PrivKey
+		msg    sdk.Msg
+		accSeq uint64
+		tc     TestCase
+	}{
+		{"signed by the session key", sessionPriv, send(10), 0, TestCase{expPass: true}},
+		{"message not allowed", sessionPriv, testdata.NewTestMsg(addr), 1, TestCase{expPass: false, expErr: sdkerrors.ErrUnauthorized}},
+		{"spend limit exceeded", sessionPriv, send(91), 1, TestCase{expPass: false, expErr: sdkerrors.ErrUnauthorized}},
+		{"spend limit reached", sessionPriv, send(90), 1, TestCase{expPass: true}},
+		{"authenticator removed by the session key", sessionPriv, types.NewMsgRemoveAuthenticator(addr, id), 2, TestCase{expPass: false, expErr: sdkerrors.ErrUnauthorized}},
+		{"signed by another key", secp256k1.GenPrivKey(), send(1), 2, TestCase{expPass: false, expErr: sdkerrors.ErrUnauthorized}},
+		{"signed by the pubkey of the account", priv, testdata.NewTestMsg(addr), 2, TestCase{expPass: true}},
+	}
+
+	for _, tc := range testCases {
+		tc.tc.desc = tc.desc
+		suite.RunTestCase([]cryptotypes.PrivKey{tc.priv}, []sdk.Msg{tc.msg}, feeAmount, gasLimit, accNums, []uint64{tc.accSeq}, suite.ctx.ChainID(), tc.tc)
+	}
+
+	// the session key cannot manage the account through authz either, even
+	// with an authenticator allowing any message
+	_, err = suite.app.AccountKeeper.AddAuthenticator(suite.ctx, addr, authenticator.SignatureKeyType, sessionPubKey)
+	require.NoError(err)
+	exec := authz.NewMsgExec(addr, []sdk.Msg{types.NewMsgRemoveAuthenticator(addr, id)})
+	rotateGrant, err := authz.NewMsgGrant(addr, accounts[1].acc.GetAddress(), authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgRotatePubKey{})), suite.ctx.BlockTime().Add(time.Hour))
+	require.NoError(err)
+	sendGrant, err := authz.NewMsgGrant(addr, accounts[1].acc.GetAddress(), authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), suite.ctx.BlockTime().Add(time.Hour))
+	require.NoError(err)
+
+	authzCases := []struct {
+		desc string
+		msg  sdk.Msg
+		tc   TestCase
+	}{
+		{"authenticator removed in exec", &exec, TestCase{expPass: false, expErr: sdkerrors.ErrUnauthorized}},
+		{"pubkey rotation granted", rotateGrant, TestCase{expPass: false, expErr: sdkerrors.ErrUnauthorized}},
+		{"send granted", sendGrant, TestCase{expPass: true}},
+	}
+	for _, tc := range authzCases {
+		tc.tc.desc = tc.desc
+		suite.RunTestCase([]cryptotypes.PrivKey{sessionPriv}, []sdk.Msg{tc.msg}, feeAmount, gasLimit, accNums, []uint64{3}, suite.ctx.ChainID(), tc.tc)
+	}
+
+	// the pubkey of the account is left unchanged
+	require.True(priv.PubKey().Equals(suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetPubKey()))
+}
+
+func (suite *AnteTestSuite) TestAuthenticatorWithoutSignatureKey() {
+	suite.SetupTest(false) // setup
+	require := suite.Require()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(2)
+	addr, priv := accounts[0].acc.GetAddress(), accounts[0].priv
+	require.NoError(accounts[0].acc.SetPubKey(priv.PubKey()))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, accounts[0].acc)
+
+	// the authenticators cannot be added, but may come from the genesis
+	ak := suite.app.AccountKeeper
+	_, err := ak.AddAuthenticator(suite.ctx, addr, authenticator.MessageFilterType, []byte(`["/cosmos.bank.v1beta1.MsgSend"]`))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	ak.SetAuthenticator(suite.ctx, types.NewAccountAuthenticator(addr, 0, authenticator.MessageFilterType, []byte(`["/cosmos.bank.v1beta1.MsgSend"]`)))
+	ak.SetAuthenticator(suite.ctx, types.NewAccountAuthenticator(addr, 1, authenticator.TimeWindowType, []byte(`{"not_before":"2022-01-01T00:00:00Z"}`)))
+
+	send := banktypes.NewMsgSend(addr, accounts[1].acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+	gasLimit := testdata.NewTestGasLimit()
+
+	suite.RunTestCase([]cryptotypes.PrivKey{secp256k1.GenPrivKey()}, []sdk.Msg{send}, feeAmount, gasLimit, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), TestCase{
+		desc: "signed by a stranger", expPass: false, expErr: sdkerrors.ErrUnauthorized,
+	})
+	suite.RunTestCase([]cryptotypes.PrivKey{priv}, []sdk.Msg{send}, feeAmount, gasLimit, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), TestCase{
+		desc: "signed by the pubkey of the account", expPass: true,
+	})
+}
+
+func (suite *AnteTestSuite) TestSigIntegrationBLS12381() {
+	params := types.DefaultParams()
+	secpCost, err := suite.runSigDecorators(params, false, secp256k1.GenPrivKey())
+	suite.Require().NoError(err)
+	blsCost, err := suite.runSigDecorators(params, false, bls12381.GenPrivKey())
+	suite.Require().NoError(err)
+
+	// the larger BLS public key also costs more to store
+	suite.Require().GreaterOrEqual(blsCost-secpCost, params.SigVerifyCostBLS12381()-params.SigVerifyCostSecp256k1)
+}
+
+func (suite *AnteTestSuite) TestAggregatedMultisig() {
+	suite.SetupTest(true) // setup
+	require := suite.Require()
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	// Make block-height non-zero to include accNum in SignBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	privs, pubKey := generateBLSMultisig(suite, 5, 3)
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	// the signers sign the same sign bytes, which don't depend on the signers
+	newTx := func(pubKey *bls12381.MultisigPubKey, signers ...int) authsigning.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		signerData := authsigning.SignerData{ChainID: suite.ctx.ChainID(), AccountNumber: acc.GetAccountNumber()}
+		signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+			signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, suite.txBuilder.GetTx())
+		require.NoError(err)
+
+		sig := multisig.NewMultisig(len(pubKey.PubKeys))
+		for _, i := range signers {
+			sigBz, err := privs[i].Sign(signBytes)
+			require.NoError(err)
+			sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: sigBz}
+			require.NoError(multisig.AddSignatureFromPubKey(sig, sigData, privs[i].PubKey(), pubKey.GetPubKeys()))
+		}
+		sig, err = pubKey.AggregateMultisignature(sig)
+		require.NoError(err)
+
+		require.NoError(suite.txBuilder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Data: sig}))
+		return suite.txBuilder.GetTx()
+	}
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svgc := ante.NewSigGasConsumeDecorator(suite.app.AccountKeeper, ante.DefaultSigVerificationGasConsumer)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
+
+	// below the threshold
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err := antehandler(cacheCtx, newTx(pubKey, 1, 3), false)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the proofs of possession are verified when the pubkey is set, then a
+	// single signature is verified
+	tx := newTx(pubKey, 1, 3, 4)
+	before := suite.ctx.GasMeter().GasConsumed()
+	ctx, err := antehandler(suite.ctx, tx, false)
+	require.NoError(err)
+	require.GreaterOrEqual(
+		ctx.GasMeter().GasConsumed()-before,
+		6*params.SigVerifyCostBLS12381()+3*params.SigVerifyCostBLS12381Aggregation(),
+	)
+
+	accPubKey, err := suite.app.AccountKeeper.GetPubKey(ctx, addr)
+	require.NoError(err)
+	require.True(pubKey.Equals(accPubKey))
+
+	// but not once the pubkey is set
+	before = ctx.GasMeter().GasConsumed()
+	ctx, err = antehandler(ctx, tx, false)
+	require.NoError(err)
+	require.Less(ctx.GasMeter().GasConsumed()-before, 5*params.SigVerifyCostBLS12381())
+
+	// a multisig with a rogue key isn't set
+	rogue := *pubKey
+	rogue.ProofsOfPossession = append([][]byte{pubKey.ProofsOfPossession[1]}, pubKey.ProofsOfPossession[1:]...)
+	addr = sdk.AccAddress(rogue.Address())
+	acc = suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	_, err = antehandler(suite.ctx, newTx(&rogue, 1, 3, 4), false)
+	require.ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+}
+
+func generateBLSMultisig(suite *AnteTestSuite, n, threshold int) ([]*bls12381.PrivKey, *bls12381.MultisigPubKey) {
+	var (
+		privs   []*bls12381.PrivKey
+		pubKeys []*bls12381.PubKey
+		pops    [][]byte
+	)
+	for i := 0; i < n; i++ {
+		priv := bls12381.GenPrivKey()
+		pop, err := priv.ProofOfPossession()
+		suite.Require().NoError(err)
+
+		privs = append(privs, priv)
+		pubKeys = append(pubKeys, priv.PubKey().(*bls12381.PubKey))
+		pops = append(pops, pop)
+	}
+
+	pubKey, err := bls12381.NewMultisigPubKey(threshold, pubKeys, pops)
+	suite.Require().NoError(err)
+
+	return privs, pubKey
+}
+
 func (suite *AnteTestSuite) runSigDecorators(params types.Params, _ bool, privs ...cryptotypes.PrivKey) (sdk.Gas, error) {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
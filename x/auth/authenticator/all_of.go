@@ -0,0 +1,81 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllOfType is the type of the AllOf authenticator.
+const AllOfType = "AllOf"
+
+// SubAuthenticator defines an authenticator combined by AllOf.
+type SubAuthenticator struct {
+	Type   string          `json:"type"`
+	Config json.RawMessage `json:"config"`
+}
+
+var _ Authenticator = AllOf{}
+
+// AllOf authenticates the txs authenticated by all of its sub-authenticators,
+// e.g. to restrict the txs signed by a session key. Its config is the JSON list
+// of the types and configs of the sub-authenticators, which must be JSON, e.g.
+//
+//	[
+//	  {"type":"SignatureKey","config":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8oW..."}},
+//	  {"type":"MessageFilter","config":["/cosmos.bank.v1beta1.MsgSend"]},
+//	  {"type":"TimeWindow","config":{"not_after":"2022-01-02T00:00:00Z"}}
+//	]
+//
+// Each sub-authenticator keeps its state under the big endian encoding of its
+// index in the list.
+type AllOf struct {
+	registry       *Registry
+	authenticators []Authenticator
+}
+
+// NewAllOf returns the AllOf authenticator type, combining the authenticator
+// types of registry.
+func NewAllOf(registry *Registry) AllOf {
+	return AllOf{registry: registry}
+}
+
+func (AllOf) Type() string {
+	return AllOfType
+}
+
+func (ao AllOf) Initialize(config []byte) (Authenticator, error) {
+	var subs []SubAuthenticator
+	if err := json.Unmarshal(config, &subs); err != nil {
+		return nil, fmt.Errorf("invalid all of: %w", err)
+	}
+	if len(subs) == 0 {
+		return nil, errors.New("all of has no authenticator")
+	}
+
+	authenticators := make([]Authenticator, len(subs))
+	for i, sub := range subs {
+		authenticator, err := ao.registry.Initialize(sub.Type, sub.Config)
+		if err != nil {
+			return nil, fmt.Errorf("authenticator %d: %w", i, err)
+		}
+		authenticators[i] = authenticator
+	}
+
+	return AllOf{registry: ao.registry, authenticators: authenticators}, nil
+}
+
+func (ao AllOf) Authenticate(ctx sdk.Context, req Request) error {
+	store := req.Store
+	for i, authenticator := range ao.authenticators {
+		req.Store = prefix.NewStore(store, sdk.Uint64ToBigEndian(uint64(i)))
+		if err := authenticator.Authenticate(ctx, req); err != nil {
+			return fmt.Errorf("%s: %w", authenticator.Type(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Package authenticator defines the authenticators which accounts can attach to
+// authenticate the txs signed for them with another key than their public key,
+// e.g. session keys restricted to some messages, a spend limit or a time window.
+//
+// An authenticator type is registered once in the Registry of the app, and an
+// account attaches it with a configuration by a MsgAddAuthenticator. The
+// signature of a signer whose key is not the public key of its account is
+// verified with the key of the signer, then the ante handler calls the
+// authenticators of the account, the tx being authenticated by the first one
+// returning no error. Each authenticator attached to an account must bind the
+// key of the signer with a SignatureKey, possibly combined by an AllOf.
+package authenticator
+
+import (
+	"fmt"
+	"sort"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authenticator defines an authenticator type.
+type Authenticator interface {
+	// Type returns the type of the authenticator, under which it is registered.
+	Type() string
+
+	// Initialize returns the authenticator configured by config, or an error
+	// if config is invalid. It is called when the authenticator is attached
+	// to an account and before each authentication.
+	Initialize(config []byte) (Authenticator, error)
+
+	// Authenticate returns an error if the tx of req is not authenticated.
+	// Any state written in the store of req is discarded if it returns an
+	// error.
+	Authenticate(ctx sdk.Context, req Request) error
+}
+
+// Request defines the request to authenticate a tx signed for an account.
+type Request struct {
+	// Account is the address of the account.
+	Account sdk.AccAddress
+	// PubKey is the key whose signature of the tx has been verified.
+	PubKey cryptotypes.PubKey
+	// Msgs are the messages of the tx.
+	Msgs []sdk.Msg
+	// Fee is the fee of the tx paid by the account, if any.
+	Fee sdk.Coins
+	// Store is the store of the state of the authenticator for the account.
+	Store sdk.KVStore
+}
+
+// SignatureKeys returns the keys of the SignatureKey authenticators of a, which
+// is either a SignatureKey or an AllOf combining some. An authenticator without
+// any does not bind the key signing the txs it authenticates.
+func SignatureKeys(a Authenticator) []cryptotypes.PubKey {
+	switch a := a.(type) {
+	case SignatureKey:
+		return []cryptotypes.PubKey{a.pubKey}
+
+	case AllOf:
+		var pubKeys []cryptotypes.PubKey
+		for _, sub := range a.authenticators {
+			pubKeys = append(pubKeys, SignatureKeys(sub)...)
+		}
+		return pubKeys
+
+	default:
+		return nil
+	}
+}
+
+// Registry defines the authenticator types which can be attached to accounts.
+type Registry struct {
+	authenticators map[string]Authenticator
+}
+
+// NewRegistry returns a registry without authenticator types.
+func NewRegistry() *Registry {
+	return &Registry{authenticators: make(map[string]Authenticator)}
+}
+
+// Register registers the given authenticator types. It panics if a type is
+// already registered.
+func (r *Registry) Register(authenticators ...Authenticator) {
+	for _, authenticator := range authenticators {
+		if _, ok := r.authenticators[authenticator.Type()]; ok {
+			panic(fmt.Sprintf("authenticator type %s already registered", authenticator.Type()))
+		}
+		r.authenticators[authenticator.Type()] = authenticator
+	}
+}
+
+// Get returns the authenticator registered for authenticatorType, or false if
+// the type is not registered.
+func (r *Registry) Get(authenticatorType string) (Authenticator, bool) {
+	authenticator, ok := r.authenticators[authenticatorType]
+	return authenticator, ok
+}
+
+// Initialize returns the authenticator of type authenticatorType configured by
+// config.
+func (r *Registry) Initialize(authenticatorType string, config []byte) (Authenticator, error) {
+	authenticator, ok := r.Get(authenticatorType)
+	if !ok {
+		return nil, fmt.Errorf("unknown authenticator type %s", authenticatorType)
+	}
+
+	return authenticator.Initialize(config)
+}
+
+// Types returns the registered authenticator types, in alphabetical order.
+func (r *Registry) Types() []string {
+	types := make([]string, 0, len(r.authenticators))
+	for authenticatorType := range r.authenticators {
+		types = append(types, authenticatorType)
+	}
+	sort.Strings(types)
+
+	return types
+}
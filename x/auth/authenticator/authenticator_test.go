@@ -0,0 +1,243 @@
+package authenticator_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func newRegistry() (*authenticator.Registry, codec.Codec) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	registry := authenticator.NewRegistry()
+	registry.Register(
+		authenticator.NewSignatureKey(cdc),
+		authenticator.NewMessageFilter(),
+		authenticator.NewTimeWindow(),
+		authenticator.NewSpendLimit(banktypes.SpentCoins),
+		authenticator.NewAllOf(registry),
+	)
+
+	return registry, cdc
+}
+
+// newContext returns a context and the store of the state of an authenticator.
+func newContext() (sdk.Context, sdk.KVStore) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+
+	return ctx, ctx.KVStore(key)
+}
+
+func TestRegistry(t *testing.T) {
+	registry, _ := newRegistry()
+
+	require.Equal(t, []string{"AllOf", "MessageFilter", "SignatureKey", "SpendLimit", "TimeWindow"}, registry.Types())
+	require.Panics(t, func() { registry.Register(authenticator.NewTimeWindow()) })
+
+	_, ok := registry.Get("unknown")
+	require.False(t, ok)
+	_, err := registry.Initialize("unknown", nil)
+	require.Error(t, err)
+}
+
+func TestSignatureKey(t *testing.T) {
+	registry, cdc := newRegistry()
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	config, err := cdc.MarshalInterfaceJSON(pubKey)
+	require.NoError(t, err)
+
+	_, err = registry.Initialize(authenticator.SignatureKeyType, []byte("{}"))
+	require.Error(t, err)
+
+	sk, err := registry.Initialize(authenticator.SignatureKeyType, config)
+	require.NoError(t, err)
+
+	ctx, _ := newContext()
+	require.NoError(t, sk.Authenticate(ctx, authenticator.Request{PubKey: pubKey}))
+	require.Error(t, sk.Authenticate(ctx, authenticator.Request{PubKey: secp256k1.GenPrivKey().PubKey()}))
+	require.Error(t, sk.Authenticate(ctx, authenticator.Request{}))
+}
+
+func TestSignatureKeys(t *testing.T) {
+	registry, cdc := newRegistry()
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	pubKeyJSON, err := cdc.MarshalInterfaceJSON(pubKey)
+	require.NoError(t, err)
+
+	sk, err := registry.Initialize(authenticator.SignatureKeyType, pubKeyJSON)
+	require.NoError(t, err)
+	require.Equal(t, []cryptotypes.PubKey{pubKey}, authenticator.SignatureKeys(sk))
+
+	ao, err := registry.Initialize(authenticator.AllOfType, []byte(fmt.Sprintf(`[
+		{"type":"MessageFilter","config":["/cosmos.bank.v1beta1.MsgSend"]},
+		{"type":"AllOf","config":[{"type":"SignatureKey","config":%s}]}
+	]`, pubKeyJSON)))
+	require.NoError(t, err)
+	require.Equal(t, []cryptotypes.PubKey{pubKey}, authenticator.SignatureKeys(ao))
+
+	mf, err := registry.Initialize(authenticator.MessageFilterType, []byte(`["/cosmos.bank.v1beta1.MsgSend"]`))
+	require.NoError(t, err)
+	require.Empty(t, authenticator.SignatureKeys(mf))
+}
+
+func TestMessageFilter(t *testing.T) {
+	registry, _ := newRegistry()
+	_, _, addr := testdata.KeyTestPubAddr()
+	send := banktypes.NewMsgSend(addr, addr, nil)
+
+	for _, config := range []string{"", "[]", `{"type_urls":[]}`} {
+		_, err := registry.Initialize(authenticator.MessageFilterType, []byte(config))
+		require.Error(t, err, config)
+	}
+
+	mf, err := registry.Initialize(authenticator.MessageFilterType, []byte(`["/cosmos.bank.v1beta1.MsgSend"]`))
+	require.NoError(t, err)
+
+	ctx, _ := newContext()
+	require.NoError(t, mf.Authenticate(ctx, authenticator.Request{Msgs: []sdk.Msg{send, send}}))
+	require.Error(t, mf.Authenticate(ctx, authenticator.Request{Msgs: []sdk.Msg{send, testdata.NewTestMsg(addr)}}))
+
+	// the messages nested in a MsgExec are filtered, as well as the MsgExec
+	vote := &testdata.TestMsg{Signers: []string{addr.String()}}
+	exec := authz.NewMsgExec(addr, []sdk.Msg{vote})
+	require.Error(t, mf.Authenticate(ctx, authenticator.Request{Msgs: []sdk.Msg{&exec}}))
+
+	mf, err = registry.Initialize(authenticator.MessageFilterType, []byte(`["/cosmos.authz.v1beta1.MsgExec","/cosmos.bank.v1beta1.MsgSend"]`))
+	require.NoError(t, err)
+	require.Error(t, mf.Authenticate(ctx, authenticator.Request{Msgs: []sdk.Msg{&exec}}))
+	exec = authz.NewMsgExec(addr, []sdk.Msg{send})
+	require.NoError(t, mf.Authenticate(ctx, authenticator.Request{Msgs: []sdk.Msg{&exec}}))
+	nestedExec := authz.NewMsgExec(addr, []sdk.Msg{&exec, vote})
+	require.Error(t, mf.Authenticate(ctx, authenticator.Request{Msgs: []sdk.Msg{&nestedExec}}))
+}
+
+func TestTimeWindow(t *testing.T) {
+	registry, _ := newRegistry()
+
+	for _, config := range []string{"", "{}", `{"not_before":"2022-01-02T00:00:00Z","not_after":"2022-01-01T00:00:00Z"}`} {
+		_, err := registry.Initialize(authenticator.TimeWindowType, []byte(config))
+		require.Error(t, err, config)
+	}
+
+	testCases := []struct {
+		config    string
+		blockTime string
+		expErr    bool
+	}{
+		{`{"not_before":"2022-01-01T00:00:00Z"}`, "2021-12-31T23:59:59Z", true},
+		{`{"not_before":"2022-01-01T00:00:00Z"}`, "2022-01-01T00:00:00Z", false},
+		{`{"not_after":"2022-01-01T00:00:00Z"}`, "2022-01-01T00:00:00Z", false},
+		{`{"not_after":"2022-01-01T00:00:00Z"}`, "2022-01-01T00:00:01Z", true},
+		{`{"not_before":"2022-01-01T00:00:00Z","not_after":"2022-01-02T00:00:00Z"}`, "2022-01-01T12:00:00Z", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s at %s", tc.config, tc.blockTime), func(t *testing.T) {
+			tw, err := registry.Initialize(authenticator.TimeWindowType, []byte(tc.config))
+			require.NoError(t, err)
+
+			blockTime, err := time.Parse(time.RFC3339, tc.blockTime)
+			require.NoError(t, err)
+
+			ctx, _ := newContext()
+			err = tw.Authenticate(ctx.WithBlockTime(blockTime), authenticator.Request{})
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSpendLimit(t *testing.T) {
+	registry, _ := newRegistry()
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	for _, config := range []string{"", "{}", `{"limit":[]}`, `{"limit":[{"denom":"stake","amount":"-1"}]}`, `{"limit":[{"denom":"stake","amount":"10"}],"period":"-1h"}`} {
+		_, err := registry.Initialize(authenticator.SpendLimitType, []byte(config))
+		require.Error(t, err, config)
+	}
+
+	sl, err := registry.Initialize(authenticator.SpendLimitType, []byte(`{"limit":[{"denom":"stake","amount":"100"}],"period":"24h"}`))
+	require.NoError(t, err)
+
+	ctx, store := newContext()
+	ctx = ctx.WithBlockTime(time.Unix(0, 0))
+	req := func(fee sdk.Coins, msgs ...sdk.Msg) authenticator.Request {
+		return authenticator.Request{Account: addr, Msgs: msgs, Fee: fee, Store: store}
+	}
+
+	// the fees and the coins sent by the account are spent
+	require.NoError(t, sl.Authenticate(ctx, req(coins(10), banktypes.NewMsgSend(addr, other, coins(50)))))
+	require.Equal(t, coins(60).String(), string(store.Get(authenticator.SpendLimitSpentKey)))
+	// the coins sent by other accounts are not
+	require.NoError(t, sl.Authenticate(ctx, req(nil, banktypes.NewMsgSend(other, addr, coins(100)))))
+	// the limit applies to the whole period
+	require.Error(t, sl.Authenticate(ctx, req(nil, banktypes.NewMsgSend(addr, other, coins(41)))))
+	require.NoError(t, sl.Authenticate(ctx, req(nil, banktypes.NewMsgSend(addr, other, coins(40)))))
+	// only the denoms of the limit can be spent
+	require.Error(t, sl.Authenticate(ctx, req(sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))))
+	// the coins spent in unknown messages can't be determined
+	require.Error(t, sl.Authenticate(ctx, req(nil, testdata.NewTestMsg(addr))))
+
+	// the limit is reset for the next period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.NoError(t, sl.Authenticate(ctx, req(nil, banktypes.NewMsgSend(addr, other, coins(100)))))
+	require.Error(t, sl.Authenticate(ctx, req(coins(1))))
+}
+
+func TestAllOf(t *testing.T) {
+	registry, cdc := newRegistry()
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	pubKeyJSON, err := cdc.MarshalInterfaceJSON(pubKey)
+	require.NoError(t, err)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	for _, config := range []string{"", "[]", `[{"type":"unknown","config":{}}]`, `[{"type":"MessageFilter","config":[]}]`} {
+		_, err := registry.Initialize(authenticator.AllOfType, []byte(config))
+		require.Error(t, err, config)
+	}
+
+	config := fmt.Sprintf(`[
+		{"type":"SignatureKey","config":%s},
+		{"type":"MessageFilter","config":["/cosmos.bank.v1beta1.MsgSend"]},
+		{"type":"SpendLimit","config":{"limit":[{"denom":"stake","amount":"100"}]}}
+	]`, pubKeyJSON)
+	ao, err := registry.Initialize(authenticator.AllOfType, []byte(config))
+	require.NoError(t, err)
+
+	ctx, store := newContext()
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	req := authenticator.Request{Account: addr, PubKey: pubKey, Msgs: []sdk.Msg{send}, Store: store}
+
+	require.NoError(t, ao.Authenticate(ctx, req))
+	// the state of the spend limit is kept under its index
+	require.NotNil(t, store.Get(append(sdk.Uint64ToBigEndian(2), authenticator.SpendLimitSpentKey...)))
+	require.Error(t, ao.Authenticate(ctx, req))
+
+	// each authenticator must authenticate the tx
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	require.Error(t, ao.Authenticate(ctx, authenticator.Request{Account: addr, PubKey: secp256k1.GenPrivKey().PubKey(), Msgs: []sdk.Msg{send}, Store: store}))
+	require.Error(t, ao.Authenticate(ctx, authenticator.Request{Account: addr, PubKey: pubKey, Msgs: []sdk.Msg{send, testdata.NewTestMsg(addr)}, Store: store}))
+	require.NoError(t, ao.Authenticate(ctx, authenticator.Request{Account: addr, PubKey: pubKey, Msgs: []sdk.Msg{send}, Store: store}))
+}
@@ -0,0 +1,84 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MessageFilterType is the type of the MessageFilter authenticator.
+const MessageFilterType = "MessageFilter"
+
+var _ Authenticator = MessageFilter{}
+
+// MessageFilter authenticates the txs whose messages all have one of the
+// allowed type URLs. Its config is the JSON list of the type URLs, e.g.
+//
+//	["/cosmos.bank.v1beta1.MsgSend","/cosmos.gov.v1beta1.MsgVote"]
+//
+// The messages nested in other messages, such as in an authz MsgExec, must be
+// allowed as well as the message nesting them.
+type MessageFilter struct {
+	typeURLs map[string]bool
+}
+
+// NewMessageFilter returns the MessageFilter authenticator type.
+func NewMessageFilter() MessageFilter {
+	return MessageFilter{}
+}
+
+func (MessageFilter) Type() string {
+	return MessageFilterType
+}
+
+func (MessageFilter) Initialize(config []byte) (Authenticator, error) {
+	var typeURLs []string
+	if err := json.Unmarshal(config, &typeURLs); err != nil {
+		return nil, fmt.Errorf("invalid message filter: %w", err)
+	}
+	if len(typeURLs) == 0 {
+		return nil, errors.New("message filter allows no message")
+	}
+
+	mf := MessageFilter{typeURLs: make(map[string]bool, len(typeURLs))}
+	for _, typeURL := range typeURLs {
+		mf.typeURLs[typeURL] = true
+	}
+
+	return mf, nil
+}
+
+func (mf MessageFilter) Authenticate(_ sdk.Context, req Request) error {
+	return mf.filter(req.Msgs)
+}
+
+// nestedMsgs is implemented by the messages nesting other messages, e.g. the
+// authz MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// filter returns an error if one of msgs, or of the messages nested in them, is
+// not allowed.
+func (mf MessageFilter) filter(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if !mf.typeURLs[typeURL] {
+			return fmt.Errorf("message %s not allowed", typeURL)
+		}
+
+		if nested, ok := msg.(nestedMsgs); ok {
+			nestedMsgs, err := nested.GetMessages()
+			if err != nil {
+				return fmt.Errorf("message %s: %w", typeURL, err)
+			}
+			if err := mf.filter(nestedMsgs); err != nil {
+				return fmt.Errorf("message %s: %w", typeURL, err)
+			}
+		}
+	}
+
+	return nil
+}
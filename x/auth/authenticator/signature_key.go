@@ -0,0 +1,50 @@
+package authenticator
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignatureKeyType is the type of the SignatureKey authenticator.
+const SignatureKeyType = "SignatureKey"
+
+var _ Authenticator = SignatureKey{}
+
+// SignatureKey authenticates the txs signed by a key. Its config is the JSON of
+// the key, e.g.
+//
+//	{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8oW..."}
+type SignatureKey struct {
+	cdc    codec.JSONCodec
+	pubKey cryptotypes.PubKey
+}
+
+// NewSignatureKey returns the SignatureKey authenticator type, decoding the
+// keys with cdc.
+func NewSignatureKey(cdc codec.JSONCodec) SignatureKey {
+	return SignatureKey{cdc: cdc}
+}
+
+func (SignatureKey) Type() string {
+	return SignatureKeyType
+}
+
+func (sk SignatureKey) Initialize(config []byte) (Authenticator, error) {
+	var pubKey cryptotypes.PubKey
+	if err := sk.cdc.UnmarshalInterfaceJSON(config, &pubKey); err != nil {
+		return nil, fmt.Errorf("invalid signature key: %w", err)
+	}
+
+	return SignatureKey{cdc: sk.cdc, pubKey: pubKey}, nil
+}
+
+func (sk SignatureKey) Authenticate(_ sdk.Context, req Request) error {
+	if req.PubKey == nil || !sk.pubKey.Equals(req.PubKey) {
+		return fmt.Errorf("tx not signed by %s", sk.pubKey)
+	}
+
+	return nil
+}
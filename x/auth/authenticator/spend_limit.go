@@ -0,0 +1,128 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SpendLimitType is the type of the SpendLimit authenticator.
+const SpendLimitType = "SpendLimit"
+
+var (
+	// SpendLimitPeriodStartKey is the key of the start of the current period
+	// in the state of a SpendLimit.
+	SpendLimitPeriodStartKey = []byte("period_start")
+	// SpendLimitSpentKey is the key of the coins spent in the current period
+	// in the state of a SpendLimit.
+	SpendLimitSpentKey = []byte("spent")
+)
+
+// SpentCoinsFn returns the coins spent by spender in msg, or false if they
+// can't be determined.
+type SpentCoinsFn func(msg sdk.Msg, spender sdk.AccAddress) (sdk.Coins, bool)
+
+var _ Authenticator = SpendLimit{}
+
+// SpendLimit authenticates the txs as long as the coins spent by the account,
+// including the fees it pays, don't exceed a limit. The limit applies over the
+// lifetime of the authenticator, or is reset at each period when a period is
+// set. Its config is the JSON of the limit and the optional period, e.g.
+//
+//	{"limit":[{"denom":"stake","amount":"1000"}],"period":"24h"}
+//
+// The txs having a message whose spent coins can't be determined are not
+// authenticated.
+type SpendLimit struct {
+	spentCoins SpentCoinsFn
+	limit      sdk.Coins
+	period     time.Duration
+}
+
+// NewSpendLimit returns the SpendLimit authenticator type, determining the
+// coins spent in the messages with spentCoins.
+func NewSpendLimit(spentCoins SpentCoinsFn) SpendLimit {
+	return SpendLimit{spentCoins: spentCoins}
+}
+
+func (SpendLimit) Type() string {
+	return SpendLimitType
+}
+
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	var cfg struct {
+		Limit  sdk.Coins `json:"limit"`
+		Period string    `json:"period,omitempty"`
+	}
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid spend limit: %w", err)
+	}
+	if cfg.Limit.Empty() || !cfg.Limit.IsValid() {
+		return nil, fmt.Errorf("invalid spend limit %s", cfg.Limit)
+	}
+
+	var period time.Duration
+	if cfg.Period != "" {
+		var err error
+		if period, err = time.ParseDuration(cfg.Period); err != nil {
+			return nil, fmt.Errorf("invalid spend limit period: %w", err)
+		}
+		if period <= 0 {
+			return nil, errors.New("spend limit period must be positive")
+		}
+	}
+
+	return SpendLimit{spentCoins: sl.spentCoins, limit: cfg.Limit, period: period}, nil
+}
+
+func (sl SpendLimit) Authenticate(ctx sdk.Context, req Request) error {
+	spent := req.Fee
+	for _, msg := range req.Msgs {
+		coins, ok := sl.spentCoins(msg, req.Account)
+		if !ok {
+			return fmt.Errorf("coins spent in message %s unknown", sdk.MsgTypeURL(msg))
+		}
+		spent = spent.Add(coins...)
+	}
+
+	periodStart, total, err := sl.state(ctx, req.Store)
+	if err != nil {
+		return err
+	}
+	total = total.Add(spent...)
+	if !sl.limit.IsAllGTE(total) {
+		return fmt.Errorf("spend limit %s exceeded by %s", sl.limit, total)
+	}
+
+	req.Store.Set(SpendLimitPeriodStartKey, sdk.FormatTimeBytes(periodStart))
+	req.Store.Set(SpendLimitSpentKey, []byte(total.String()))
+
+	return nil
+}
+
+// state returns the start of the current period and the coins spent since
+// then, starting a new period if the previous one is over.
+func (sl SpendLimit) state(ctx sdk.Context, store sdk.KVStore) (time.Time, sdk.Coins, error) {
+	bz := store.Get(SpendLimitPeriodStartKey)
+	if bz == nil {
+		return ctx.BlockTime(), sdk.NewCoins(), nil
+	}
+
+	periodStart, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if sl.period > 0 && !ctx.BlockTime().Before(periodStart.Add(sl.period)) {
+		return ctx.BlockTime(), sdk.NewCoins(), nil
+	}
+
+	spent, err := sdk.ParseCoinsNormalized(string(store.Get(SpendLimitSpentKey)))
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return periodStart, spent, nil
+}
@@ -0,0 +1,64 @@
+package authenticator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TimeWindowType is the type of the TimeWindow authenticator.
+const TimeWindowType = "TimeWindow"
+
+var _ Authenticator = TimeWindow{}
+
+// TimeWindow authenticates the txs included in blocks whose time is in a
+// window. Its config is the JSON of the RFC 3339 bounds of the window, either
+// of which can be omitted, e.g.
+//
+//	{"not_before":"2022-01-01T00:00:00Z","not_after":"2022-01-02T00:00:00Z"}
+type TimeWindow struct {
+	notBefore *time.Time
+	notAfter  *time.Time
+}
+
+// NewTimeWindow returns the TimeWindow authenticator type.
+func NewTimeWindow() TimeWindow {
+	return TimeWindow{}
+}
+
+func (TimeWindow) Type() string {
+	return TimeWindowType
+}
+
+func (TimeWindow) Initialize(config []byte) (Authenticator, error) {
+	var cfg struct {
+		NotBefore *time.Time `json:"not_before,omitempty"`
+		NotAfter  *time.Time `json:"not_after,omitempty"`
+	}
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid time window: %w", err)
+	}
+	if cfg.NotBefore == nil && cfg.NotAfter == nil {
+		return nil, errors.New("time window has no bound")
+	}
+	if cfg.NotBefore != nil && cfg.NotAfter != nil && cfg.NotAfter.Before(*cfg.NotBefore) {
+		return nil, errors.New("time window ends before it starts")
+	}
+
+	return TimeWindow{notBefore: cfg.NotBefore, notAfter: cfg.NotAfter}, nil
+}
+
+func (tw TimeWindow) Authenticate(ctx sdk.Context, _ Request) error {
+	blockTime := ctx.BlockTime()
+	if tw.notBefore != nil && blockTime.Before(*tw.notBefore) {
+		return fmt.Errorf("time window starts at %s", tw.notBefore.Format(time.RFC3339))
+	}
+	if tw.notAfter != nil && blockTime.After(*tw.notAfter) {
+		return fmt.Errorf("time window ended at %s", tw.notAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,483 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SigningBundleVersion is the version of the signing bundle format written by
+// this package.
+const SigningBundleVersion = 1
+
+// bundleSignMode is the sign mode of the signatures collected in a bundle. The
+// amino JSON sign bytes don't depend on the other signers, which lets the
+// signers of a transaction and the members of a multisig sign independently.
+const bundleSignMode = signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// SigningBundle gathers everything needed to sign a transaction offline along
+// with the signatures collected so far, so that it can be passed from one
+// co-signer to the next, in the manner of Bitcoin's PSBT. The signatures of
+// bundles of the same transaction can be merged, and once enough signatures
+// are collected, the bundle is finalized into a signed transaction.
+type SigningBundle struct {
+	Version uint32 `json:"version"`
+	ChainID string `json:"chain_id"`
+	// Tx is the unsigned transaction in the JSON encoding of the TxConfig.
+	Tx json.RawMessage `json:"tx"`
+	// Signers holds the signers of the transaction, in the order of
+	// tx.GetSigners().
+	Signers []*BundleSigner `json:"signers"`
+}
+
+// BundleSigner describes a signer of the transaction of a SigningBundle, which
+// is either a single key or a multisig, and the signatures collected for it.
+type BundleSigner struct {
+	Address string `json:"address"`
+	// PubKey is the public key of the signer in the JSON encoding of an Any.
+	PubKey        json.RawMessage `json:"pub_key"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	// Signatures holds the signatures of the signer's key, or of the members
+	// of its multisig.
+	Signatures []*PartialSignature `json:"signatures"`
+}
+
+// PartialSignature is a signature of the sign bytes of a bundle's transaction
+// for one of its signers.
+type PartialSignature struct {
+	// PubKey is the public key of the signature in the JSON encoding of an Any.
+	PubKey    json.RawMessage `json:"pub_key"`
+	Signature []byte          `json:"signature"`
+}
+
+// NewSigningBundle returns a bundle of the given unsigned transaction for the
+// given signers, whose public keys, account numbers and sequences are given in
+// the order of tx.GetSigners().
+func NewSigningBundle(
+	clientCtx client.Context, tx sdk.Tx, pubKeys []cryptotypes.PubKey, accountNumbers, sequences []uint64,
+) (*SigningBundle, error) {
+	if clientCtx.ChainID == "" {
+		return nil, fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+	}
+
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "expected a tx implementing SigVerifiableTx, got %T", tx)
+	}
+
+	signers := sigTx.GetSigners()
+
+	if len(pubKeys) != len(signers) || len(accountNumbers) != len(signers) || len(sequences) != len(signers) {
+		return nil, fmt.Errorf("expected the public key, account number and sequence of %d signers", len(signers))
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signatures are collected in the bundle
+	if err := txBuilder.SetSignatures(); err != nil {
+		return nil, err
+	}
+
+	txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &SigningBundle{
+		Version: SigningBundleVersion,
+		ChainID: clientCtx.ChainID,
+		Tx:      txJSON,
+		Signers: make([]*BundleSigner, len(signers)),
+	}
+
+	for i, signer := range signers {
+		if !bytes.Equal(pubKeys[i].Address(), signer) {
+			return nil, fmt.Errorf("public key %s is not the key of signer %s", pubKeys[i], signer)
+		}
+
+		pubKeyJSON, err := clientCtx.Codec.MarshalInterfaceJSON(pubKeys[i])
+		if err != nil {
+			return nil, err
+		}
+
+		bundle.Signers[i] = &BundleSigner{
+			Address:       signer.String(),
+			PubKey:        pubKeyJSON,
+			AccountNumber: accountNumbers[i],
+			Sequence:      sequences[i],
+			Signatures:    []*PartialSignature{},
+		}
+	}
+
+	return bundle, nil
+}
+
+// ReadSigningBundleFromFile reads a signing bundle from the given file.
+func ReadSigningBundleFromFile(filename string) (*SigningBundle, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := new(SigningBundle)
+	if err := json.Unmarshal(bz, bundle); err != nil {
+		return nil, fmt.Errorf("failed to decode signing bundle %s: %w", filename, err)
+	}
+
+	if bundle.Version != SigningBundleVersion {
+		return nil, fmt.Errorf("unsupported signing bundle version %d", bundle.Version)
+	}
+
+	return bundle, nil
+}
+
+// GetTx decodes the unsigned transaction of the bundle.
+func (b *SigningBundle) GetTx(txConfig client.TxConfig) (signing.Tx, error) {
+	tx, err := txConfig.TxJSONDecoder()(b.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sigTx, ok := tx.(signing.Tx)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "expected a tx implementing signing.Tx, got %T", tx)
+	}
+
+	return sigTx, nil
+}
+
+// GetPubKey decodes the public key of the signer.
+func (s *BundleSigner) GetPubKey(cdc codec.Codec) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := cdc.UnmarshalInterfaceJSON(s.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+// GetPubKey decodes the public key of the signature.
+func (s *PartialSignature) GetPubKey(cdc codec.Codec) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := cdc.UnmarshalInterfaceJSON(s.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+// Keys returns the keys allowed to sign for the signer: the members of its
+// multisig, or its own key. It also returns the number of signatures required,
+// or the sum of the weights of the signers for a weighted multisig.
+func (s *BundleSigner) Keys(cdc codec.Codec) (keys []cryptotypes.PubKey, threshold int, err error) {
+	pubKey, err := s.GetPubKey(cdc)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if multisigPubKey, ok := pubKey.(multisig.PubKey); ok {
+		return multisigPubKey.GetPubKeys(), int(multisigPubKey.GetThreshold()), nil
+	}
+
+	return []cryptotypes.PubKey{pubKey}, 1, nil
+}
+
+// signerData returns the data the signatures of the signer sign over.
+func (b *SigningBundle) signerData(signer *BundleSigner) signing.SignerData {
+	return signing.SignerData{
+		ChainID:       b.ChainID,
+		AccountNumber: signer.AccountNumber,
+		Sequence:      signer.Sequence,
+	}
+}
+
+// SignBytes returns the bytes signed by the keys of the signer.
+func (b *SigningBundle) SignBytes(txConfig client.TxConfig, signer *BundleSigner) ([]byte, error) {
+	tx, err := b.GetTx(txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return txConfig.SignModeHandler().GetSignBytes(bundleSignMode, b.signerData(signer), tx)
+}
+
+// AddSignature verifies the signature of the sign bytes of the signer with the
+// given key, which must be the key of the signer or of a member of its
+// multisig, and adds it to the bundle unless it is already there.
+func (b *SigningBundle) AddSignature(
+	clientCtx client.Context, signer *BundleSigner, pubKey cryptotypes.PubKey, sig []byte,
+) error {
+	keys, _, err := signer.Keys(clientCtx.Codec)
+	if err != nil {
+		return err
+	}
+
+	if indexOfKey(keys, pubKey) < 0 {
+		return fmt.Errorf("key %s cannot sign for %s", sdk.AccAddress(pubKey.Address()), signer.Address)
+	}
+
+	signBytes, err := b.SignBytes(clientCtx.TxConfig, signer)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(signBytes, sig) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid signature of %s for %s", sdk.AccAddress(pubKey.Address()), signer.Address)
+	}
+
+	for _, partialSig := range signer.Signatures {
+		existing, err := partialSig.GetPubKey(clientCtx.Codec)
+		if err != nil {
+			return err
+		}
+
+		if existing.Equals(pubKey) {
+			return nil
+		}
+	}
+
+	pubKeyJSON, err := clientCtx.Codec.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return err
+	}
+
+	signer.Signatures = append(signer.Signatures, &PartialSignature{PubKey: pubKeyJSON, Signature: sig})
+	return nil
+}
+
+// Merge adds the signatures of another bundle of the same transaction.
+func (b *SigningBundle) Merge(clientCtx client.Context, other *SigningBundle) error {
+	if b.ChainID != other.ChainID || len(b.Signers) != len(other.Signers) {
+		return fmt.Errorf("cannot merge signing bundles of different transactions")
+	}
+
+	tx, err := b.GetTx(clientCtx.TxConfig)
+	if err != nil {
+		return err
+	}
+
+	otherTx, err := other.GetTx(clientCtx.TxConfig)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	otherTxBytes, err := clientCtx.TxConfig.TxEncoder()(otherTx)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(txBytes, otherTxBytes) {
+		return fmt.Errorf("cannot merge signing bundles of different transactions")
+	}
+
+	for i, signer := range b.Signers {
+		otherSigner := other.Signers[i]
+		if signer.Address != otherSigner.Address || signer.AccountNumber != otherSigner.AccountNumber ||
+			signer.Sequence != otherSigner.Sequence {
+			return fmt.Errorf("cannot merge signing bundles with different data for signer %s", signer.Address)
+		}
+
+		for _, partialSig := range otherSigner.Signatures {
+			pubKey, err := partialSig.GetPubKey(clientCtx.Codec)
+			if err != nil {
+				return err
+			}
+
+			if err := b.AddSignature(clientCtx, signer, pubKey, partialSig.Signature); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MissingSignatures returns the number of signatures still required for each
+// signer of the bundle.
+func (b *SigningBundle) MissingSignatures(cdc codec.Codec) ([]int, error) {
+	missing := make([]int, len(b.Signers))
+	for i, signer := range b.Signers {
+		pubKey, err := signer.GetPubKey(cdc)
+		if err != nil {
+			return nil, err
+		}
+
+		if weighted, ok := pubKey.(multisig.WeightedPubKey); ok {
+			if missing[i], err = missingWeightedSignatures(cdc, weighted, signer.Signatures); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		_, threshold, err := signer.Keys(cdc)
+		if err != nil {
+			return nil, err
+		}
+
+		if n := threshold - len(signer.Signatures); n > 0 {
+			missing[i] = n
+		}
+	}
+
+	return missing, nil
+}
+
+// missingWeightedSignatures returns the minimum number of signatures of the
+// members of a weighted multisig still required to reach its threshold, taking
+// the heaviest members which haven't signed first.
+func missingWeightedSignatures(cdc codec.Codec, pubKey multisig.WeightedPubKey, sigs []*PartialSignature) (int, error) {
+	keys, weights := pubKey.GetPubKeys(), pubKey.GetWeights()
+	signed := make([]bool, len(keys))
+	for _, partialSig := range sigs {
+		memberPubKey, err := partialSig.GetPubKey(cdc)
+		if err != nil {
+			return 0, err
+		}
+
+		if i := indexOfKey(keys, memberPubKey); i >= 0 {
+			signed[i] = true
+		}
+	}
+
+	var (
+		weight    uint64
+		remaining []uint32
+	)
+	for i, w := range weights {
+		if signed[i] {
+			weight += uint64(w)
+		} else {
+			remaining = append(remaining, w)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i] > remaining[j] })
+
+	missing := 0
+	for ; weight < uint64(pubKey.GetThreshold()) && missing < len(remaining); missing++ {
+		weight += uint64(remaining[missing])
+	}
+
+	return missing, nil
+}
+
+// Finalize combines the signatures collected for each signer into the
+// signatures of the transaction and returns it, failing if any signer lacks
+// signatures.
+func (b *SigningBundle) Finalize(clientCtx client.Context) (sdk.Tx, error) {
+	missing, err := b.MissingSignatures(clientCtx.Codec)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, n := range missing {
+		if n > 0 {
+			return nil, fmt.Errorf("signer %s is missing %d signature(s)", b.Signers[i].Address, n)
+		}
+	}
+
+	tx, err := b.GetTx(clientCtx.TxConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]signingtypes.SignatureV2, len(b.Signers))
+	for i, signer := range b.Signers {
+		sigs[i], err = b.signatureOf(clientCtx.Codec, signer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	signedTx := txBuilder.GetTx()
+	for i, signer := range b.Signers {
+		err := signing.VerifySignature(
+			sigs[i].PubKey, b.signerData(signer), sigs[i].Data, clientCtx.TxConfig.SignModeHandler(), signedTx,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't verify signature for address %s: %w", signer.Address, err)
+		}
+	}
+
+	return signedTx, nil
+}
+
+// signatureOf returns the transaction signature of the signer, combining the
+// signatures of the members of a multisig.
+func (b *SigningBundle) signatureOf(cdc codec.Codec, signer *BundleSigner) (signingtypes.SignatureV2, error) {
+	pubKey, err := signer.GetPubKey(cdc)
+	if err != nil {
+		return signingtypes.SignatureV2{}, err
+	}
+
+	multisigPubKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return signingtypes.SignatureV2{
+			PubKey:   pubKey,
+			Data:     &signingtypes.SingleSignatureData{SignMode: bundleSignMode, Signature: signer.Signatures[0].Signature},
+			Sequence: signer.Sequence,
+		}, nil
+	}
+
+	multisigSig := multisig.NewMultisig(len(multisigPubKey.GetPubKeys()))
+	for _, partialSig := range signer.Signatures {
+		memberPubKey, err := partialSig.GetPubKey(cdc)
+		if err != nil {
+			return signingtypes.SignatureV2{}, err
+		}
+
+		sig := signingtypes.SignatureV2{
+			PubKey:   memberPubKey,
+			Data:     &signingtypes.SingleSignatureData{SignMode: bundleSignMode, Signature: partialSig.Signature},
+			Sequence: signer.Sequence,
+		}
+		if err := multisig.AddSignatureV2(multisigSig, sig, multisigPubKey.GetPubKeys()); err != nil {
+			return signingtypes.SignatureV2{}, err
+		}
+	}
+	if aggregatedPubKey, ok := multisigPubKey.(multisig.AggregatedPubKey); ok {
+		if multisigSig, err = aggregatedPubKey.AggregateMultisignature(multisigSig); err != nil {
+			return signingtypes.SignatureV2{}, err
+		}
+	}
+
+	return signingtypes.SignatureV2{PubKey: multisigPubKey, Data: multisigSig, Sequence: signer.Sequence}, nil
+}
+
+func indexOfKey(keys []cryptotypes.PubKey, pubKey cryptotypes.PubKey) int {
+	for i, key := range keys {
+		if key.Equals(pubKey) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,254 @@
+package client_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type bundleFixture struct {
+	clientCtx client.Context
+	kb        keyring.Keyring
+	multisig  cryptotypes.PubKey
+	bundle    *authclient.SigningBundle
+}
+
+func newBundleFixture(t *testing.T) bundleFixture {
+	return newMultisigBundleFixture(t, hd.Secp256k1, func(_ keyring.Keyring, members []cryptotypes.PubKey) cryptotypes.PubKey {
+		return kmultisig.NewLegacyAminoPubKey(2, members)
+	})
+}
+
+// newMultisigBundleFixture returns a fixture whose signer is the multisig
+// built from the keys k1, k2 and k3 of the given algorithm
+func newMultisigBundleFixture(
+	t *testing.T, algo keyring.SignatureAlgo, newMultisig func(keyring.Keyring, []cryptotypes.PubKey) cryptotypes.PubKey,
+) bundleFixture {
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Marshaler).
+		WithLegacyAmino(encCfg.Amino).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithChainID("test-chain")
+
+	kb := keyring.NewInMemory()
+	var members []cryptotypes.PubKey
+	for _, name := range []string{"k1", "k2", "k3"} {
+		info, _, err := kb.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, algo)
+		require.NoError(t, err)
+		members = append(members, info.GetPubKey())
+	}
+	multisig := newMultisig(kb, members)
+
+	_, _, to := testdata.KeyTestPubAddr()
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(
+		sdk.AccAddress(multisig.Address()), to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	)))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetMemo("bundle")
+
+	bundle, err := authclient.NewSigningBundle(
+		clientCtx, txBuilder.GetTx(), []cryptotypes.PubKey{multisig}, []uint64{7}, []uint64{3},
+	)
+	require.NoError(t, err)
+
+	return bundleFixture{clientCtx: clientCtx, kb: kb, multisig: multisig, bundle: bundle}
+}
+
+// sign signs a copy of the bundle with the key of the given name
+func (f bundleFixture) sign(t *testing.T, name string) *authclient.SigningBundle {
+	bundle := f.copy(t)
+	signBytes, err := bundle.SignBytes(f.clientCtx.TxConfig, bundle.Signers[0])
+	require.NoError(t, err)
+
+	sig, pubKey, err := f.kb.Sign(name, signBytes)
+	require.NoError(t, err)
+	require.NoError(t, bundle.AddSignature(f.clientCtx, bundle.Signers[0], pubKey, sig))
+
+	return bundle
+}
+
+func (f bundleFixture) copy(t *testing.T) *authclient.SigningBundle {
+	bz, err := json.Marshal(f.bundle)
+	require.NoError(t, err)
+
+	bundle := new(authclient.SigningBundle)
+	require.NoError(t, json.Unmarshal(bz, bundle))
+	return bundle
+}
+
+func TestSigningBundle(t *testing.T) {
+	f := newBundleFixture(t)
+
+	missing, err := f.bundle.MissingSignatures(f.clientCtx.Codec)
+	require.NoError(t, err)
+	require.Equal(t, []int{2}, missing)
+
+	// the co-signers sign in parallel
+	bundle1 := f.sign(t, "k1")
+	bundle3 := f.sign(t, "k3")
+
+	_, err = bundle1.Finalize(f.clientCtx)
+	require.EqualError(t, err, "signer "+f.bundle.Signers[0].Address+" is missing 1 signature(s)")
+
+	require.NoError(t, bundle1.Merge(f.clientCtx, bundle3))
+	// merging again adds nothing
+	require.NoError(t, bundle1.Merge(f.clientCtx, bundle3))
+	require.Len(t, bundle1.Signers[0].Signatures, 2)
+
+	missing, err = bundle1.MissingSignatures(f.clientCtx.Codec)
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, missing)
+
+	signedTx, err := bundle1.Finalize(f.clientCtx)
+	require.NoError(t, err)
+
+	sigs, err := signedTx.(interface {
+		GetSignaturesV2() ([]signingtypes.SignatureV2, error)
+	}).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, f.multisig.Equals(sigs[0].PubKey))
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+
+	multisigData := sigs[0].Data.(*signingtypes.MultiSignatureData)
+	require.Len(t, multisigData.Signatures, 2)
+	require.True(t, multisigData.BitArray.GetIndex(0))
+	require.False(t, multisigData.BitArray.GetIndex(1))
+	require.True(t, multisigData.BitArray.GetIndex(2))
+}
+
+func TestSigningBundle_WeightedMultisig(t *testing.T) {
+	f := newMultisigBundleFixture(t, hd.Secp256k1, func(_ keyring.Keyring, members []cryptotypes.PubKey) cryptotypes.PubKey {
+		multisig, err := kmultisig.NewWeightedMultisigPubKey(4, members, []uint32{3, 1, 1})
+		require.NoError(t, err)
+		return multisig
+	})
+
+	// the heaviest key and any other one are enough
+	missing, err := f.bundle.MissingSignatures(f.clientCtx.Codec)
+	require.NoError(t, err)
+	require.Equal(t, []int{2}, missing)
+
+	bundle2 := f.sign(t, "k2")
+	missing, err = bundle2.MissingSignatures(f.clientCtx.Codec)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, missing)
+
+	require.NoError(t, bundle2.Merge(f.clientCtx, f.sign(t, "k3")))
+	missing, err = bundle2.MissingSignatures(f.clientCtx.Codec)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, missing)
+
+	require.NoError(t, bundle2.Merge(f.clientCtx, f.sign(t, "k1")))
+	missing, err = bundle2.MissingSignatures(f.clientCtx.Codec)
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, missing)
+
+	signedTx, err := bundle2.Finalize(f.clientCtx)
+	require.NoError(t, err)
+
+	sigs, err := signedTx.(interface {
+		GetSignaturesV2() ([]signingtypes.SignatureV2, error)
+	}).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, f.multisig.Equals(sigs[0].PubKey))
+	require.Len(t, sigs[0].Data.(*signingtypes.MultiSignatureData).Signatures, 3)
+}
+
+func TestSigningBundle_AggregatedMultisig(t *testing.T) {
+	f := newMultisigBundleFixture(t, hd.Bls12381, func(kb keyring.Keyring, members []cryptotypes.PubKey) cryptotypes.PubKey {
+		var (
+			pubKeys []*bls12381.PubKey
+			pops    [][]byte
+		)
+		for i, name := range []string{"k1", "k2", "k3"} {
+			privHex, err := keyring.NewUnsafe(kb).UnsafeExportPrivKeyHex(name)
+			require.NoError(t, err)
+			privBz, err := hex.DecodeString(privHex)
+			require.NoError(t, err)
+			pop, err := (&bls12381.PrivKey{Key: privBz}).ProofOfPossession()
+			require.NoError(t, err)
+
+			pubKeys = append(pubKeys, members[i].(*bls12381.PubKey))
+			pops = append(pops, pop)
+		}
+
+		multisig, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+		require.NoError(t, err)
+		return multisig
+	})
+
+	bundle := f.sign(t, "k1")
+	require.NoError(t, bundle.Merge(f.clientCtx, f.sign(t, "k3")))
+
+	signedTx, err := bundle.Finalize(f.clientCtx)
+	require.NoError(t, err)
+
+	// the signatures of the members are aggregated into one
+	sigs, err := signedTx.(interface {
+		GetSignaturesV2() ([]signingtypes.SignatureV2, error)
+	}).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	multisigData := sigs[0].Data.(*signingtypes.MultiSignatureData)
+	require.Len(t, multisigData.Signatures, 1)
+	require.True(t, multisigData.BitArray.GetIndex(0))
+	require.False(t, multisigData.BitArray.GetIndex(1))
+	require.True(t, multisigData.BitArray.GetIndex(2))
+
+	signBytes, err := bundle.SignBytes(f.clientCtx.TxConfig, bundle.Signers[0])
+	require.NoError(t, err)
+	require.NoError(t, f.multisig.(*bls12381.MultisigPubKey).VerifyMultisignature(
+		func(signingtypes.SignMode) ([]byte, error) { return signBytes, nil }, multisigData,
+	))
+}
+
+func TestSigningBundle_InvalidSignatures(t *testing.T) {
+	f := newBundleFixture(t)
+	signer := f.bundle.Signers[0]
+
+	signBytes, err := f.bundle.SignBytes(f.clientCtx.TxConfig, signer)
+	require.NoError(t, err)
+
+	// a key which is not a member of the multisig
+	_, err = f.kb.NewAccount("other", testdata.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	sig, pubKey, err := f.kb.Sign("other", signBytes)
+	require.NoError(t, err)
+	require.Error(t, f.bundle.AddSignature(f.clientCtx, signer, pubKey, sig))
+
+	// a member signing other bytes
+	sig, pubKey, err = f.kb.Sign("k1", []byte("other bytes"))
+	require.NoError(t, err)
+	require.Error(t, f.bundle.AddSignature(f.clientCtx, signer, pubKey, sig))
+	require.Empty(t, signer.Signatures)
+}
+
+func TestSigningBundle_MergeDifferentTx(t *testing.T) {
+	f := newBundleFixture(t)
+
+	other := f.sign(t, "k1")
+	other.Signers[0].Sequence++
+
+	require.Error(t, f.bundle.Merge(f.clientCtx, other))
+	require.Empty(t, f.bundle.Signers[0].Signatures)
+}
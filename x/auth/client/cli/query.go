@@ -45,6 +45,8 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountsCmd(),
 		QueryParamsCmd(),
 		QueryModuleAccountByNameCmd(),
+		GetPubKeyHistoryCmd(),
+		GetAccountAuthenticatorsCmd(),
 	)
 
 	return cmd
@@ -145,6 +147,82 @@ func GetAccountsCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPubKeyHistoryCmd returns a query command that will display the pubkey
+// rotations of an account
+func GetPubKeyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pubkey-history [address]",
+		Short: "Query the pubkey rotations of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PubKeyHistory(cmd.Context(), &types.QueryPubKeyHistoryRequest{Address: addr.String(), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pubkey history")
+
+	return cmd
+}
+
+// GetAccountAuthenticatorsCmd returns a query command that will display the
+// authenticators of an account
+func GetAccountAuthenticatorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authenticators [address]",
+		Short: "Query the authenticators of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountAuthenticators(cmd.Context(), &types.QueryAccountAuthenticatorsRequest{Address: addr.String(), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "authenticators")
+
+	return cmd
+}
+
 // QueryModuleAccountByNameCmd returns a command to
 func QueryModuleAccountByNameCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetTxCmd returns the transaction commands for the auth module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRotatePubKeyCmd(),
+		NewAddAuthenticatorCmd(),
+		NewRemoveAuthenticatorCmd(),
+	)
+
+	return txCmd
+}
+
+// NewRotatePubKeyCmd returns a CLI command handler for creating a
+// MsgRotatePubKey transaction.
+func NewRotatePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-pubkey [new-key]",
+		Short: "Replace the pubkey of the --from account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the pubkey of the --from account, which must be signed by its current
+key. The new key must be a key of the keyring, which signs the rotation to prove
+that it is held. The account keeps its address, and must be signed by the new key
+from then on.
+
+Example:
+$ %s tx auth rotate-pubkey newkey --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr := clientCtx.GetFromAddress()
+			acc, err := clientCtx.AccountRetriever.GetAccount(clientCtx, addr)
+			if err != nil {
+				return err
+			}
+			if acc.GetPubKey() == nil {
+				return fmt.Errorf("account %s has no pubkey to rotate", addr)
+			}
+
+			signBytes := types.PubKeyRotationSignBytes(clientCtx.ChainID, addr, acc.GetPubKey())
+			sig, newPubKey, err := clientCtx.Keyring.Sign(args[0], signBytes)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotatePubKey(addr, newPubKey, &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+				Signature: sig,
+			})
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAddAuthenticatorCmd returns a CLI command handler for creating a
+// MsgAddAuthenticator transaction.
+func NewAddAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-authenticator [type] [config]",
+		Short: "Attach an authenticator to the --from account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attach an authenticator to the --from account, which must be signed by its
+pubkey. The txs of the account signed with another key are then authenticated by
+its authenticators. The format of the config depends on the type of the
+authenticator, e.g. to attach a session key which can only send coins:
+
+Example:
+$ %s tx auth add-authenticator AllOf '[{"type":"SignatureKey","config":{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}},{"type":"MessageFilter","config":["/cosmos.bank.v1beta1.MsgSend"]}]' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAuthenticator(clientCtx.GetFromAddress(), args[0], []byte(args[1]))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveAuthenticatorCmd returns a CLI command handler for creating a
+// MsgRemoveAuthenticator transaction.
+func NewRemoveAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-authenticator [id]",
+		Short:   "Remove an authenticator from the --from account",
+		Example: fmt.Sprintf("$ %s tx auth remove-authenticator 3 --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid authenticator id %s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgRemoveAuthenticator(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
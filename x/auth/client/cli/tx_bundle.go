@@ -0,0 +1,442 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// GetBundleCommand returns the command managing signing bundles, which gather
+// an unsigned transaction, the data of its signers and the signatures
+// collected so far.
+func GetBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Collect the signatures of a transaction, e.g. of a multisig, in a signing bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`A signing bundle holds a transaction generated offline, the public keys,
+account numbers and sequences of its signers, and the signatures collected so far.
+It is passed from one co-signer to the next, each signing it with a single command,
+and is finalized into a signed transaction once enough signatures are collected.
+
+Example:
+$ %[1]s tx bank send multi cosmos1... 10stake --generate-only > tx.json
+$ %[1]s tx bundle create tx.json --output-document bundle.json
+$ %[1]s tx bundle sign bundle.json --from k1
+$ %[1]s tx bundle sign bundle.json --from k2
+$ %[1]s tx bundle finalize bundle.json > signed.json
+$ %[1]s tx broadcast signed.json
+
+The signatures of bundles signed in parallel are combined with the merge command.
+`, version.AppName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetBundleCreateCommand(),
+		GetBundleInspectCommand(),
+		GetBundleSignCommand(),
+		GetBundleMergeCommand(),
+		GetBundleFinalizeCommand(),
+	)
+
+	return cmd
+}
+
+// GetBundleCreateCommand returns the command creating a signing bundle.
+func GetBundleCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file]",
+		Short: "Create a signing bundle of a transaction generated offline",
+		Long: `Create a signing bundle of the transaction read from [file], created with the
+--generate-only flag.
+
+The public key of each signer is looked up in the keyring, which must hold the
+multisig keys, or else on chain. The account numbers and sequences of the signers
+are queried, unless the --offline flag is set, in which case the transaction must
+have a single signer whose account number and sequence are set with the
+--account-number and --sequence flags.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeBundleCreateCmd(),
+		Args:   cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The bundle is written to the given file instead of STDOUT")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeBundleCreateCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		unsignedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		sigTx, ok := unsignedTx.(signing.SigVerifiableTx)
+		if !ok {
+			return fmt.Errorf("cannot create a signing bundle of %T", unsignedTx)
+		}
+
+		signers := sigTx.GetSigners()
+		if clientCtx.Offline && len(signers) != 1 {
+			return fmt.Errorf("the bundle of a tx with %d signers cannot be created offline", len(signers))
+		}
+
+		txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		pubKeys := make([]cryptotypes.PubKey, len(signers))
+		accountNumbers := make([]uint64, len(signers))
+		sequences := make([]uint64, len(signers))
+
+		for i, signer := range signers {
+			if info, err := clientCtx.Keyring.KeyByAddress(signer); err == nil {
+				pubKeys[i] = info.GetPubKey()
+			}
+
+			if clientCtx.Offline {
+				accountNumbers[i], sequences[i] = txFactory.AccountNumber(), txFactory.Sequence()
+			} else {
+				account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, signer)
+				if err != nil {
+					return err
+				}
+
+				accountNumbers[i], sequences[i] = account.GetAccountNumber(), account.GetSequence()
+				if pubKeys[i] == nil {
+					pubKeys[i] = account.GetPubKey()
+				}
+			}
+
+			if pubKeys[i] == nil {
+				return fmt.Errorf("the public key of signer %s is neither in the keyring nor on chain", signer)
+			}
+		}
+
+		bundle, err := authclient.NewSigningBundle(clientCtx, unsignedTx, pubKeys, accountNumbers, sequences)
+		if err != nil {
+			return err
+		}
+
+		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+		return writeBundle(cmd, bundle, outputDoc)
+	}
+}
+
+// bundleSummary is the output of the inspect command.
+type bundleSummary struct {
+	ChainID  string                `json:"chain_id" yaml:"chain_id"`
+	Tx       legacytx.StdTx        `json:"tx" yaml:"tx"`
+	Signers  []bundleSignerSummary `json:"signers" yaml:"signers"`
+	Complete bool                  `json:"complete" yaml:"complete"`
+}
+
+type bundleSignerSummary struct {
+	Address       string   `json:"address" yaml:"address"`
+	Threshold     int      `json:"threshold" yaml:"threshold"`
+	Keys          []string `json:"keys" yaml:"keys"`
+	AccountNumber uint64   `json:"account_number" yaml:"account_number"`
+	Sequence      uint64   `json:"sequence" yaml:"sequence"`
+	SignedBy      []string `json:"signed_by" yaml:"signed_by"`
+	Missing       int      `json:"missing" yaml:"missing"`
+}
+
+// GetBundleInspectCommand returns the command describing a signing bundle.
+func GetBundleInspectCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect [bundle]",
+		Short: "Describe the transaction of a signing bundle and the signatures collected so far",
+		Long: `Print the transaction of a signing bundle and, for each of its signers, the
+addresses of the keys allowed to sign for it, the number of signatures required,
+the addresses of the keys whose signatures were collected and the number of
+signatures still missing.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := authclient.ReadSigningBundleFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			unsignedTx, err := bundle.GetTx(clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := tx.ConvertTxToStdTx(clientCtx.LegacyAmino, unsignedTx)
+			if err != nil {
+				return err
+			}
+
+			missing, err := bundle.MissingSignatures(clientCtx.Codec)
+			if err != nil {
+				return err
+			}
+
+			summary := bundleSummary{ChainID: bundle.ChainID, Tx: stdTx, Complete: true}
+			for i, signer := range bundle.Signers {
+				keys, threshold, err := signer.Keys(clientCtx.Codec)
+				if err != nil {
+					return err
+				}
+
+				signerSummary := bundleSignerSummary{
+					Address:       signer.Address,
+					Threshold:     threshold,
+					AccountNumber: signer.AccountNumber,
+					Sequence:      signer.Sequence,
+					Missing:       missing[i],
+				}
+				for _, key := range keys {
+					signerSummary.Keys = append(signerSummary.Keys, sdk.AccAddress(key.Address()).String())
+				}
+				for _, sig := range signer.Signatures {
+					pubKey, err := sig.GetPubKey(clientCtx.Codec)
+					if err != nil {
+						return err
+					}
+
+					signerSummary.SignedBy = append(signerSummary.SignedBy, sdk.AccAddress(pubKey.Address()).String())
+				}
+
+				summary.Signers = append(summary.Signers, signerSummary)
+				summary.Complete = summary.Complete && missing[i] == 0
+			}
+
+			return clientCtx.PrintObjectLegacy(summary)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetBundleSignCommand returns the command adding a signature to a signing bundle.
+func GetBundleSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [bundle]",
+		Short: "Sign a signing bundle",
+		Long: `Sign a signing bundle with the key given with the --from flag, for each signer
+of its transaction the key can sign for, i.e. which is the key itself or a multisig
+the key is a member of. The bundle is updated in place, unless the
+--output-document flag is set.
+
+No query is performed: the account numbers and sequences come from the bundle.
+`,
+		RunE: makeBundleSignCmd(),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The bundle is written to the given file instead of [bundle]")
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func makeBundleSignCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		bundle, err := authclient.ReadSigningBundleFromFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		info, err := clientCtx.Keyring.Key(clientCtx.GetFromName())
+		if err != nil {
+			return fmt.Errorf("error getting account from keybase: %w", err)
+		}
+		if _, ok := info.GetPubKey().(multisig.PubKey); ok {
+			return fmt.Errorf("%s is a multisig key: sign with the keys of its members", info.GetName())
+		}
+
+		signed := false
+		for _, signer := range bundle.Signers {
+			keys, _, err := signer.Keys(clientCtx.Codec)
+			if err != nil {
+				return err
+			}
+
+			if !containsKey(keys, info.GetPubKey()) {
+				continue
+			}
+
+			signBytes, err := bundle.SignBytes(clientCtx.TxConfig, signer)
+			if err != nil {
+				return err
+			}
+
+			sig, pubKey, err := clientCtx.Keyring.Sign(info.GetName(), signBytes)
+			if err != nil {
+				return err
+			}
+
+			if err := bundle.AddSignature(clientCtx, signer, pubKey, sig); err != nil {
+				return err
+			}
+			signed = true
+		}
+
+		if !signed {
+			return fmt.Errorf("%s is not a signer of the transaction nor a member of a multisig signer", info.GetAddress())
+		}
+
+		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+		if outputDoc == "" {
+			outputDoc = args[0]
+		}
+
+		return writeBundle(cmd, bundle, outputDoc)
+	}
+}
+
+// GetBundleMergeCommand returns the command merging signing bundles.
+func GetBundleMergeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge [bundle] [bundle]...",
+		Short: "Merge the signatures of signing bundles of the same transaction",
+		Long: `Merge the signatures collected in signing bundles of the same transaction, e.g.
+signed in parallel by different co-signers, into a single bundle. Every signature is
+verified.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := authclient.ReadSigningBundleFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, filename := range args[1:] {
+				other, err := authclient.ReadSigningBundleFromFile(filename)
+				if err != nil {
+					return err
+				}
+
+				if err := bundle.Merge(clientCtx, other); err != nil {
+					return fmt.Errorf("failed to merge %s: %w", filename, err)
+				}
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			return writeBundle(cmd, bundle, outputDoc)
+		},
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The bundle is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// GetBundleFinalizeCommand returns the command turning a signing bundle into a
+// signed transaction.
+func GetBundleFinalizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [bundle]",
+		Short: "Turn a signing bundle with enough signatures into a signed transaction",
+		Long: `Combine the signatures collected in a signing bundle into the signatures of its
+transaction, e.g. into the multisig signature of a multisig signer, and print the
+signed transaction, ready to be broadcast. It fails if any signer lacks signatures.
+`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := authclient.ReadSigningBundleFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			signedTx, err := bundle.Finalize(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			closeFunc, err := setOutputFile(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFunc()
+
+			cmd.Printf("%s\n", txJSON)
+			return nil
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed transaction is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// writeBundle writes the bundle to the given file, or to the output of the
+// command if it's empty.
+func writeBundle(cmd *cobra.Command, bundle *authclient.SigningBundle, filename string) error {
+	bz, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if filename == "" {
+		cmd.Printf("%s\n", bz)
+		return nil
+	}
+
+	return os.WriteFile(filename, append(bz, '\n'), 0o644)
+}
+
+func containsKey(keys []cryptotypes.PubKey, pubKey cryptotypes.PubKey) bool {
+	for _, key := range keys {
+		if key.Equals(pubKey) {
+			return true
+		}
+	}
+
+	return false
+}
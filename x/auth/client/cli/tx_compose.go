@@ -0,0 +1,299 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// typeURLKey is the key of the type URL of the messages read by the compose
+// command, as in the JSON encoding of an Any.
+const typeURLKey = "@type"
+
+var (
+	coinType     = reflect.TypeOf(sdk.Coin{})
+	coinsType    = reflect.TypeOf(sdk.Coins{})
+	decCoinType  = reflect.TypeOf(sdk.DecCoin{})
+	decCoinsType = reflect.TypeOf(sdk.DecCoins{})
+	anyType      = reflect.TypeOf(codectypes.Any{})
+)
+
+// GetComposeCommand returns the command building a transaction of several
+// messages read from a file.
+func GetComposeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compose [file]",
+		Short: "Build, sign and broadcast a transaction of the messages listed in a YAML or JSON file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Build a transaction of the messages listed in a YAML or JSON file, or read from
+STDIN if [file] is "-", then sign and broadcast it like any other transaction
+command, e.g. simulating it first with --gas=auto or only generating it with
+--generate-only.
+
+Each message holds its type URL under "@type" and its fields in the JSON encoding
+of the message, in which coins and lists of coins may be written as strings.
+Messages nested in an Any, e.g. in a MsgExec, follow the same form. The signer of
+every message must be the --from account, unless --generate-only is set.
+
+Example:
+$ %s tx compose msgs.yaml --from mykey --gas auto
+
+where msgs.yaml holds:
+
+- "@type": /cosmos.staking.v1beta1.MsgDelegate
+  delegator_address: cosmos1...
+  validator_address: cosmosvaloper1...
+  amount: 100stake
+- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: cosmos1...
+  to_address: cosmos1...
+  amount: 10stake,5atom
+`, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var bz []byte
+			if args[0] == "-" {
+				bz, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				bz, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			msgs, err := ParseComposedMsgs(clientCtx, bz)
+			if err != nil {
+				return err
+			}
+
+			for i, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return fmt.Errorf("invalid message %d: %w", i, err)
+				}
+
+				if clientCtx.GenerateOnly {
+					continue
+				}
+
+				for _, signer := range msg.GetSigners() {
+					if !signer.Equals(clientCtx.GetFromAddress()) {
+						return fmt.Errorf(
+							"message %d must be signed by %s, not by the --from account: generate the tx with --generate-only and sign it separately",
+							i, signer,
+						)
+					}
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParseComposedMsgs parses a YAML or JSON list of messages, each holding its
+// type URL under "@type" and its fields in their JSON encoding, in which coins
+// and lists of coins may be written as coin strings, e.g. "10stake,5atom". The
+// type of each message is resolved through the interface registry.
+func ParseComposedMsgs(clientCtx client.Context, bz []byte) ([]sdk.Msg, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse messages: %w", err)
+	}
+
+	list, ok := stringKeys(raw).([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list of messages")
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no message to compose")
+	}
+
+	msgs := make([]sdk.Msg, len(list))
+	for i, item := range list {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("message %d is not an object", i)
+		}
+
+		if err := normalizeAny(clientCtx.InterfaceRegistry, fields); err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+
+		msgJSON, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON(msgJSON, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
+
+// normalizeAny resolves the type of the value of an Any from its type URL and
+// normalizes its fields.
+func normalizeAny(registry codectypes.InterfaceRegistry, fields map[string]interface{}) error {
+	typeURL, ok := fields[typeURLKey].(string)
+	if !ok {
+		return fmt.Errorf("missing %q", typeURLKey)
+	}
+
+	if !strings.HasPrefix(typeURL, "/") {
+		typeURL = "/" + typeURL
+		fields[typeURLKey] = typeURL
+	}
+
+	msg, err := registry.Resolve(typeURL)
+	if err != nil {
+		return err
+	}
+
+	return normalizeFields(registry, reflect.TypeOf(msg).Elem(), fields)
+}
+
+// normalizeFields converts the coin strings found in the fields of a message of
+// the given type into their JSON encoding, recursively.
+func normalizeFields(registry codectypes.InterfaceRegistry, typ reflect.Type, fields map[string]interface{}) error {
+	for key, value := range fields {
+		if key == typeURLKey {
+			continue
+		}
+
+		field, ok := fieldByJSONName(typ, key)
+		if !ok {
+			// left for the JSON decoding to report
+			continue
+		}
+
+		normalized, err := normalizeValue(registry, field.Type, value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		fields[key] = normalized
+	}
+
+	return nil
+}
+
+func normalizeValue(registry codectypes.InterfaceRegistry, typ reflect.Type, value interface{}) (interface{}, error) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if s, ok := value.(string); ok {
+		switch typ {
+		case coinType:
+			return sdk.ParseCoinNormalized(s)
+		case coinsType:
+			return sdk.ParseCoinsNormalized(s)
+		case decCoinType:
+			return sdk.ParseDecCoin(s)
+		case decCoinsType:
+			return sdk.ParseDecCoins(s)
+		}
+
+		return value, nil
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if typ == anyType {
+			return value, normalizeAny(registry, value)
+		}
+
+		if typ.Kind() == reflect.Struct {
+			return value, normalizeFields(registry, typ, value)
+		}
+
+	case []interface{}:
+		if typ.Kind() != reflect.Slice {
+			return value, nil
+		}
+
+		for i, elem := range value {
+			normalized, err := normalizeValue(registry, typ.Elem(), elem)
+			if err != nil {
+				return nil, err
+			}
+
+			value[i] = normalized
+		}
+	}
+
+	return value, nil
+}
+
+// fieldByJSONName returns the field of a message with the given name, either
+// its proto name or its JSON name.
+func fieldByJSONName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	if typ.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+
+		props := new(proto.Properties)
+		props.Parse(tag)
+		if props.OrigName == name || props.JSONName == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// stringKeys converts the maps decoded from YAML, whose keys are interfaces,
+// into maps with string keys, recursively.
+func stringKeys(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[fmt.Sprint(k)] = stringKeys(v)
+		}
+		return m
+
+	case []interface{}:
+		for i, v := range value {
+			value[i] = stringKeys(v)
+		}
+		return value
+
+	default:
+		return value
+	}
+}
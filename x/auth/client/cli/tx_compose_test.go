@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestParseComposedMsgs(t *testing.T) {
+	encodingConfig := simappparams.MakeTestEncodingConfig()
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	authz.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Marshaler).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)))
+
+	testCases := []struct {
+		name   string
+		input  string
+		expErr bool
+		expMsg []sdk.Msg
+	}{
+		{
+			"yaml with coin strings",
+			`
+- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: ` + addr1.String() + `
+  to_address: ` + addr2.String() + `
+  amount: 10stake,5atom
+- "@type": cosmos.bank.v1beta1.MsgSend
+  from_address: ` + addr1.String() + `
+  to_address: ` + addr2.String() + `
+  amount:
+  - 5atom
+  - denom: stake
+    amount: "10"
+`,
+			false,
+			[]sdk.Msg{send, send},
+		},
+		{
+			"json",
+			`[{"@type": "/cosmos.bank.v1beta1.MsgSend", "from_address": "` + addr1.String() +
+				`", "to_address": "` + addr2.String() + `", "amount": "10stake,5atom"}]`,
+			false,
+			[]sdk.Msg{send},
+		},
+		{
+			"nested any",
+			`
+- "@type": /cosmos.authz.v1beta1.MsgExec
+  grantee: ` + addr2.String() + `
+  msgs:
+  - "@type": /cosmos.bank.v1beta1.MsgSend
+    from_address: ` + addr1.String() + `
+    to_address: ` + addr2.String() + `
+    amount: 10stake,5atom
+`,
+			false,
+			func() []sdk.Msg {
+				msg := authz.NewMsgExec(addr2, []sdk.Msg{send})
+				return []sdk.Msg{&msg}
+			}(),
+		},
+		{"empty list", `[]`, true, nil},
+		{"not a list", `"@type": /cosmos.bank.v1beta1.MsgSend`, true, nil},
+		{"missing type", `[{"from_address": "` + addr1.String() + `"}]`, true, nil},
+		{"unknown type", `[{"@type": "/cosmos.bank.v1beta1.MsgUnknown"}]`, true, nil},
+		{"invalid coins", `[{"@type": "/cosmos.bank.v1beta1.MsgSend", "amount": "ten stake"}]`, true, nil},
+		{"unknown field", `[{"@type": "/cosmos.bank.v1beta1.MsgSend", "sender": "` + addr1.String() + `"}]`, true, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := ParseComposedMsgs(clientCtx, []byte(tc.input))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, msgs, len(tc.expMsg))
+			for i, msg := range msgs {
+				require.Equal(t, encodingConfig.Marshaler.MustMarshalJSON(tc.expMsg[i]), encodingConfig.Marshaler.MustMarshalJSON(msg))
+			}
+		})
+	}
+}
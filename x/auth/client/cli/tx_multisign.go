@@ -12,7 +12,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -96,8 +95,8 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			return err
 		}
 
-		multisigPub := multisigInfo.GetPubKey().(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub := multisigInfo.GetPubKey().(multisig.PubKey)
+		multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 		if !clientCtx.Offline {
 			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
 			if err != nil {
@@ -136,6 +135,11 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 				}
 			}
 		}
+		if aggregatedPub, ok := multisigPub.(multisig.AggregatedPubKey); ok {
+			if multisigSig, err = aggregatedPub.AggregateMultisignature(multisigSig); err != nil {
+				return err
+			}
+		}
 
 		sigV2 := signingtypes.SignatureV2{
 			PubKey:   multisigPub,
@@ -302,8 +306,8 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 				return err
 			}
 
-			multisigPub := multisigInfo.GetPubKey().(*kmultisig.LegacyAminoPubKey)
-			multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+			multisigPub := multisigInfo.GetPubKey().(multisig.PubKey)
+			multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 			signingData := signing.SignerData{
 				ChainID:       txFactory.ChainID(),
 				AccountNumber: txFactory.AccountNumber(),
@@ -320,6 +324,11 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 					return err
 				}
 			}
+			if aggregatedPub, ok := multisigPub.(multisig.AggregatedPubKey); ok {
+				if multisigSig, err = aggregatedPub.AggregateMultisignature(multisigSig); err != nil {
+					return err
+				}
+			}
 
 			sigV2 := signingtypes.SignatureV2{
 				PubKey:   multisigPub,
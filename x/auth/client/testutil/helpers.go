@@ -106,4 +106,54 @@ func TxMultiSignBatchExec(clientCtx client.Context, filename string, from string
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignBatchCmd(), args)
 }
 
+func TxBundleCreateExec(clientCtx client.Context, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, clientCtx.ChainID),
+		filename,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetBundleCreateCommand(), append(args, extraArgs...))
+}
+
+func TxBundleInspectExec(clientCtx client.Context, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		filename,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetBundleInspectCommand(), append(args, extraArgs...))
+}
+
+func TxBundleSignExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--from=%s", from.String()),
+		filename,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetBundleSignCommand(), append(args, extraArgs...))
+}
+
+func TxBundleMergeExec(clientCtx client.Context, filenames []string, extraArgs ...string) (testutil.BufferWriter, error) {
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetBundleMergeCommand(), append(filenames, extraArgs...))
+}
+
+func TxBundleFinalizeExec(clientCtx client.Context, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		filename,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetBundleFinalizeCommand(), append(args, extraArgs...))
+}
+
+func TxComposeExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		filename,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from.String()),
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetComposeCommand(), append(args, extraArgs...))
+}
+
 // DONTCOVER
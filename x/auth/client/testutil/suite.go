@@ -934,6 +934,156 @@ func (s *IntegrationTestSuite) TestCLIMultisign() {
 	s.Require().NoError(s.network.WaitForNextBlock())
 }
 
+func (s *IntegrationTestSuite) TestCLIBundleMultisign() {
+	val1 := s.network.Validators[0]
+
+	// Generate 3 accounts and a 2-of-3 multisig.
+	kb := val1.ClientCtx.Keyring
+	var pubKeys []cryptotypes.PubKey
+	for i := 1; i <= 3; i++ {
+		info, _, err := kb.NewMnemonic(fmt.Sprintf("bundleAccount%d", i), keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		s.Require().NoError(err)
+		pubKeys = append(pubKeys, info.GetPubKey())
+	}
+
+	multisigInfo, err := kb.SaveMultisig("bundleMulti", kmultisig.NewLegacyAminoPubKey(2, pubKeys))
+	s.Require().NoError(err)
+
+	account1, err := kb.Key("bundleAccount1")
+	s.Require().NoError(err)
+
+	account2, err := kb.Key("bundleAccount3")
+	s.Require().NoError(err)
+
+	// Send coins from validator to multisig.
+	_, err = s.createBankMsg(val1, multisigInfo.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 20)))
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// Generate multisig transaction.
+	multiGeneratedTx, err := bankcli.MsgSendExec(
+		val1.ClientCtx,
+		multisigInfo.GetAddress(),
+		val1.Address,
+		sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 5)),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	multiGeneratedTxFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String())
+
+	// Create the bundle and sign it in parallel.
+	bundle, err := TxBundleCreateExec(val1.ClientCtx, multiGeneratedTxFile.Name())
+	s.Require().NoError(err)
+	bundle1File := testutil.WriteToNewTempFile(s.T(), bundle.String())
+	bundle2File := testutil.WriteToNewTempFile(s.T(), bundle.String())
+
+	_, err = TxBundleSignExec(val1.ClientCtx, account1.GetAddress(), bundle1File.Name())
+	s.Require().NoError(err)
+	_, err = TxBundleSignExec(val1.ClientCtx, account2.GetAddress(), bundle2File.Name())
+	s.Require().NoError(err)
+
+	// A key which cannot sign for the multisig.
+	_, err = TxBundleSignExec(val1.ClientCtx, val1.Address, bundle2File.Name())
+	s.Require().Error(err)
+
+	// A single signature is not enough.
+	_, err = TxBundleFinalizeExec(val1.ClientCtx, bundle1File.Name())
+	s.Require().Error(err)
+
+	merged, err := TxBundleMergeExec(val1.ClientCtx, []string{bundle1File.Name(), bundle2File.Name()})
+	s.Require().NoError(err)
+	mergedFile := testutil.WriteToNewTempFile(s.T(), merged.String())
+
+	out, err := TxBundleInspectExec(val1.ClientCtx, mergedFile.Name())
+	s.Require().NoError(err)
+
+	var summary struct {
+		Complete bool
+		Signers  []struct {
+			Address  string
+			SignedBy []string `json:"signed_by"`
+			Missing  string
+		}
+	}
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &summary))
+	s.Require().True(summary.Complete)
+	s.Require().Len(summary.Signers, 1)
+	s.Require().Equal(multisigInfo.GetAddress().String(), summary.Signers[0].Address)
+	s.Require().ElementsMatch(
+		[]string{account1.GetAddress().String(), account2.GetAddress().String()}, summary.Signers[0].SignedBy,
+	)
+
+	signedTx, err := TxBundleFinalizeExec(val1.ClientCtx, mergedFile.Name())
+	s.Require().NoError(err)
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx.String())
+
+	_, err = TxValidateSignaturesExec(val1.ClientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+
+	val1.ClientCtx.BroadcastMode = flags.BroadcastBlock
+	res, err := TxBroadcastExec(val1.ClientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+
+	var txRes sdk.TxResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(res.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+}
+
+func (s *IntegrationTestSuite) TestCLICompose() {
+	val1 := s.network.Validators[0]
+	receiver := sdk.AccAddress("compose_receiver____")
+
+	msgsFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`
+- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: %[1]s
+  to_address: %[2]s
+  amount: 10%[3]s
+- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: %[1]s
+  to_address: %[2]s
+  amount:
+  - denom: %[3]s
+    amount: "5"
+`, val1.Address, receiver, s.cfg.BondDenom))
+
+	fees := fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))))
+
+	// Generate only.
+	out, err := TxComposeExec(val1.ClientCtx, val1.Address, msgsFile.Name(), fees, fmt.Sprintf("--%s=true", flags.FlagGenerateOnly))
+	s.Require().NoError(err)
+	generatedTx, err := val1.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(generatedTx.GetMsgs(), 2)
+
+	// Messages signed by another account are rejected unless generating only.
+	otherFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`
+- "@type": /cosmos.bank.v1beta1.MsgSend
+  from_address: %s
+  to_address: %s
+  amount: 10%s
+`, receiver, val1.Address, s.cfg.BondDenom))
+	_, err = TxComposeExec(val1.ClientCtx, val1.Address, otherFile.Name(), fees, fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation))
+	s.Require().Error(err)
+
+	// Sign and broadcast.
+	out, err = TxComposeExec(
+		val1.ClientCtx, val1.Address, msgsFile.Name(), fees,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+	)
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	resp, err := bankcli.QueryBalancesExec(val1.ClientCtx, receiver)
+	s.Require().NoError(err)
+	var balRes banktypes.QueryAllBalancesResponse
+	s.Require().NoError(val1.ClientCtx.Codec.UnmarshalJSON(resp.Bytes(), &balRes))
+	s.Require().Equal(sdk.NewInt(15), balRes.Balances.AmountOf(s.cfg.BondDenom))
+}
+
 func (s *IntegrationTestSuite) TestSignBatchMultisig() {
 	val := s.network.Validators[0]
 
@@ -24,6 +24,16 @@ func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisSta
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, rotation := range data.PubKeyRotations {
+		ak.SetPubKeyRotation(ctx, rotation)
+	}
+	for _, a := range data.Authenticators {
+		ak.SetAuthenticator(ctx, a)
+	}
+	for _, s := range data.AuthenticatorStates {
+		ak.SetAuthenticatorState(ctx, s)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -38,5 +48,28 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	var rotations []types.PubKeyRotation
+	ak.IteratePubKeyRotations(ctx, func(rotation types.PubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	var authenticators []types.AccountAuthenticator
+	ak.IterateAuthenticators(ctx, func(a types.AccountAuthenticator) bool {
+		authenticators = append(authenticators, a)
+		return false
+	})
+
+	var states []types.AuthenticatorState
+	ak.IterateAuthenticatorStates(ctx, func(s types.AuthenticatorState) bool {
+		states = append(states, s)
+		return false
+	})
+
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.PubKeyRotations = rotations
+	genState.Authenticators = authenticators
+	genState.AuthenticatorStates = states
+
+	return genState
 }
@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// AuthenticatorRegistry returns the registry of the authenticator types which
+// can be attached to the accounts. The app registers its authenticator types
+// in it when it is created.
+func (ak AccountKeeper) AuthenticatorRegistry() *authenticator.Registry {
+	return ak.authenticators
+}
+
+// AddAuthenticator attaches an authenticator of a registered type to the account
+// at addr and returns its id. Module accounts cannot have authenticators.
+//
+// The authenticator must bind the key signing the txs it authenticates with a
+// SignatureKey, possibly combined by an AllOf, whose proofs of possession are
+// verified as when a pubkey is first set by the ante handler.
+func (ak AccountKeeper) AddAuthenticator(ctx sdk.Context, addr sdk.AccAddress, authenticatorType string, config []byte) (uint64, error) {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	if _, ok := acc.(types.ModuleAccountI); ok {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot add an authenticator to module account %s", addr)
+	}
+
+	auth, err := ak.authenticators.Initialize(authenticatorType, config)
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	pubKeys := authenticator.SignatureKeys(auth)
+	if len(pubKeys) == 0 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s authenticator does not bind the signer key with a %s", authenticatorType, authenticator.SignatureKeyType)
+	}
+
+	params := ak.GetParams(ctx)
+	for _, pubKey := range pubKeys {
+		if err := types.VerifyProofsOfPossession(ctx.GasMeter(), pubKey, params); err != nil {
+			return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+	}
+
+	id := ak.getNextAuthenticatorID(ctx)
+	ak.SetAuthenticator(ctx, types.NewAccountAuthenticator(addr, id, authenticatorType, config))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, fmt.Sprint(id)),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, authenticatorType),
+		),
+	)
+
+	return id, nil
+}
+
+// RemoveAuthenticator removes an authenticator from the account at addr, along
+// with its state.
+func (ak AccountKeeper) RemoveAuthenticator(ctx sdk.Context, addr sdk.AccAddress, id uint64) error {
+	if _, found := ak.GetAuthenticator(ctx, addr, id); !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "authenticator %d of %s does not exist", id, addr)
+	}
+
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.AuthenticatorStoreKey(addr, id))
+
+	stateStore := prefix.NewStore(store, types.AuthenticatorStateStoreKey(addr, id))
+	iterator := stateStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		stateStore.Delete(key)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, fmt.Sprint(id)),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthenticator returns an authenticator of the account at addr.
+func (ak AccountKeeper) GetAuthenticator(ctx sdk.Context, addr sdk.AccAddress, id uint64) (a types.AccountAuthenticator, found bool) {
+	bz := ctx.KVStore(ak.key).Get(types.AuthenticatorStoreKey(addr, id))
+	if bz == nil {
+		return a, false
+	}
+
+	ak.cdc.MustUnmarshal(bz, &a)
+	return a, true
+}
+
+// GetAuthenticators returns the authenticators of the account at addr, in the
+// order they were added.
+func (ak AccountKeeper) GetAuthenticators(ctx sdk.Context, addr sdk.AccAddress) (authenticators []types.AccountAuthenticator) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AuthenticatorsStoreKey(addr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var a types.AccountAuthenticator
+		ak.cdc.MustUnmarshal(iterator.Value(), &a)
+		authenticators = append(authenticators, a)
+	}
+
+	return authenticators
+}
+
+// HasAuthenticators returns true if the account at addr has authenticators.
+func (ak AccountKeeper) HasAuthenticators(ctx sdk.Context, addr sdk.AccAddress) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AuthenticatorsStoreKey(addr))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// IterateAuthenticators iterates over the authenticators of all the accounts,
+// calling the provided function. Stop iteration when it returns true.
+func (ak AccountKeeper) IterateAuthenticators(ctx sdk.Context, cb func(a types.AccountAuthenticator) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AuthenticatorKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var a types.AccountAuthenticator
+		ak.cdc.MustUnmarshal(iterator.Value(), &a)
+
+		if cb(a) {
+			break
+		}
+	}
+}
+
+// SetAuthenticator sets an authenticator of an account, without validating its
+// config.
+func (ak AccountKeeper) SetAuthenticator(ctx sdk.Context, a types.AccountAuthenticator) {
+	addr, err := sdk.AccAddressFromBech32(a.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(ak.key).Set(types.AuthenticatorStoreKey(addr, a.Id), ak.cdc.MustMarshal(&a))
+
+	if a.Id >= ak.peekNextAuthenticatorID(ctx) {
+		ak.setNextAuthenticatorID(ctx, a.Id+1)
+	}
+}
+
+// IterateAuthenticatorStates iterates over the entries of the state of all the
+// authenticators, calling the provided function. Stop iteration when it returns
+// true.
+func (ak AccountKeeper) IterateAuthenticatorStates(ctx sdk.Context, cb func(s types.AuthenticatorState) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.AuthenticatorStateKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is prefix | len(address) | address | id | state key
+		key := iterator.Key()[len(types.AuthenticatorStateKeyPrefix):]
+		addrLen := int(key[0])
+		addr := sdk.AccAddress(key[1 : 1+addrLen])
+		key = key[1+addrLen:]
+
+		s := types.AuthenticatorState{
+			Address:         addr.String(),
+			AuthenticatorId: sdk.BigEndianToUint64(key[:8]),
+			Key:             key[8:],
+			Value:           iterator.Value(),
+		}
+		if cb(s) {
+			break
+		}
+	}
+}
+
+// SetAuthenticatorState sets an entry of the state of an authenticator.
+func (ak AccountKeeper) SetAuthenticatorState(ctx sdk.Context, s types.AuthenticatorState) {
+	addr, err := sdk.AccAddressFromBech32(s.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	key := append(types.AuthenticatorStateStoreKey(addr, s.AuthenticatorId), s.Key...)
+	ctx.KVStore(ak.key).Set(key, s.Value)
+}
+
+// Authenticate authenticates a tx signed for the account req.Account with a key
+// which is not its pubkey. The authenticators of the account are tried in the
+// order they were added, and the tx is authenticated by the first one returning
+// no error, whose state changes are kept.
+//
+// An authenticator only authenticates the txs signed by the keys of its
+// SignatureKeys. The txs managing the pubkey or the authenticators of the
+// account must be signed with its pubkey, so that an authenticator cannot
+// extend itself, including through the authz messages executing or granting
+// them.
+func (ak AccountKeeper) Authenticate(ctx sdk.Context, req authenticator.Request) error {
+	for _, msg := range req.Msgs {
+		if managesAccount(msg, req.Account) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s must be signed with the pubkey of %s", sdk.MsgTypeURL(msg), req.Account)
+		}
+	}
+
+	authenticators := ak.GetAuthenticators(ctx, req.Account)
+	if len(authenticators) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no authenticator", req.Account)
+	}
+
+	errs := make([]string, 0, len(authenticators))
+	for _, a := range authenticators {
+		auth, err := ak.authenticators.Initialize(a.Type, a.Config)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("authenticator %d: %s", a.Id, err))
+			continue
+		}
+
+		if !bindsKey(auth, req.PubKey) {
+			errs = append(errs, fmt.Sprintf("authenticator %d: tx not signed by its signature key", a.Id))
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		req.Store = prefix.NewStore(cacheCtx.KVStore(ak.key), types.AuthenticatorStateStoreKey(req.Account, a.Id))
+		if err := auth.Authenticate(cacheCtx, req); err != nil {
+			errs = append(errs, fmt.Sprintf("authenticator %d: %s", a.Id, err))
+			continue
+		}
+
+		write()
+		return nil
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tx not authenticated for %s: %s", req.Account, strings.Join(errs, "; "))
+}
+
+// bindsKey returns true if auth has SignatureKeys, which all are pubKey.
+func bindsKey(auth authenticator.Authenticator, pubKey cryptotypes.PubKey) bool {
+	pubKeys := authenticator.SignatureKeys(auth)
+	if len(pubKeys) == 0 || pubKey == nil {
+		return false
+	}
+
+	for _, pk := range pubKeys {
+		if !pk.Equals(pubKey) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// managesAccount returns true if msg, or a message nested in it as in an authz
+// MsgExec, manages the pubkey or the authenticators of the account at addr, or
+// grants an authorization to manage them. The messages which cannot be unpacked
+// are assumed to manage the account.
+func managesAccount(msg sdk.Msg, addr sdk.AccAddress) bool {
+	switch msg := msg.(type) {
+	case *types.MsgRotatePubKey:
+		return msg.Address == addr.String()
+	case *types.MsgAddAuthenticator:
+		return msg.Address == addr.String()
+	case *types.MsgRemoveAuthenticator:
+		return msg.Address == addr.String()
+	case *authz.MsgGrant:
+		if msg.Granter == addr.String() && grantsManagement(msg.Grant.GetAuthorization()) {
+			return true
+		}
+	}
+
+	nested, ok := msg.(interface {
+		GetMessages() ([]sdk.Msg, error)
+	})
+	if !ok {
+		return false
+	}
+	nestedMsgs, err := nested.GetMessages()
+	if err != nil {
+		return true
+	}
+	for _, nestedMsg := range nestedMsgs {
+		if managesAccount(nestedMsg, addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// grantsManagement returns true if the authorization allows its grantee to
+// manage the pubkey or the authenticators of the granter, either directly or by
+// executing or granting the messages on its behalf.
+func grantsManagement(authorization authz.Authorization) bool {
+	if authorization == nil {
+		return true
+	}
+
+	switch authorization.MsgTypeURL() {
+	case sdk.MsgTypeURL(&types.MsgRotatePubKey{}),
+		sdk.MsgTypeURL(&types.MsgAddAuthenticator{}),
+		sdk.MsgTypeURL(&types.MsgRemoveAuthenticator{}),
+		sdk.MsgTypeURL(&authz.MsgExec{}),
+		sdk.MsgTypeURL(&authz.MsgGrant{}):
+		return true
+	default:
+		return false
+	}
+}
+
+// getNextAuthenticatorID returns and increments the global authenticator id
+// counter.
+func (ak AccountKeeper) getNextAuthenticatorID(ctx sdk.Context) uint64 {
+	id := ak.peekNextAuthenticatorID(ctx)
+	ak.setNextAuthenticatorID(ctx, id+1)
+
+	return id
+}
+
+// peekNextAuthenticatorID returns the global authenticator id counter.
+func (ak AccountKeeper) peekNextAuthenticatorID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(ak.key).Get(types.NextAuthenticatorIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	var id gogotypes.UInt64Value
+	ak.cdc.MustUnmarshal(bz, &id)
+
+	return id.GetValue()
+}
+
+// setNextAuthenticatorID sets the global authenticator id counter.
+func (ak AccountKeeper) setNextAuthenticatorID(ctx sdk.Context, id uint64) {
+	bz := ak.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	ctx.KVStore(ak.key).Set(types.NextAuthenticatorIDKey, bz)
+}
@@ -0,0 +1,365 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sessionKeyConfig returns the config of an AllOf authenticator of sessionKey
+// limited to send up to 100stake.
+func (suite *KeeperTestSuite) sessionKeyConfig(sessionKey cryptotypes.PubKey) []byte {
+	pubKeyJSON, err := suite.app.AppCodec().MarshalInterfaceJSON(sessionKey)
+	suite.Require().NoError(err)
+
+	return []byte(fmt.Sprintf(`[
+		{"type":"SignatureKey","config":%s},
+		{"type":"SpendLimit","config":{"limit":[{"denom":"stake","amount":"100"}]}}
+	]`, pubKeyJSON))
+}
+
+// blsMultisig returns an aggregated multisig of two keys, whose proofs of
+// possession are swapped if rogue is true.
+func (suite *KeeperTestSuite) blsMultisig(rogue bool) *bls12381.MultisigPubKey {
+	var (
+		pubKeys []*bls12381.PubKey
+		pops    [][]byte
+	)
+	for i := 0; i < 2; i++ {
+		priv := bls12381.GenPrivKey()
+		pop, err := priv.ProofOfPossession()
+		suite.Require().NoError(err)
+
+		pubKeys = append(pubKeys, priv.PubKey().(*bls12381.PubKey))
+		pops = append(pops, pop)
+	}
+
+	pubKey, err := bls12381.NewMultisigPubKey(2, pubKeys, pops)
+	suite.Require().NoError(err)
+	if rogue {
+		pubKey.ProofsOfPossession[0], pubKey.ProofsOfPossession[1] = pubKey.ProofsOfPossession[1], pubKey.ProofsOfPossession[0]
+	}
+
+	return pubKey
+}
+
+func (suite *KeeperTestSuite) TestAddAuthenticator() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	config := suite.sessionKeyConfig(secp256k1.GenPrivKey().PubKey())
+	multisigConfig, err := suite.app.AppCodec().MarshalInterfaceJSON(suite.blsMultisig(false))
+	suite.Require().NoError(err)
+	rogueConfig, err := suite.app.AppCodec().MarshalInterfaceJSON(suite.blsMultisig(true))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg               string
+		malleate          func()
+		addr              sdk.AccAddress
+		authenticatorType string
+		config            []byte
+		expErr            bool
+	}{
+		{
+			"success",
+			func() { suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0)) },
+			addr, authenticator.AllOfType, config, false,
+		},
+		{
+			"unknown account",
+			func() {},
+			addr, authenticator.AllOfType, config, true,
+		},
+		{
+			"module account",
+			func() { suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.FeeCollectorName) },
+			types.NewModuleAddress(types.FeeCollectorName), authenticator.AllOfType, config, true,
+		},
+		{
+			"unknown type",
+			func() { suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0)) },
+			addr, "unknown", config, true,
+		},
+		{
+			"invalid config",
+			func() { suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0)) },
+			addr, authenticator.TimeWindowType, []byte("{}"), true,
+		},
+		{
+			"no signature key",
+			func() { suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0)) },
+			addr, authenticator.MessageFilterType, []byte(`["/cosmos.bank.v1beta1.MsgSend"]`), true,
+		},
+		{
+			"all of without signature key",
+			func() { suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0)) },
+			addr, authenticator.AllOfType, []byte(`[{"type":"TimeWindow","config":{"not_before":"2022-01-01T00:00:00Z"}}]`), true,
+		},
+		{
+			"aggregated multisig",
+			func() { suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0)) },
+			addr, authenticator.SignatureKeyType, multisigConfig, false,
+		},
+		{
+			"invalid proof of possession",
+			func() { suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0)) },
+			addr, authenticator.SignatureKeyType, rogueConfig, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			ak := suite.app.AccountKeeper
+
+			tc.malleate()
+			id, err := ak.AddAuthenticator(suite.ctx, tc.addr, tc.authenticatorType, tc.config)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().False(ak.HasAuthenticators(suite.ctx, tc.addr))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(ak.HasAuthenticators(suite.ctx, tc.addr))
+
+			a, found := ak.GetAuthenticator(suite.ctx, tc.addr, id)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewAccountAuthenticator(tc.addr, id, tc.authenticatorType, tc.config), a)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveAuthenticator() {
+	ak := suite.app.AccountKeeper
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	config := suite.sessionKeyConfig(secp256k1.GenPrivKey().PubKey())
+	id1, err := ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, config)
+	suite.Require().NoError(err)
+	id2, err := ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, config)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(id1, id2)
+
+	ak.SetAuthenticatorState(suite.ctx, types.AuthenticatorState{Address: addr.String(), AuthenticatorId: id1, Key: []byte("key"), Value: []byte("value")})
+
+	suite.Require().Error(ak.RemoveAuthenticator(suite.ctx, addr, id2+1))
+	suite.Require().NoError(ak.RemoveAuthenticator(suite.ctx, addr, id1))
+	suite.Require().Error(ak.RemoveAuthenticator(suite.ctx, addr, id1))
+
+	// the state of the authenticator is removed with it
+	ak.IterateAuthenticatorStates(suite.ctx, func(s types.AuthenticatorState) bool {
+		suite.Failf("state not removed", "%v", s)
+		return true
+	})
+
+	authenticators := ak.GetAuthenticators(suite.ctx, addr)
+	suite.Require().Len(authenticators, 1)
+	suite.Require().Equal(id2, authenticators[0].Id)
+
+	// ids are not reused
+	id3, err := ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, config)
+	suite.Require().NoError(err)
+	suite.Require().Greater(id3, id2)
+}
+
+func (suite *KeeperTestSuite) TestAuthenticate() {
+	ak := suite.app.AccountKeeper
+	pubKey, sessionKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addr, other, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+	req := func(pubKey cryptotypes.PubKey, msgs ...sdk.Msg) authenticator.Request {
+		return authenticator.Request{Account: addr, PubKey: pubKey, Msgs: msgs}
+	}
+
+	err := ak.Authenticate(suite.ctx, req(sessionKey, send(1)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+
+	_, err = ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, suite.sessionKeyConfig(sessionKey))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(ak.Authenticate(suite.ctx, req(sessionKey, send(60))))
+	suite.Require().ErrorIs(ak.Authenticate(suite.ctx, req(secp256k1.GenPrivKey().PubKey(), send(1))), sdkerrors.ErrUnauthorized)
+
+	// the state of a failed authentication is discarded
+	suite.Require().ErrorIs(ak.Authenticate(suite.ctx, req(sessionKey, send(30), send(30))), sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(ak.Authenticate(suite.ctx, req(sessionKey, send(40))))
+	suite.Require().Error(ak.Authenticate(suite.ctx, req(sessionKey, send(1))))
+
+	// another authenticator of the account can authenticate the tx
+	_, err = ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, suite.sessionKeyConfig(sessionKey))
+	suite.Require().NoError(err)
+	suite.Require().NoError(ak.Authenticate(suite.ctx, req(sessionKey, send(1))))
+
+	// the account is managed with its pubkey only, including through authz,
+	// even when an authenticator of the session key allows any message
+	sessionKeyJSON, err := suite.app.AppCodec().MarshalInterfaceJSON(sessionKey)
+	suite.Require().NoError(err)
+	_, err = ak.AddAuthenticator(suite.ctx, addr, authenticator.SignatureKeyType, sessionKeyJSON)
+	suite.Require().NoError(err)
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(addr, msgs)
+		return &msg
+	}
+	grant := func(msgTypeURL string) sdk.Msg {
+		msg, err := authz.NewMsgGrant(addr, other, authz.NewGenericAuthorization(msgTypeURL), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		return msg
+	}
+	testCases := []struct {
+		desc    string
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"remove authenticator", types.NewMsgRemoveAuthenticator(addr, 0), false},
+		{"remove authenticator in exec", exec(send(1), types.NewMsgRemoveAuthenticator(addr, 0)), false},
+		{"rotate pubkey in nested exec", exec(exec(&types.MsgRotatePubKey{Address: addr.String()})), false},
+		{"grant rotate pubkey", grant(sdk.MsgTypeURL(&types.MsgRotatePubKey{})), false},
+		{"grant add authenticator", grant(sdk.MsgTypeURL(&types.MsgAddAuthenticator{})), false},
+		{"grant exec", grant(sdk.MsgTypeURL(&authz.MsgExec{})), false},
+		{"grant grant in exec", exec(grant(sdk.MsgTypeURL(&authz.MsgGrant{}))), false},
+		{"grant send", grant(sdk.MsgTypeURL(&banktypes.MsgSend{})), true},
+		{"send in exec", exec(send(1)), true},
+	}
+	for _, tc := range testCases {
+		err := ak.Authenticate(suite.ctx, req(sessionKey, tc.msg))
+		if tc.expPass {
+			suite.Require().NoError(err, tc.desc)
+		} else {
+			suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized, tc.desc)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestAuthenticateWithoutSignatureKey() {
+	ak := suite.app.AccountKeeper
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	// authenticators not binding the signer key, e.g. set in genesis, do not
+	// authenticate the txs signed by anyone
+	ak.SetAuthenticator(suite.ctx, types.NewAccountAuthenticator(addr, 0, authenticator.MessageFilterType, []byte(`["/cosmos.bank.v1beta1.MsgSend"]`)))
+	ak.SetAuthenticator(suite.ctx, types.NewAccountAuthenticator(addr, 1, authenticator.TimeWindowType, []byte(`{"not_before":"2022-01-01T00:00:00Z"}`)))
+
+	stranger := secp256k1.GenPrivKey().PubKey()
+	err := ak.Authenticate(suite.ctx, authenticator.Request{Account: addr, PubKey: stranger, Msgs: []sdk.Msg{send}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestMsgAddRemoveAuthenticator() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	addMsg := types.NewMsgAddAuthenticator(addr, authenticator.AllOfType, suite.sessionKeyConfig(secp256k1.GenPrivKey().PubKey()))
+	suite.Require().NoError(addMsg.ValidateBasic())
+	suite.Require().Equal([]sdk.AccAddress{addr}, addMsg.GetSigners())
+	suite.Require().NotEmpty(addMsg.GetSignBytes())
+	suite.Require().Error(types.NewMsgAddAuthenticator(addr, " ", nil).ValidateBasic())
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.AccountKeeper)
+	res, err := msgServer.AddAuthenticator(sdk.WrapSDKContext(suite.ctx), addMsg)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AccountKeeper.HasAuthenticators(suite.ctx, addr))
+
+	removeMsg := types.NewMsgRemoveAuthenticator(addr, res.Id)
+	suite.Require().NoError(removeMsg.ValidateBasic())
+	suite.Require().Equal([]sdk.AccAddress{addr}, removeMsg.GetSigners())
+	_, err = msgServer.RemoveAuthenticator(sdk.WrapSDKContext(suite.ctx), removeMsg)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AccountKeeper.HasAuthenticators(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAccountAuthenticators() {
+	ak := suite.app.AccountKeeper
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	// another account has an authenticator added in between
+	otherPubKey := secp256k1.GenPrivKey().PubKey()
+	other := sdk.AccAddress(otherPubKey.Address())
+	suite.setAccount(types.NewBaseAccount(other, otherPubKey, 1, 0))
+
+	config := suite.sessionKeyConfig(secp256k1.GenPrivKey().PubKey())
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		id, err := ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, config)
+		suite.Require().NoError(err)
+		ids = append(ids, id)
+
+		_, err = ak.AddAuthenticator(suite.ctx, other, authenticator.AllOfType, config)
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.queryClient.AccountAuthenticators(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountAuthenticatorsRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.AccountAuthenticators(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountAuthenticatorsRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authenticators, 3)
+	for i, a := range res.Authenticators {
+		suite.Require().Equal(ids[i], a.Id)
+		suite.Require().Equal(addr.String(), a.Address)
+	}
+
+	res, err = suite.queryClient.AccountAuthenticators(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountAuthenticatorsRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authenticators, 1)
+	suite.Require().Equal(ids[1], res.Authenticators[0].Id)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestAuthenticatorsGenesis() {
+	ak := suite.app.AccountKeeper
+	pubKey, sessionKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	id, err := ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, suite.sessionKeyConfig(sessionKey))
+	suite.Require().NoError(err)
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	suite.Require().NoError(ak.Authenticate(suite.ctx, authenticator.Request{Account: addr, PubKey: sessionKey, Msgs: []sdk.Msg{send}}))
+
+	genState := auth.ExportGenesis(suite.ctx, ak)
+	suite.Require().Len(genState.Authenticators, 1)
+	suite.Require().NotEmpty(genState.AuthenticatorStates)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+
+	suite.SetupTest() // reset
+	ak = suite.app.AccountKeeper
+	auth.InitGenesis(suite.ctx, ak, *genState)
+	exported := auth.ExportGenesis(suite.ctx, ak)
+	suite.Require().Equal(genState.Authenticators, exported.Authenticators)
+	suite.Require().Equal(genState.AuthenticatorStates, exported.AuthenticatorStates)
+
+	// the spend limit carries over, and new ids follow the imported ones
+	suite.Require().Error(ak.Authenticate(suite.ctx, authenticator.Request{Account: addr, PubKey: sessionKey, Msgs: []sdk.Msg{send}}))
+	newID, err := ak.AddAuthenticator(suite.ctx, addr, authenticator.AllOfType, suite.sessionKeyConfig(sessionKey))
+	suite.Require().NoError(err)
+	suite.Require().Greater(newID, id)
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GenesisAccountsCollection is the name of the collection holding the accounts
+// in a split genesis directory.
+const GenesisAccountsCollection = "accounts"
+
+// InitGenesisStream initializes the auth module's state from a given genesis
+// state, and the accounts streamed from stream.
+//
+// Unlike auth.InitGenesis, which sorts all the genesis accounts in memory and
+// assigns them consecutive account numbers, streamed accounts keep the account
+// number they were exported with. Accounts found in the genesis state itself
+// are assigned account numbers past the highest streamed one.
+func (ak AccountKeeper) InitGenesisStream(ctx sdk.Context, data types.GenesisState, stream *module.GenesisStream) error {
+	ak.SetParams(ctx, data.Params)
+
+	var (
+		nextAccNum uint64
+		any        codectypes.Any
+	)
+	err := stream.Read(GenesisAccountsCollection, &any, func() error {
+		var acc types.AccountI
+		if err := ak.cdc.UnpackAny(&any, &acc); err != nil {
+			return err
+		}
+
+		if ak.HasAccount(ctx, acc.GetAddress()) {
+			return fmt.Errorf("duplicate account found in genesis state; address: %s", acc.GetAddress())
+		}
+
+		if acc.GetAccountNumber() >= nextAccNum {
+			nextAccNum = acc.GetAccountNumber() + 1
+		}
+
+		ak.SetAccount(ctx, acc)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ak.setNextAccountNumber(ctx, nextAccNum)
+
+	accounts, err := types.UnpackAccounts(data.Accounts)
+	if err != nil {
+		return err
+	}
+	accounts = types.SanitizeGenesisAccounts(accounts)
+
+	for _, a := range accounts {
+		acc := ak.NewAccount(ctx, a)
+		ak.SetAccount(ctx, acc)
+	}
+
+	for _, rotation := range data.PubKeyRotations {
+		ak.SetPubKeyRotation(ctx, rotation)
+	}
+	for _, a := range data.Authenticators {
+		ak.SetAuthenticator(ctx, a)
+	}
+	for _, s := range data.AuthenticatorStates {
+		ak.SetAuthenticatorState(ctx, s)
+	}
+
+	ak.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	return nil
+}
+
+// ExportGenesisStream writes all the accounts to stream, one at a time, and
+// returns the rest of the auth module's genesis state, including the pubkey
+// rotations and the authenticators.
+func (ak AccountKeeper) ExportGenesisStream(ctx sdk.Context, stream *module.GenesisStream) (*types.GenesisState, error) {
+	var err error
+	ak.IterateAccounts(ctx, func(account types.AccountI) bool {
+		var any *codectypes.Any
+		if any, err = codectypes.NewAnyWithValue(account); err != nil {
+			return true
+		}
+
+		err = stream.Write(GenesisAccountsCollection, any)
+		return err != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rotations []types.PubKeyRotation
+	ak.IteratePubKeyRotations(ctx, func(rotation types.PubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	var authenticators []types.AccountAuthenticator
+	ak.IterateAuthenticators(ctx, func(a types.AccountAuthenticator) bool {
+		authenticators = append(authenticators, a)
+		return false
+	})
+
+	var states []types.AuthenticatorState
+	ak.IterateAuthenticatorStates(ctx, func(s types.AuthenticatorState) bool {
+		states = append(states, s)
+		return false
+	})
+
+	genState := types.NewGenesisState(ak.GetParams(ctx), types.GenesisAccounts{})
+	genState.PubKeyRotations = rotations
+	genState.Authenticators = authenticators
+	genState.AuthenticatorStates = states
+
+	return genState, nil
+}
+
+// setNextAccountNumber sets the number returned by the next call to
+// GetNextAccountNumber.
+func (ak AccountKeeper) setNextAccountNumber(ctx sdk.Context, accNum uint64) {
+	store := ctx.KVStore(ak.key)
+	bz := ak.cdc.MustMarshal(&gogotypes.UInt64Value{Value: accNum})
+	store.Set(types.GlobalAccountNumberKey, bz)
+}
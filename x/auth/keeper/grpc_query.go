@@ -106,3 +106,73 @@ func (ak AccountKeeper) ModuleAccountByName(c context.Context, req *types.QueryM
 
 	return &types.QueryModuleAccountByNameResponse{Account: any}, nil
 }
+
+// PubKeyHistory returns the pubkey rotations of an account
+func (ak AccountKeeper) PubKeyHistory(c context.Context, req *types.QueryPubKeyHistoryRequest) (*types.QueryPubKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rotationsStore := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyRotationsStoreKey(addr))
+
+	var rotations []types.PubKeyRotation
+	pageRes, err := query.Paginate(rotationsStore, req.Pagination, func(key, value []byte) error {
+		var rotation types.PubKeyRotation
+		if err := ak.cdc.Unmarshal(value, &rotation); err != nil {
+			return err
+		}
+
+		rotations = append(rotations, rotation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryPubKeyHistoryResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
+
+// AccountAuthenticators returns the authenticators of an account
+func (ak AccountKeeper) AccountAuthenticators(c context.Context, req *types.QueryAccountAuthenticatorsRequest) (*types.QueryAccountAuthenticatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	authenticatorsStore := prefix.NewStore(ctx.KVStore(ak.key), types.AuthenticatorsStoreKey(addr))
+
+	var authenticators []types.AccountAuthenticator
+	pageRes, err := query.Paginate(authenticatorsStore, req.Pagination, func(key, value []byte) error {
+		var a types.AccountAuthenticator
+		if err := ak.cdc.Unmarshal(value, &a); err != nil {
+			return err
+		}
+
+		authenticators = append(authenticators, a)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryAccountAuthenticatorsResponse{Authenticators: authenticators, Pagination: pageRes}, nil
+}
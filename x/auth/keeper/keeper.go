@@ -10,6 +10,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -57,6 +58,9 @@ type AccountKeeper struct {
 
 	// The prototypical AccountI constructor.
 	proto func() types.AccountI
+
+	// The authenticator types which can be attached to the accounts.
+	authenticators *authenticator.Registry
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -82,11 +86,12 @@ func NewAccountKeeper(
 	}
 
 	return AccountKeeper{
-		key:           key,
-		proto:         proto,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		permAddrs:     permAddrs,
+		key:            key,
+		proto:          proto,
+		cdc:            cdc,
+		paramSubspace:  paramstore,
+		permAddrs:      permAddrs,
+		authenticators: authenticator.NewRegistry(),
 	}
 }
 
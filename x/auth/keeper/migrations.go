@@ -4,6 +4,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v043"
+	v046 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v046"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,3 +42,9 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return iterErr
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	v046.MigrateStore(ctx, m.keeper.paramSubspace)
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RotatePubKey replaces the pubkey of the signer of the message.
+func (s msgServer) RotatePubKey(goCtx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %T, got %T", (cryptotypes.PubKey)(nil), msg.NewPubKey.GetCachedValue())
+	}
+
+	newPubKeySig, err := msg.GetNewPubKeySignatureData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid new pubkey signature: %s", err)
+	}
+
+	if err := s.AccountKeeper.RotatePubKey(ctx, addr, newPubKey, newPubKeySig); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	)
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
+
+// AddAuthenticator attaches an authenticator to the signer of the message.
+func (s msgServer) AddAuthenticator(goCtx context.Context, msg *types.MsgAddAuthenticator) (*types.MsgAddAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.AccountKeeper.AddAuthenticator(ctx, addr, msg.AuthenticatorType, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	)
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+// RemoveAuthenticator removes an authenticator from the signer of the message.
+func (s msgServer) RemoveAuthenticator(goCtx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.AccountKeeper.RemoveAuthenticator(ctx, addr, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	)
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
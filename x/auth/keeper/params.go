@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -15,3 +17,11 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetPubKeyRotationCooldown returns the PubKeyRotationCooldown param. It is read
+// on its own rather than with GetParams, so that a rotation does not pay for
+// reading every param.
+func (ak AccountKeeper) GetPubKeyRotationCooldown(ctx sdk.Context) (cooldown time.Duration) {
+	ak.paramSubspace.Get(ctx, types.KeyPubKeyRotationCooldown, &cooldown)
+	return
+}
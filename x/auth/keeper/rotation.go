@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"encoding/base64"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RotatePubKey replaces the pubkey of the account at addr with newPubKey, which
+// may be of a different type, and records the rotation in the account's pubkey
+// history. The account keeps its address, so the address of its pubkey no
+// longer matches it after a rotation. newPubKeySig must be the signature by
+// newPubKey of the bytes returned by types.PubKeyRotationSignBytes, so that an
+// account cannot be rotated to a key its owner does not hold.
+//
+// Module accounts and accounts without a pubkey cannot be rotated, and an
+// account cannot be rotated again before the PubKeyRotationCooldown param has
+// elapsed since its last rotation.
+func (ak AccountKeeper) RotatePubKey(ctx sdk.Context, addr sdk.AccAddress, newPubKey cryptotypes.PubKey, newPubKeySig signing.SignatureData) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	if _, ok := acc.(types.ModuleAccountI); ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot rotate the pubkey of module account %s", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no pubkey to rotate", addr)
+	}
+
+	if oldPubKey.Equals(newPubKey) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "new pubkey is the current pubkey")
+	}
+
+	if last, found := ak.GetLastPubKeyRotation(ctx, addr); found {
+		if next := last.Time.Add(ak.GetPubKeyRotationCooldown(ctx)); ctx.BlockTime().Before(next) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "pubkey of %s cannot be rotated before %s", addr, next)
+		}
+	}
+
+	params := ak.GetParams(ctx)
+	signBytes := types.PubKeyRotationSignBytes(ctx.ChainID(), addr, oldPubKey)
+	if err := types.VerifyPubKeyRotationSignature(ctx.GasMeter(), newPubKey, signBytes, newPubKeySig, params); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid new pubkey signature: %s", err)
+	}
+
+	// the proofs of possession are verified as when a pubkey is first set by
+	// the ante handler
+	if err := types.VerifyProofsOfPossession(ctx.GasMeter(), newPubKey, params); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	rotation, err := types.NewPubKeyRotation(addr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	ak.SetPubKeyRotation(ctx, rotation)
+
+	// the pubkeys are encoded in base64 as the String method of some of them,
+	// e.g. multisigs, cannot be used in events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotatePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyOldPubKey, base64.StdEncoding.EncodeToString(oldPubKey.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, base64.StdEncoding.EncodeToString(newPubKey.Bytes())),
+		),
+	)
+
+	return nil
+}
+
+// GetLastPubKeyRotation returns the last pubkey rotation of the account at addr.
+func (ak AccountKeeper) GetLastPubKeyRotation(ctx sdk.Context, addr sdk.AccAddress) (rotation types.PubKeyRotation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyRotationsStoreKey(addr))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return rotation, false
+	}
+
+	ak.cdc.MustUnmarshal(iterator.Value(), &rotation)
+	return rotation, true
+}
+
+// IteratePubKeyRotations iterates over the pubkey rotations of all the accounts,
+// in chronological order for each account, calling the provided function. Stop
+// iteration when it returns true.
+func (ak AccountKeeper) IteratePubKeyRotations(ctx sdk.Context, cb func(rotation types.PubKeyRotation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.key), types.PubKeyRotationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.PubKeyRotation
+		ak.cdc.MustUnmarshal(iterator.Value(), &rotation)
+
+		if cb(rotation) {
+			break
+		}
+	}
+}
+
+// SetPubKeyRotation appends a pubkey rotation to the pubkey history of its
+// account. Rotations must be set in chronological order.
+func (ak AccountKeeper) SetPubKeyRotation(ctx sdk.Context, rotation types.PubKeyRotation) {
+	addr, err := sdk.AccAddressFromBech32(rotation.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(ak.key)
+	prefixStore := prefix.NewStore(store, types.PubKeyRotationsStoreKey(addr))
+	iterator := prefixStore.ReverseIterator(nil, nil)
+	var index uint64
+	if iterator.Valid() {
+		index = sdk.BigEndianToUint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	store.Set(types.PubKeyRotationStoreKey(addr, index), ak.cdc.MustMarshal(&rotation))
+}
@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestRotatePubKey() {
+	oldPriv, newPriv := secp256k1.GenPrivKey(), ed25519.GenPrivKey()
+	oldPubKey, newPubKey := oldPriv.PubKey(), newPriv.PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	moduleAddr := types.NewModuleAddress(types.FeeCollectorName)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		addr     sdk.AccAddress
+		pubKey   cryptotypes.PubKey
+		sig      func() signing.SignatureData
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				suite.setAccount(types.NewBaseAccount(addr, oldPubKey, 0, 0))
+			},
+			addr, newPubKey,
+			func() signing.SignatureData { return suite.rotationSig(addr, oldPubKey, newPriv) },
+			nil,
+		},
+		{
+			"unknown account",
+			func() {},
+			addr, newPubKey,
+			func() signing.SignatureData { return suite.rotationSig(addr, oldPubKey, newPriv) },
+			sdkerrors.ErrUnknownAddress,
+		},
+		{
+			"account without pubkey",
+			func() {
+				suite.setAccount(types.NewBaseAccountWithAddress(addr))
+			},
+			addr, newPubKey,
+			func() signing.SignatureData { return suite.rotationSig(addr, oldPubKey, newPriv) },
+			sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			"same pubkey",
+			func() {
+				suite.setAccount(types.NewBaseAccount(addr, oldPubKey, 0, 0))
+			},
+			addr, oldPubKey,
+			func() signing.SignatureData { return suite.rotationSig(addr, oldPubKey, oldPriv) },
+			sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			"module account",
+			func() {
+				suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.FeeCollectorName)
+			},
+			moduleAddr, newPubKey,
+			func() signing.SignatureData { return suite.rotationSig(moduleAddr, oldPubKey, newPriv) },
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"signature of another key",
+			func() {
+				suite.setAccount(types.NewBaseAccount(addr, oldPubKey, 0, 0))
+			},
+			addr, newPubKey,
+			func() signing.SignatureData { return suite.rotationSig(addr, oldPubKey, ed25519.GenPrivKey()) },
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"signature by the old key",
+			func() {
+				suite.setAccount(types.NewBaseAccount(addr, oldPubKey, 0, 0))
+			},
+			addr, newPubKey,
+			func() signing.SignatureData { return suite.rotationSig(addr, oldPubKey, oldPriv) },
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"signature for another account",
+			func() {
+				suite.setAccount(types.NewBaseAccount(addr, oldPubKey, 0, 0))
+			},
+			addr, newPubKey,
+			func() signing.SignatureData {
+				return suite.rotationSig(sdk.AccAddress(newPubKey.Address()), oldPubKey, newPriv)
+			},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"missing signature",
+			func() {
+				suite.setAccount(types.NewBaseAccount(addr, oldPubKey, 0, 0))
+			},
+			addr, newPubKey,
+			func() signing.SignatureData { return &signing.SingleSignatureData{} },
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			err := suite.app.AccountKeeper.RotatePubKey(suite.ctx, tc.addr, tc.pubKey, tc.sig())
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, tc.addr)
+			suite.Require().True(tc.pubKey.Equals(acc.GetPubKey()))
+
+			rotation, found := suite.app.AccountKeeper.GetLastPubKeyRotation(suite.ctx, tc.addr)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.addr.String(), rotation.Address)
+			suite.Require().True(oldPubKey.Equals(rotation.GetOldPubKeyValue()))
+			suite.Require().True(tc.pubKey.Equals(rotation.GetNewPubKeyValue()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRotatePubKeyMultisig() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	multiPubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{privs[0].PubKey(), privs[1].PubKey()})
+	signBytes := types.PubKeyRotationSignBytes(suite.ctx.ChainID(), addr, pubKey)
+
+	sig := multisig.NewMultisig(len(privs))
+	addSig := func(priv cryptotypes.PrivKey) {
+		sigBz, err := priv.Sign(signBytes)
+		suite.Require().NoError(err)
+		suite.Require().NoError(multisig.AddSignatureFromPubKey(sig, &signing.SingleSignatureData{Signature: sigBz}, priv.PubKey(), multiPubKey.GetPubKeys()))
+	}
+
+	// below the threshold
+	addSig(privs[0])
+	suite.Require().ErrorIs(suite.app.AccountKeeper.RotatePubKey(suite.ctx, addr, multiPubKey, sig), sdkerrors.ErrUnauthorized)
+
+	addSig(privs[1])
+	suite.Require().NoError(suite.app.AccountKeeper.RotatePubKey(suite.ctx, addr, multiPubKey, sig))
+	suite.Require().True(multiPubKey.Equals(suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetPubKey()))
+}
+
+func (suite *KeeperTestSuite) TestRotatePubKeyVestingAccount() {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	vesting := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	baseAcc := types.NewBaseAccount(addr, pubKey, 0, 0)
+	suite.setAccount(vestingtypes.NewContinuousVestingAccount(baseAcc, vesting, 0, 100))
+
+	newPriv := secp256k1.GenPrivKey()
+	suite.Require().NoError(suite.app.AccountKeeper.RotatePubKey(suite.ctx, addr, newPriv.PubKey(), suite.rotationSig(addr, pubKey, newPriv)))
+
+	// the vesting schedule is kept
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(vesting, acc.GetOriginalVesting())
+	suite.Require().Equal(int64(100), acc.GetEndTime())
+}
+
+func (suite *KeeperTestSuite) TestRotatePubKeyCooldown() {
+	ak := suite.app.AccountKeeper
+	cooldown := ak.GetPubKeyRotationCooldown(suite.ctx)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	rotate := func(ctx sdk.Context) error {
+		oldPubKey := ak.GetAccount(ctx, addr).GetPubKey()
+		newPriv := secp256k1.GenPrivKey()
+		return ak.RotatePubKey(ctx, addr, newPriv.PubKey(), suite.rotationSig(addr, oldPubKey, newPriv))
+	}
+
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	suite.Require().NoError(rotate(ctx))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(cooldown - time.Second))
+	suite.Require().ErrorIs(rotate(ctx), sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	suite.Require().NoError(rotate(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgRotatePubKey() {
+	pubKey, newPriv := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey()
+	newPubKey := newPriv.PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+
+	msg, err := types.NewMsgRotatePubKey(addr, newPubKey, suite.rotationSig(addr, pubKey, newPriv))
+	suite.Require().NoError(err)
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal([]sdk.AccAddress{addr}, msg.GetSigners())
+
+	noSig := *msg
+	noSig.NewPubKeySignature = nil
+	suite.Require().Error(noSig.ValidateBasic())
+	suite.Require().NotEmpty(msg.GetSignBytes())
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.AccountKeeper)
+	_, err = msgServer.RotatePubKey(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	suite.Require().True(newPubKey.Equals(suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetPubKey()))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryPubKeyHistory() {
+	ak := suite.app.AccountKeeper
+	privs := []cryptotypes.PrivKey{
+		secp256k1.GenPrivKey(),
+		secp256k1.GenPrivKey(),
+		ed25519.GenPrivKey(),
+	}
+	pubKeys := make([]cryptotypes.PubKey, len(privs))
+	for i, priv := range privs {
+		pubKeys[i] = priv.PubKey()
+	}
+	addr := sdk.AccAddress(pubKeys[0].Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKeys[0], 0, 0))
+
+	// another account rotated in between
+	_, otherPubKey, other := testdata.KeyTestPubAddr()
+	suite.setAccount(types.NewBaseAccount(other, otherPubKey, 1, 0))
+
+	ctx := suite.ctx
+	for i, priv := range privs[1:] {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(time.Unix(0, 0).Add(time.Duration(i) * ak.GetPubKeyRotationCooldown(ctx)))
+		suite.Require().NoError(ak.RotatePubKey(ctx, addr, priv.PubKey(), suite.rotationSig(addr, pubKeys[i], priv)))
+		if i == 0 {
+			otherPriv := secp256k1.GenPrivKey()
+			suite.Require().NoError(ak.RotatePubKey(ctx, other, otherPriv.PubKey(), suite.rotationSig(other, otherPubKey, otherPriv)))
+		}
+	}
+
+	_, err := suite.queryClient.PubKeyHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPubKeyHistoryRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.PubKeyHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPubKeyHistoryRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Rotations, 2)
+	for i, rotation := range res.Rotations {
+		suite.Require().True(pubKeys[i].Equals(rotation.GetOldPubKeyValue()))
+		suite.Require().True(pubKeys[i+1].Equals(rotation.GetNewPubKeyValue()))
+		suite.Require().Equal(int64(i+1), rotation.Height)
+	}
+
+	res, err = suite.queryClient.PubKeyHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPubKeyHistoryRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Rotations, 1)
+	suite.Require().True(pubKeys[2].Equals(res.Rotations[0].GetNewPubKeyValue()))
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestPubKeyRotationsGenesis() {
+	pubKey, newPriv := secp256k1.GenPrivKey().PubKey(), ed25519.GenPrivKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	suite.setAccount(types.NewBaseAccount(addr, pubKey, 0, 0))
+	suite.Require().NoError(suite.app.AccountKeeper.RotatePubKey(suite.ctx, addr, newPriv.PubKey(), suite.rotationSig(addr, pubKey, newPriv)))
+
+	genState := auth.ExportGenesis(suite.ctx, suite.app.AccountKeeper)
+	suite.Require().Len(genState.PubKeyRotations, 1)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+
+	suite.SetupTest() // reset
+	auth.InitGenesis(suite.ctx, suite.app.AccountKeeper, *genState)
+	suite.Require().Equal(genState.PubKeyRotations, auth.ExportGenesis(suite.ctx, suite.app.AccountKeeper).PubKeyRotations)
+	suite.Require().True(genState.PubKeyRotations[0].GetNewPubKeyValue().Equals(suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetPubKey()))
+}
+
+func (suite *KeeperTestSuite) setAccount(acc types.AccountI) {
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccount(suite.ctx, acc))
+}
+
+// rotationSig returns the signature by newPriv of the rotation of the pubkey
+// oldPubKey of the account at addr.
+func (suite *KeeperTestSuite) rotationSig(addr sdk.AccAddress, oldPubKey cryptotypes.PubKey, newPriv cryptotypes.PrivKey) signing.SignatureData {
+	sig, err := newPriv.Sign(types.PubKeyRotationSignBytes(suite.ctx.ChainID(), addr, oldPubKey))
+	suite.Require().NoError(err)
+
+	return &signing.SingleSignatureData{Signature: sig}
+}
@@ -232,13 +232,17 @@ func TestMigrate(t *testing.T) {
       "sequence": "0"
     }
   ],
+  "authenticator_states": [],
+  "authenticators": [],
   "params": {
     "max_memo_characters": "10",
+    "pub_key_rotation_cooldown": "0s",
     "sig_verify_cost_ed25519": "40",
     "sig_verify_cost_secp256k1": "50",
     "tx_sig_limit": "20",
     "tx_size_cost_per_byte": "30"
-  }
+  },
+  "pub_key_rotations": []
 }`
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
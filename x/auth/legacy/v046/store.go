@@ -0,0 +1,17 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting the PubKeyRotationCooldown param to its default value.
+func MigrateStore(ctx sdk.Context, paramSubspace paramtypes.Subspace) {
+	if !paramSubspace.Has(ctx, types.KeyPubKeyRotationCooldown) {
+		paramSubspace.Set(ctx, types.KeyPubKeyRotationCooldown, types.DefaultPubKeyRotationCooldown)
+	}
+}
@@ -0,0 +1,38 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	subspace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the params of v0.45, without the PubKeyRotationCooldown param
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) != string(types.KeyPubKeyRotationCooldown) {
+			subspace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	require.False(t, subspace.Has(ctx, types.KeyPubKeyRotationCooldown))
+
+	v046.MigrateStore(ctx, subspace)
+
+	params = types.Params{}
+	subspace.GetParamSet(ctx, &params)
+	require.Equal(t, types.DefaultParams(), params)
+}
@@ -29,6 +29,8 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+
+	_ module.AppModuleGenesisStream = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -60,6 +62,29 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	return types.ValidateGenesis(data)
 }
 
+// ValidateGenesisStream performs genesis state validation for the auth module,
+// along with the accounts streamed from a split genesis directory.
+func (AppModuleBasic) ValidateGenesisStream(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage, stream *module.GenesisStream) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	v, err := types.NewGenesisAccountsValidator(data)
+	if err != nil {
+		return err
+	}
+
+	return stream.ReadJSON(keeper.GenesisAccountsCollection, func(bz json.RawMessage) error {
+		var acc types.GenesisAccount
+		if err := cdc.UnmarshalInterfaceJSON(bz, &acc); err != nil {
+			return err
+		}
+
+		return v.Validate(acc)
+	})
+}
+
 // RegisterRESTRoutes registers the REST routes for the auth module.
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
 	rest.RegisterRoutes(clientCtx, rtr, types.StoreKey)
@@ -74,7 +99,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 
 // GetTxCmd returns the root tx command for the auth module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns the root query command for the auth module.
@@ -113,7 +138,9 @@ func (AppModule) Name() string {
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the auth module.
-func (AppModule) Route() sdk.Route { return sdk.Route{} }
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(types.RouterKey, NewHandler(am.accountKeeper))
+}
 
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
@@ -125,15 +152,21 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 	return keeper.NewQuerier(am.accountKeeper, legacyQuerierCdc)
 }
 
-// RegisterServices registers a GRPC query service to respond to the
-// module-specific GRPC queries.
+// RegisterServices registers the module's Msg service and a GRPC query
+// service to respond to the module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.accountKeeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.accountKeeper)
 	m := keeper.NewMigrator(am.accountKeeper, cfg.QueryServer())
 	err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
 	if err != nil {
 		panic(err)
 	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the auth module. It returns
@@ -152,8 +185,31 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// InitGenesisStream performs genesis initialization for the auth module from a
+// split genesis directory, where accounts are streamed. It returns no validator
+// updates.
+func (am AppModule) InitGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage, stream *module.GenesisStream) ([]abci.ValidatorUpdate, error) {
+	var genesisState types.GenesisState
+	if err := cdc.UnmarshalJSON(data, &genesisState); err != nil {
+		return nil, err
+	}
+
+	return []abci.ValidatorUpdate{}, am.accountKeeper.InitGenesisStream(ctx, genesisState, stream)
+}
+
+// ExportGenesisStream streams the accounts to stream and returns the rest of
+// the auth module's exported genesis state as raw bytes.
+func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, stream *module.GenesisStream) (json.RawMessage, error) {
+	gs, err := am.accountKeeper.ExportGenesisStream(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSON(gs)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // AppModuleSimulation functions
 
@@ -34,6 +34,30 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("%v\n%v", accA, accB)
 
+		case bytes.Equal(kvA.Key[:1], types.PubKeyRotationKeyPrefix):
+			var rotationA, rotationB types.PubKeyRotation
+			ak.GetCodec().MustUnmarshal(kvA.Value, &rotationA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &rotationB)
+
+			return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+
+		case bytes.Equal(kvA.Key[:1], types.AuthenticatorKeyPrefix):
+			var authenticatorA, authenticatorB types.AccountAuthenticator
+			ak.GetCodec().MustUnmarshal(kvA.Value, &authenticatorA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &authenticatorB)
+
+			return fmt.Sprintf("%v\n%v", authenticatorA, authenticatorB)
+
+		case bytes.Equal(kvA.Key[:1], types.AuthenticatorStateKeyPrefix):
+			return fmt.Sprintf("AuthenticatorStateA: %X\nAuthenticatorStateB: %X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key, types.NextAuthenticatorIDKey):
+			var nextIDA, nextIDB gogotypes.UInt64Value
+			ak.GetCodec().MustUnmarshal(kvA.Value, &nextIDA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &nextIDB)
+
+			return fmt.Sprintf("NextAuthenticatorIDA: %d\nNextAuthenticatorIDB: %d", nextIDA.Value, nextIDB.Value)
+
 		case bytes.Equal(kvA.Key, types.GlobalAccountNumberKey):
 			var globalAccNumberA, globalAccNumberB gogotypes.UInt64Value
 			ak.GetCodec().MustUnmarshal(kvA.Value, &globalAccNumberA)
@@ -30,6 +30,7 @@ func TestDecodeStore(t *testing.T) {
 	require.NoError(t, err)
 
 	globalAccNumber := gogotypes.UInt64Value{Value: 10}
+	authenticator := types.NewAccountAuthenticator(delAddr1, 1, "MessageFilter", []byte(`["/cosmos.bank.v1beta1.MsgSend"]`))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -41,6 +42,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.GlobalAccountNumberKey,
 				Value: cdc.MustMarshal(&globalAccNumber),
 			},
+			{
+				Key:   types.AuthenticatorStoreKey(delAddr1, 1),
+				Value: cdc.MustMarshal(&authenticator),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -53,6 +58,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
 		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
+		{"Authenticator", fmt.Sprintf("%v\n%v", authenticator, authenticator)},
 		{"other", ""},
 	}
 
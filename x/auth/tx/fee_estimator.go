@@ -0,0 +1,130 @@
+package tx
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	pagination "github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// DefaultFeeEstimateBlocks is the number of recent blocks sampled by the fee
+	// estimator when the request does not set it.
+	DefaultFeeEstimateBlocks = 20
+	// MaxFeeEstimateBlocks is the maximum number of recent blocks sampled by the
+	// fee estimator.
+	MaxFeeEstimateBlocks = 100
+
+	// Percentiles of the fee per gas sampled suggested as low, medium and high
+	// gas prices.
+	lowFeePercentile    = 25
+	mediumFeePercentile = 50
+	highFeePercentile   = 90
+)
+
+// feeEstimatorServer is the server for the protobuf FeeEstimator service,
+// sampling the fees paid in recent blocks through the Tx service.
+type feeEstimatorServer struct {
+	txService txtypes.ServiceServer
+}
+
+// NewFeeEstimatorServer creates a new FeeEstimator service server reading
+// recent blocks through the given Tx service server.
+func NewFeeEstimatorServer(txService txtypes.ServiceServer) txtypes.FeeEstimatorServer {
+	return feeEstimatorServer{txService: txService}
+}
+
+var _ txtypes.FeeEstimatorServer = feeEstimatorServer{}
+
+// EstimateFee implements the FeeEstimator.EstimateFee RPC method. Each
+// transaction of the sampled blocks is a sample of the fee per gas, its fee
+// divided by its gas limit, of each denom it paid fees in.
+func (s feeEstimatorServer) EstimateFee(ctx context.Context, req *txtypes.EstimateFeeRequest) (*txtypes.EstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	blocks := int64(req.Blocks)
+	switch {
+	case blocks == 0:
+		blocks = DefaultFeeEstimateBlocks
+	case blocks > MaxFeeEstimateBlocks:
+		blocks = MaxFeeEstimateBlocks
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height < blocks {
+		blocks = height
+	}
+
+	samples := make(map[string][]sdk.Dec)
+	for h := height; h > height-blocks; h-- {
+		for offset := uint64(0); ; {
+			res, err := s.txService.GetBlockWithTxs(ctx, &txtypes.GetBlockWithTxsRequest{
+				Height:     h,
+				Pagination: &pagination.PageRequest{Offset: offset, Limit: pagination.DefaultLimit},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, tx := range res.Txs {
+				fee := tx.GetAuthInfo().GetFee()
+				if fee == nil || fee.GasLimit == 0 {
+					continue
+				}
+
+				for _, coin := range fee.Amount {
+					samples[coin.Denom] = append(samples[coin.Denom], coin.Amount.ToDec().QuoInt64(int64(fee.GasLimit)))
+				}
+			}
+
+			offset += uint64(len(res.Txs))
+			if len(res.Txs) == 0 || offset >= res.Pagination.GetTotal() {
+				break
+			}
+		}
+	}
+
+	estimates := make([]txtypes.GasPriceEstimate, 0, len(samples))
+	for denom, prices := range samples {
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+
+		estimates = append(estimates, txtypes.GasPriceEstimate{
+			Denom:   denom,
+			Low:     percentile(prices, lowFeePercentile),
+			Medium:  percentile(prices, mediumFeePercentile),
+			High:    percentile(prices, highFeePercentile),
+			Samples: uint64(len(prices)),
+		})
+	}
+
+	sort.Slice(estimates, func(i, j int) bool {
+		if estimates[i].Samples != estimates[j].Samples {
+			return estimates[i].Samples > estimates[j].Samples
+		}
+
+		return estimates[i].Denom < estimates[j].Denom
+	})
+
+	return &txtypes.EstimateFeeResponse{
+		Height:    height,
+		Blocks:    uint32(blocks),
+		Estimates: estimates,
+	}, nil
+}
+
+// percentile returns the nearest-rank percentile p of the given sorted values.
+func percentile(sorted []sdk.Dec, p int) sdk.Dec {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,105 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// mockTxService serves blocks whose transactions pay the given fees for 1000
+// gas each.
+type mockTxService struct {
+	txtypes.ServiceServer
+
+	fees map[int64][]sdk.Coins
+}
+
+func (m mockTxService) GetBlockWithTxs(_ context.Context, req *txtypes.GetBlockWithTxsRequest) (*txtypes.GetBlockWithTxsResponse, error) {
+	blockFees := m.fees[req.Height]
+
+	var txs []*txtypes.Tx
+	for i := req.Pagination.Offset; i < uint64(len(blockFees)) && uint64(len(txs)) < req.Pagination.Limit; i++ {
+		txs = append(txs, &txtypes.Tx{
+			AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{Amount: blockFees[i], GasLimit: 1000}},
+		})
+	}
+
+	return &txtypes.GetBlockWithTxsResponse{
+		Txs:        txs,
+		Pagination: &query.PageResponse{Total: uint64(len(blockFees))},
+	}, nil
+}
+
+func TestEstimateFee(t *testing.T) {
+	fees := map[int64][]sdk.Coins{
+		1: {sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))},
+		2: {},
+		3: {},
+		4: {sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+	}
+	// 150 txs, more than a page, paying from 1 to 150 stake.
+	for i := int64(1); i <= 150; i++ {
+		fees[3] = append(fees[3], sdk.NewCoins(sdk.NewInt64Coin("stake", i)))
+	}
+	fees[3] = append(fees[3], sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 1000)), nil)
+
+	server := NewFeeEstimatorServer(mockTxService{fees: fees})
+	ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithBlockHeader(tmproto.Header{Height: 4}))
+
+	_, err := server.EstimateFee(ctx, nil)
+	require.Error(t, err)
+
+	res, err := server.EstimateFee(ctx, &txtypes.EstimateFeeRequest{Blocks: 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), res.Height)
+	require.Equal(t, uint32(3), res.Blocks)
+	require.Equal(t, []txtypes.GasPriceEstimate{
+		{
+			Denom:   "stake",
+			Low:     sdk.NewDecWithPrec(37, 3),
+			Medium:  sdk.NewDecWithPrec(75, 3),
+			High:    sdk.NewDecWithPrec(136, 3),
+			Samples: 152,
+		},
+		{
+			Denom:   "atom",
+			Low:     sdk.NewDecWithPrec(5, 3),
+			Medium:  sdk.NewDecWithPrec(5, 3),
+			High:    sdk.NewDecWithPrec(5, 3),
+			Samples: 1,
+		},
+	}, res.Estimates)
+
+	// The blocks sampled are capped by the current height.
+	res, err = server.EstimateFee(ctx, &txtypes.EstimateFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), res.Blocks)
+	require.Len(t, res.Estimates, 2)
+	require.Equal(t, sdk.OneDec(), res.Estimates[1].High)
+
+	// No fee was paid in the most recent blocks.
+	res, err = server.EstimateFee(sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithBlockHeader(tmproto.Header{Height: 3})), &txtypes.EstimateFeeRequest{Blocks: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Estimates, 2)
+
+	res, err = server.EstimateFee(sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()).WithBlockHeader(tmproto.Header{Height: 2})), &txtypes.EstimateFeeRequest{Blocks: 1})
+	require.NoError(t, err)
+	require.Empty(t, res.Estimates)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []sdk.Dec{sdk.NewDec(1), sdk.NewDec(2), sdk.NewDec(3), sdk.NewDec(4)}
+
+	require.Equal(t, sdk.NewDec(1), percentile(values, 0))
+	require.Equal(t, sdk.NewDec(1), percentile(values, 25))
+	require.Equal(t, sdk.NewDec(2), percentile(values, 50))
+	require.Equal(t, sdk.NewDec(4), percentile(values, 90))
+	require.Equal(t, sdk.NewDec(4), percentile(values, 100))
+	require.Equal(t, sdk.NewDec(7), percentile([]sdk.Dec{sdk.NewDec(7)}, 50))
+}
@@ -198,7 +198,7 @@ func (s txServer) GetBlockWithTxs(ctx context.Context, req *txtypes.GetBlockWith
 	blockTxs := block.Data.Txs
 	blockTxsLn := uint64(len(blockTxs))
 	txs := make([]*txtypes.Tx, 0, limit)
-	if offset >= blockTxsLn {
+	if offset >= blockTxsLn && blockTxsLn != 0 {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("out of range: cannot paginate %d txs with offset %d and limit %d", blockTxsLn, offset, limit)
 	}
 	decodeTxAt := func(i uint64) error {
@@ -242,23 +242,24 @@ func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxReque
 	return client.TxServiceBroadcast(ctx, s.clientCtx, req)
 }
 
-// RegisterTxService registers the tx service on the gRPC router.
+// RegisterTxService registers the tx service, along with the fee estimator
+// service reading blocks through it, on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
 ) {
-	txtypes.RegisterServiceServer(
-		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
-	)
+	txServer := NewTxServer(clientCtx, simulateFn, interfaceRegistry)
+	txtypes.RegisterServiceServer(qrt, txServer)
+	txtypes.RegisterFeeEstimatorServer(qrt, NewFeeEstimatorServer(txServer))
 }
 
-// RegisterGRPCGatewayRoutes mounts the tx service's GRPC-gateway routes on the
-// given Mux.
+// RegisterGRPCGatewayRoutes mounts the tx and fee estimator services' GRPC-gateway
+// routes on the given Mux.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
 	txtypes.RegisterServiceHandlerClient(context.Background(), mux, txtypes.NewServiceClient(clientConn))
+	txtypes.RegisterFeeEstimatorHandlerClient(context.Background(), mux, txtypes.NewFeeEstimatorClient(clientConn))
 }
 
 func parseOrderBy(orderBy txtypes.OrderBy) string {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -460,6 +461,35 @@ func (s IntegrationTestSuite) TestBroadcastTx_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestBroadcastTx_GRPCWait() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	timeout := 30 * time.Second
+	grpcRes, err := s.queryClient.BroadcastTx(context.Background(), &tx.BroadcastTxRequest{
+		Mode:    tx.BroadcastMode_BROADCAST_MODE_WAIT,
+		TxBytes: txBytes,
+		Timeout: &timeout,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), grpcRes.TxResponse.Code, grpcRes.TxResponse.RawLog)
+	s.Require().Greater(grpcRes.TxResponse.Height, int64(0))
+	s.Require().NotEmpty(grpcRes.TxResponse.Events)
+	s.Require().NotEmpty(grpcRes.TxResponse.Timestamp)
+	s.Require().NotNil(grpcRes.TxResponse.Tx)
+
+	// A tx which fails CheckTx is returned without waiting.
+	grpcRes, err = s.queryClient.BroadcastTx(context.Background(), &tx.BroadcastTxRequest{
+		Mode:    tx.BroadcastMode_BROADCAST_MODE_WAIT,
+		TxBytes: txBytes,
+	})
+	s.Require().NoError(err)
+	s.Require().NotEqual(uint32(0), grpcRes.TxResponse.Code)
+	s.Require().Equal(int64(0), grpcRes.TxResponse.Height)
+}
+
 func (s IntegrationTestSuite) TestBroadcastTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
@@ -663,6 +693,99 @@ func (s IntegrationTestSuite) TestGetBlockWithTxs_GRPCGateway() {
 	}
 }
 
+func (s IntegrationTestSuite) TestEstimateFee_GRPC() {
+	val := s.network.Validators[0]
+	queryClient := tx.NewFeeEstimatorClient(val.ClientCtx)
+
+	testCases := []struct {
+		name      string
+		req       *tx.EstimateFeeRequest
+		expErr    bool
+		expBlocks uint32
+	}{
+		{"nil request", nil, true, 0},
+		{"default blocks", &tx.EstimateFeeRequest{}, false, 20},
+		{"too many blocks", &tx.EstimateFeeRequest{Blocks: 1000}, false, 100},
+		{"txs block only", &tx.EstimateFeeRequest{Blocks: uint32(s.txHeight - 1)}, false, uint32(s.txHeight - 1)},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := queryClient.EstimateFee(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(res.Blocks, tc.expBlocks)
+			s.Require().GreaterOrEqual(res.Height, s.txHeight)
+			s.Require().NotEmpty(res.Estimates)
+
+			// The txs sent in SetupSuite paid 10 stake for 200000 gas.
+			estimate := res.Estimates[0]
+			s.Require().Equal(s.cfg.BondDenom, estimate.Denom)
+			s.Require().GreaterOrEqual(estimate.Samples, uint64(2))
+			s.Require().True(estimate.Low.LTE(estimate.Medium))
+			s.Require().True(estimate.Medium.LTE(estimate.High))
+			s.Require().True(estimate.Low.IsPositive())
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestEstimateFee_GRPCGateway() {
+	val := s.network.Validators[0]
+
+	res, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/estimate_fee?blocks=%d", val.APIAddress, 5))
+	s.Require().NoError(err)
+
+	var result tx.EstimateFeeResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res, &result))
+	s.Require().Equal(uint32(5), result.Blocks)
+}
+
+func (s IntegrationTestSuite) TestFeeStrategy() {
+	val := s.network.Validators[0]
+
+	out, err := bankcli.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		val.Address,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1))),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", flags.FlagFeeStrategy, flags.FeeStrategyHigh),
+		fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+	)
+	s.Require().NoError(err)
+
+	generated, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	fee := generated.(sdk.FeeTx).GetFee()
+	s.Require().Equal(1, len(fee))
+	s.Require().Equal(s.cfg.BondDenom, fee[0].Denom)
+	s.Require().True(fee[0].Amount.IsPositive())
+
+	_, err = bankcli.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		val.Address,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1))),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", flags.FlagFeeStrategy, flags.FeeStrategyHigh),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().Error(err)
+
+	_, err = bankcli.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		val.Address,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1))),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=cheapest", flags.FlagFeeStrategy),
+	)
+	s.Require().Error(err)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
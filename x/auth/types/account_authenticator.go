@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAccountAuthenticator returns a new AccountAuthenticator.
+//
+//nolint:interfacer
+func NewAccountAuthenticator(addr sdk.AccAddress, id uint64, authenticatorType string, config []byte) AccountAuthenticator {
+	return AccountAuthenticator{
+		Address: addr.String(),
+		Id:      id,
+		Type:    authenticatorType,
+		Config:  config,
+	}
+}
+
+// GetAccountAddress returns the address of the account of the authenticator.
+func (a AccountAuthenticator) GetAccountAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(a.Address)
+	return addr
+}
+
+// Validate performs a basic validation of the authenticator.
+func (a AccountAuthenticator) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return err
+	}
+	if strings.TrimSpace(a.Type) == "" {
+		return fmt.Errorf("missing type of authenticator %d of %s", a.Id, a.Address)
+	}
+
+	return nil
+}
+
+// ValidateAuthenticators validates the authenticators of the genesis, whose ids
+// must be unique, and the entries of their state, which must belong to one of
+// them.
+func ValidateAuthenticators(authenticators []AccountAuthenticator, states []AuthenticatorState) error {
+	addrs := make(map[uint64]string, len(authenticators))
+	for _, a := range authenticators {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := addrs[a.Id]; ok {
+			return fmt.Errorf("duplicate authenticator id %d", a.Id)
+		}
+		addrs[a.Id] = a.Address
+	}
+
+	for _, s := range states {
+		if addrs[s.AuthenticatorId] != s.Address {
+			return fmt.Errorf("state of unknown authenticator %d of %s", s.AuthenticatorId, s.Address)
+		}
+		if len(s.Key) == 0 {
+			return fmt.Errorf("empty state key of authenticator %d of %s", s.AuthenticatorId, s.Address)
+		}
+	}
+
+	return nil
+}
@@ -8,16 +8,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,6 +118,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// pub_key_rotation_cooldown is the minimum time between two rotations of the
+	// public key of an account.
+	PubKeyRotationCooldown time.Duration `protobuf:"bytes,6,opt,name=pub_key_rotation_cooldown,json=pubKeyRotationCooldown,proto3,stdduration" json:"pub_key_rotation_cooldown" yaml:"pub_key_rotation_cooldown"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,59 +190,312 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetPubKeyRotationCooldown() time.Duration {
+	if m != nil {
+		return m.PubKeyRotationCooldown
+	}
+	return 0
+}
+
+// PubKeyRotation defines the rotation of the public key of an account by a
+// MsgRotatePubKey.
+type PubKeyRotation struct {
+	Address   string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	OldPubKey *types.Any `protobuf:"bytes,2,opt,name=old_pub_key,json=oldPubKey,proto3" json:"old_pub_key,omitempty" yaml:"old_pub_key"`
+	NewPubKey *types.Any `protobuf:"bytes,3,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty" yaml:"new_pub_key"`
+	// height is the block height of the rotation.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PubKeyRotation) Reset()         { *m = PubKeyRotation{} }
+func (m *PubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotation) ProtoMessage()    {}
+func (*PubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *PubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotation.Merge(m, src)
+}
+func (m *PubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotation proto.InternalMessageInfo
+
+func (m *PubKeyRotation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyRotation) GetOldPubKey() *types.Any {
+	if m != nil {
+		return m.OldPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetNewPubKey() *types.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PubKeyRotation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// AccountAuthenticator defines an authenticator attached to an account by a
+// MsgAddAuthenticator, which authenticates the txs signed for the account with
+// another key than its public key.
+type AccountAuthenticator struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// id is the identifier of the authenticator, unique across all accounts.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the type of the authenticator, as registered in the authenticator
+	// registry of the app.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// config is the configuration of the authenticator, whose format depends on
+	// its type.
+	Config []byte `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *AccountAuthenticator) Reset()         { *m = AccountAuthenticator{} }
+func (m *AccountAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticator) ProtoMessage()    {}
+func (*AccountAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *AccountAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticator.Merge(m, src)
+}
+func (m *AccountAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticator proto.InternalMessageInfo
+
+func (m *AccountAuthenticator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountAuthenticator) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AccountAuthenticator) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AccountAuthenticator) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// AuthenticatorState defines an entry of the state kept by an authenticator,
+// such as the coins spent under a spend limit.
+type AuthenticatorState struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// authenticator_id is the identifier of the authenticator keeping the entry.
+	AuthenticatorId uint64 `protobuf:"varint,2,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty" yaml:"authenticator_id"`
+	Key             []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value           []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *AuthenticatorState) Reset()         { *m = AuthenticatorState{} }
+func (m *AuthenticatorState) String() string { return proto.CompactTextString(m) }
+func (*AuthenticatorState) ProtoMessage()    {}
+func (*AuthenticatorState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *AuthenticatorState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthenticatorState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthenticatorState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthenticatorState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticatorState.Merge(m, src)
+}
+func (m *AuthenticatorState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthenticatorState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticatorState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticatorState proto.InternalMessageInfo
+
+func (m *AuthenticatorState) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AuthenticatorState) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func (m *AuthenticatorState) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AuthenticatorState) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*PubKeyRotation)(nil), "cosmos.auth.v1beta1.PubKeyRotation")
+	proto.RegisterType((*AccountAuthenticator)(nil), "cosmos.auth.v1beta1.AccountAuthenticator")
+	proto.RegisterType((*AuthenticatorState)(nil), "cosmos.auth.v1beta1.AuthenticatorState")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x5f, 0xf2, 0xf8, 0x98, 0x00, 0x12, 0x26, 0x80, 0x93, 0xf7, 0x64, 0x5b, 0x5e, 0xe5,
-	0x49, 0x2f, 0x8e, 0x92, 0x8a, 0x4a, 0x64, 0x51, 0x15, 0xd3, 0x2e, 0x50, 0x0b, 0x42, 0x46, 0xea,
-	0xa2, 0xaa, 0xe4, 0x8e, 0x9d, 0xc1, 0x58, 0x64, 0x32, 0xc6, 0x33, 0x46, 0x31, 0xbf, 0xa0, 0xcb,
-	0x2e, 0xbb, 0xe4, 0x47, 0xf0, 0x0f, 0xba, 0xe9, 0x12, 0xb1, 0xea, 0xca, 0xad, 0xc2, 0xa6, 0xea,
-	0x32, 0xfb, 0x4a, 0x95, 0x67, 0x9c, 0x90, 0xa0, 0x74, 0x95, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x9d,
-	0x7b, 0xe3, 0x01, 0xaa, 0x47, 0x28, 0x26, 0xb4, 0x09, 0x63, 0x76, 0xd6, 0xbc, 0x6c, 0xb9, 0x88,
-	0xc1, 0x16, 0x0f, 0xcc, 0x30, 0x22, 0x8c, 0xc8, 0x1b, 0x82, 0x37, 0x39, 0x94, 0xf3, 0xb5, 0xaa,
-	0x00, 0x1d, 0x2e, 0x69, 0xe6, 0x0a, 0x1e, 0xd4, 0x2a, 0x3e, 0xf1, 0x89, 0xc0, 0xb3, 0x53, 0x8e,
-	0x56, 0x7d, 0x42, 0xfc, 0x1e, 0x6a, 0xf2, 0xc8, 0x8d, 0x4f, 0x9b, 0xb0, 0x9f, 0x08, 0xca, 0xf8,
-	0x25, 0x81, 0xb2, 0x05, 0x29, 0xda, 0xf3, 0x3c, 0x12, 0xf7, 0x99, 0xac, 0x80, 0x45, 0xd8, 0xed,
-	0x46, 0x88, 0x52, 0x45, 0xd2, 0xa5, 0xfa, 0xb2, 0x3d, 0x0e, 0xe5, 0x77, 0x60, 0x31, 0x8c, 0x5d,
-	0xe7, 0x1c, 0x25, 0xca, 0x5f, 0xba, 0x54, 0x2f, 0xb7, 0x2b, 0xa6, 0xb0, 0x35, 0xc7, 0xb6, 0xe6,
-	0x5e, 0x3f, 0xb1, 0x1a, 0x3f, 0x53, 0xad, 0x12, 0xc6, 0x6e, 0x2f, 0xf0, 0x32, 0xed, 0xff, 0x04,
-	0x07, 0x0c, 0xe1, 0x90, 0x25, 0xa3, 0x54, 0x5b, 0x4f, 0x20, 0xee, 0x75, 0x8c, 0x07, 0xd6, 0xb0,
-	0x17, 0xc2, 0xd8, 0x7d, 0x85, 0x12, 0xf9, 0x39, 0x58, 0x83, 0xa2, 0x05, 0xa7, 0x1f, 0x63, 0x17,
-	0x45, 0x4a, 0x51, 0x97, 0xea, 0x25, 0xab, 0x3a, 0x4a, 0xb5, 0x4d, 0x91, 0x36, 0xcb, 0x1b, 0xf6,
-	0x6a, 0x0e, 0x1c, 0xf1, 0x58, 0xae, 0x81, 0x25, 0x8a, 0x2e, 0x62, 0xd4, 0xf7, 0x90, 0x52, 0xca,
-	0x72, 0xed, 0x49, 0xdc, 0x51, 0x3e, 0x5c, 0x6b, 0x85, 0x4f, 0xd7, 0x5a, 0xe1, 0xc7, 0xb5, 0x56,
-	0xb8, 0xbb, 0x69, 0x2c, 0xe5, 0xd7, 0x3d, 0x30, 0x3e, 0x4b, 0x60, 0xf5, 0x90, 0x74, 0xe3, 0xde,
-	0x64, 0x02, 0xef, 0xc1, 0x8a, 0x0b, 0x29, 0x72, 0x72, 0x77, 0x3e, 0x86, 0x72, 0x5b, 0x37, 0xe7,
-	0x6c, 0xc2, 0x9c, 0x9a, 0x9c, 0xf5, 0xcf, 0x6d, 0xaa, 0x49, 0xa3, 0x54, 0xdb, 0x10, 0xdd, 0x4e,
-	0x7b, 0x18, 0x76, 0xd9, 0x9d, 0x9a, 0xb1, 0x0c, 0x4a, 0x7d, 0x88, 0x11, 0x1f, 0xe3, 0xb2, 0xcd,
-	0xcf, 0xb2, 0x0e, 0xca, 0x21, 0x8a, 0x70, 0x40, 0x69, 0x40, 0xfa, 0x54, 0x29, 0xea, 0xc5, 0xfa,
-	0xb2, 0x3d, 0x0d, 0x75, 0x6a, 0xe3, 0x3b, 0xdc, 0xdd, 0x34, 0xd6, 0x66, 0x5a, 0x3e, 0x30, 0xbe,
-	0x15, 0xc1, 0xc2, 0x31, 0x8c, 0x20, 0xa6, 0xf2, 0x11, 0xd8, 0xc0, 0x70, 0xe0, 0x60, 0x84, 0x89,
-	0xe3, 0x9d, 0xc1, 0x08, 0x7a, 0x0c, 0x45, 0x62, 0x99, 0x25, 0x4b, 0x1d, 0xa5, 0x5a, 0x4d, 0xf4,
-	0x37, 0x47, 0x64, 0xd8, 0xeb, 0x18, 0x0e, 0x0e, 0x11, 0x26, 0xfb, 0x13, 0x4c, 0xde, 0x05, 0x2b,
-	0x6c, 0xe0, 0xd0, 0xc0, 0x77, 0x7a, 0x01, 0x0e, 0x18, 0x6f, 0xba, 0x64, 0x6d, 0x3f, 0x5c, 0x74,
-	0x9a, 0x35, 0x6c, 0xc0, 0x06, 0x27, 0x81, 0xff, 0x3a, 0x0b, 0x64, 0x1b, 0x6c, 0x72, 0xf2, 0x0a,
-	0x39, 0x1e, 0xa1, 0xcc, 0x09, 0x51, 0xe4, 0xb8, 0x09, 0x43, 0xf9, 0x6a, 0xf5, 0x51, 0xaa, 0xfd,
-	0x3b, 0xe5, 0xf1, 0x58, 0x66, 0xd8, 0xeb, 0x99, 0xd9, 0x15, 0xda, 0x27, 0x94, 0x1d, 0xa3, 0xc8,
-	0x4a, 0x18, 0x92, 0x2f, 0xc0, 0x76, 0x56, 0xed, 0x12, 0x45, 0xc1, 0x69, 0x22, 0xf4, 0xa8, 0xdb,
-	0xde, 0xd9, 0x69, 0xed, 0x8a, 0xa5, 0x5b, 0x9d, 0x61, 0xaa, 0x55, 0x4e, 0x02, 0xff, 0x0d, 0x57,
-	0x64, 0xa9, 0x2f, 0x5f, 0x70, 0x7e, 0x94, 0x6a, 0xaa, 0xa8, 0xf6, 0x07, 0x03, 0xc3, 0xae, 0xd0,
-	0x99, 0x3c, 0x01, 0xcb, 0x09, 0xa8, 0x3e, 0xce, 0xa0, 0xc8, 0x0b, 0xdb, 0x3b, 0x4f, 0xcf, 0x5b,
-	0xca, 0xdf, 0xbc, 0xe8, 0xb3, 0x61, 0xaa, 0x6d, 0xcd, 0x14, 0x3d, 0x19, 0x2b, 0x46, 0xa9, 0xa6,
-	0xcf, 0x2f, 0x3b, 0x31, 0x31, 0xec, 0x2d, 0x3a, 0x37, 0xb7, 0xb3, 0x94, 0xff, 0x67, 0x25, 0x6b,
-	0xff, 0xcb, 0x50, 0x95, 0x6e, 0x87, 0xaa, 0xf4, 0x7d, 0xa8, 0x4a, 0x1f, 0xef, 0xd5, 0xc2, 0xed,
-	0xbd, 0x5a, 0xf8, 0x7a, 0xaf, 0x16, 0xde, 0xfe, 0xe7, 0x07, 0xec, 0x2c, 0x76, 0x4d, 0x8f, 0xe0,
-	0xfc, 0x2d, 0xc8, 0x7f, 0x1a, 0xb4, 0x7b, 0xde, 0x1c, 0x88, 0xa7, 0x85, 0x25, 0x21, 0xa2, 0xee,
-	0x02, 0xff, 0x52, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x49, 0x90, 0x16, 0xd9, 0x76, 0x04,
-	0x00, 0x00,
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0xc5, 0xb1, 0x4e, 0x8e, 0x9b, 0xd0, 0x8a, 0x2d, 0x29, 0x05, 0x29, 0x70, 0x72,
+	0x81, 0x58, 0x82, 0x55, 0xb8, 0x68, 0x34, 0x14, 0x35, 0x9d, 0x16, 0x30, 0x9a, 0x04, 0xc6, 0xb9,
+	0xe8, 0x50, 0x14, 0x60, 0x8f, 0xe4, 0x99, 0x22, 0x4c, 0xf2, 0x18, 0xde, 0xd1, 0x16, 0x33, 0x76,
+	0xea, 0x98, 0xd1, 0x53, 0xe1, 0x1f, 0x91, 0x7f, 0xd0, 0x25, 0xa3, 0x91, 0xa9, 0x13, 0x5b, 0xc8,
+	0x1d, 0x8a, 0x8e, 0xda, 0x0b, 0x14, 0xbc, 0x3b, 0xc9, 0x92, 0xe2, 0x18, 0x99, 0x74, 0xef, 0x7d,
+	0xdf, 0xfb, 0xde, 0xbb, 0x77, 0x7a, 0x8f, 0x40, 0x73, 0x08, 0x0d, 0x09, 0xed, 0xa2, 0x94, 0x0d,
+	0xba, 0xa7, 0x3b, 0x36, 0x66, 0x68, 0x87, 0x1b, 0x9d, 0x38, 0x21, 0x8c, 0xa8, 0xeb, 0x02, 0xef,
+	0x70, 0x97, 0xc4, 0x5b, 0x4d, 0xe1, 0xb4, 0x38, 0xa5, 0x2b, 0x19, 0xdc, 0x68, 0xd5, 0x3d, 0xe2,
+	0x11, 0xe1, 0x2f, 0x4e, 0xd2, 0xdb, 0xf4, 0x08, 0xf1, 0x02, 0xdc, 0xe5, 0x96, 0x9d, 0x1e, 0x77,
+	0x51, 0x94, 0x49, 0x48, 0x5b, 0x84, 0xdc, 0x34, 0x41, 0xcc, 0x27, 0x91, 0xc4, 0xf5, 0x45, 0x9c,
+	0xf9, 0x21, 0xa6, 0x0c, 0x85, 0xb1, 0x20, 0x18, 0xff, 0x29, 0xa0, 0x66, 0x22, 0x8a, 0xf7, 0x1c,
+	0x87, 0xa4, 0x11, 0x53, 0x1b, 0xe0, 0x2e, 0x72, 0xdd, 0x04, 0x53, 0xda, 0x50, 0xda, 0xca, 0x56,
+	0x15, 0x4e, 0x4c, 0xf5, 0x27, 0x70, 0x37, 0x4e, 0x6d, 0xeb, 0x04, 0x67, 0x8d, 0xa5, 0xb6, 0xb2,
+	0x55, 0xeb, 0xd5, 0x3b, 0x42, 0xbc, 0x33, 0x11, 0xef, 0xec, 0x45, 0x99, 0xb9, 0xfd, 0x6f, 0xae,
+	0xd7, 0xe3, 0xd4, 0x0e, 0x7c, 0xa7, 0xe0, 0x3e, 0x26, 0xa1, 0xcf, 0x70, 0x18, 0xb3, 0x6c, 0x9c,
+	0xeb, 0x0f, 0x32, 0x14, 0x06, 0x7d, 0xe3, 0x1a, 0x35, 0xe0, 0x72, 0x9c, 0xda, 0xdf, 0xe1, 0x4c,
+	0xfd, 0x1a, 0xac, 0x21, 0x51, 0x82, 0x15, 0xa5, 0xa1, 0x8d, 0x93, 0x46, 0xb9, 0xad, 0x6c, 0x55,
+	0xcc, 0xe6, 0x38, 0xd7, 0x1f, 0x8a, 0xb0, 0x79, 0xdc, 0x80, 0xf7, 0xa4, 0xe3, 0x05, 0xb7, 0xd5,
+	0x16, 0x58, 0xa1, 0xf8, 0x65, 0x8a, 0x23, 0x07, 0x37, 0x2a, 0x45, 0x2c, 0x9c, 0xda, 0xfd, 0xc6,
+	0xaf, 0x17, 0x7a, 0xe9, 0xfc, 0x42, 0x2f, 0xfd, 0x73, 0xa1, 0x97, 0xde, 0xbd, 0xd9, 0x5e, 0x91,
+	0xd7, 0x3d, 0x30, 0x7e, 0x57, 0xc0, 0xbd, 0xe7, 0xc4, 0x4d, 0x83, 0x69, 0x07, 0x7e, 0x06, 0xab,
+	0x36, 0xa2, 0xd8, 0x92, 0xea, 0xbc, 0x0d, 0xb5, 0x5e, 0xbb, 0x73, 0xc3, 0x53, 0x76, 0x66, 0x3a,
+	0x67, 0x3e, 0xba, 0xcc, 0x75, 0x65, 0x9c, 0xeb, 0xeb, 0xa2, 0xda, 0x59, 0x0d, 0x03, 0xd6, 0xec,
+	0x99, 0x1e, 0xab, 0xa0, 0x12, 0xa1, 0x10, 0xf3, 0x36, 0x56, 0x21, 0x3f, 0xab, 0x6d, 0x50, 0x8b,
+	0x71, 0x12, 0xfa, 0x94, 0xfa, 0x24, 0xa2, 0x8d, 0x72, 0xbb, 0xbc, 0x55, 0x85, 0xb3, 0xae, 0x7e,
+	0x6b, 0x72, 0x87, 0x77, 0x6f, 0xb6, 0xd7, 0xe6, 0x4a, 0x3e, 0x30, 0xfe, 0xae, 0x80, 0xe5, 0x43,
+	0x94, 0xa0, 0x90, 0xaa, 0x2f, 0xc0, 0x7a, 0x88, 0x86, 0x56, 0x88, 0x43, 0x62, 0x39, 0x03, 0x94,
+	0x20, 0x87, 0xe1, 0x44, 0x3c, 0x66, 0xc5, 0xd4, 0xc6, 0xb9, 0xde, 0x12, 0xf5, 0xdd, 0x40, 0x32,
+	0xe0, 0x83, 0x10, 0x0d, 0x9f, 0xe3, 0x90, 0xec, 0x4f, 0x7d, 0xea, 0x13, 0xb0, 0xca, 0x86, 0x16,
+	0xf5, 0x3d, 0x2b, 0xf0, 0x43, 0x9f, 0xf1, 0xa2, 0x2b, 0xe6, 0xe6, 0xf5, 0x45, 0x67, 0x51, 0x03,
+	0x02, 0x36, 0x3c, 0xf2, 0xbd, 0x67, 0x85, 0xa1, 0x42, 0xf0, 0x90, 0x83, 0xaf, 0xb0, 0xe5, 0x10,
+	0xca, 0xac, 0x18, 0x27, 0x96, 0x9d, 0x31, 0x2c, 0x9f, 0xb6, 0x3d, 0xce, 0xf5, 0x4f, 0x67, 0x34,
+	0x16, 0x69, 0x06, 0x7c, 0x50, 0x88, 0xbd, 0xc2, 0xfb, 0x84, 0xb2, 0x43, 0x9c, 0x98, 0x19, 0xc3,
+	0xea, 0x4b, 0xb0, 0x59, 0x64, 0x3b, 0xc5, 0x89, 0x7f, 0x9c, 0x09, 0x3e, 0x76, 0x7b, 0xbb, 0xbb,
+	0x3b, 0x4f, 0xc4, 0xa3, 0x9b, 0xfd, 0x51, 0xae, 0xd7, 0x8f, 0x7c, 0xef, 0x07, 0xce, 0x28, 0x42,
+	0xbf, 0x79, 0xca, 0xf1, 0x71, 0xae, 0x6b, 0x22, 0xdb, 0x07, 0x04, 0x0c, 0x58, 0xa7, 0x73, 0x71,
+	0xc2, 0xad, 0x66, 0xa0, 0xb9, 0x18, 0x41, 0xb1, 0x13, 0xf7, 0x76, 0xbf, 0x38, 0xd9, 0x69, 0xdc,
+	0xe1, 0x49, 0xbf, 0x1a, 0xe5, 0xfa, 0xc6, 0x5c, 0xd2, 0xa3, 0x09, 0x63, 0x9c, 0xeb, 0xed, 0x9b,
+	0xd3, 0x4e, 0x45, 0x0c, 0xb8, 0x41, 0x6f, 0x8c, 0x55, 0x7f, 0x51, 0x40, 0x53, 0x0e, 0x9d, 0x95,
+	0x10, 0xc6, 0x27, 0xdb, 0x72, 0x08, 0x09, 0x5c, 0x72, 0x16, 0x35, 0x96, 0xf9, 0x3f, 0xb3, 0xf9,
+	0xde, 0x18, 0x3e, 0x95, 0x3b, 0xc0, 0x7c, 0xfc, 0x36, 0xd7, 0x4b, 0xd7, 0x05, 0x7c, 0x50, 0xc9,
+	0x38, 0xff, 0x53, 0x57, 0xe0, 0x86, 0x18, 0x45, 0x28, 0xd1, 0x7d, 0x09, 0xf6, 0x57, 0xe4, 0xe0,
+	0x28, 0xc6, 0xf9, 0x12, 0x58, 0x3b, 0x9c, 0x23, 0xdd, 0xb2, 0x2f, 0x9e, 0x81, 0x1a, 0x09, 0x5c,
+	0xeb, 0x63, 0x76, 0xc6, 0xc6, 0x38, 0xd7, 0x55, 0x51, 0xe3, 0x4c, 0x88, 0x01, 0xab, 0x24, 0x70,
+	0x45, 0xbe, 0x42, 0x2d, 0xc2, 0x67, 0x53, 0xb5, 0xf2, 0xc7, 0xa9, 0xcd, 0x84, 0x18, 0xb0, 0x1a,
+	0xe1, 0x33, 0xa9, 0xb6, 0x01, 0x96, 0x07, 0xd8, 0xf7, 0x06, 0x8c, 0xff, 0x69, 0xca, 0x50, 0x5a,
+	0xea, 0x97, 0xa0, 0x52, 0x2c, 0x48, 0xfe, 0xaa, 0xb5, 0x5e, 0xeb, 0x3d, 0xf9, 0xef, 0x27, 0xdb,
+	0xd3, 0x5c, 0x29, 0x5a, 0xfb, 0xba, 0x68, 0x1b, 0x8f, 0x30, 0x02, 0x50, 0x97, 0xd3, 0xb8, 0x97,
+	0xb2, 0x01, 0x8e, 0x98, 0xef, 0x20, 0x46, 0x92, 0x5b, 0xfa, 0xb3, 0x06, 0x96, 0x7c, 0x57, 0x8c,
+	0x13, 0x5c, 0xf2, 0xdd, 0x62, 0x2b, 0xb0, 0x2c, 0x16, 0xc3, 0x51, 0x85, 0xfc, 0x5c, 0xd4, 0xe9,
+	0x90, 0xe8, 0xd8, 0xf7, 0x78, 0x9d, 0xab, 0x50, 0x5a, 0xc6, 0x6f, 0x0a, 0x50, 0xe7, 0xf2, 0x1c,
+	0x31, 0xc4, 0xf0, 0x2d, 0xc9, 0xbe, 0x05, 0xf7, 0xd1, 0x2c, 0xdf, 0x9a, 0xa4, 0x36, 0x1f, 0x8d,
+	0x73, 0x7d, 0x53, 0x2e, 0xd8, 0x05, 0x86, 0x01, 0x3f, 0x99, 0x73, 0x1d, 0xb8, 0xea, 0x7d, 0x50,
+	0x9e, 0xb4, 0x7f, 0x15, 0x16, 0x47, 0xb5, 0x0e, 0xee, 0x9c, 0xa2, 0x20, 0xc5, 0xb2, 0x42, 0x61,
+	0x98, 0xfb, 0x6f, 0x47, 0x9a, 0x72, 0x39, 0xd2, 0x94, 0xbf, 0x46, 0x9a, 0xf2, 0xfa, 0x4a, 0x2b,
+	0x5d, 0x5e, 0x69, 0xa5, 0x3f, 0xae, 0xb4, 0xd2, 0x8f, 0x9f, 0x79, 0x3e, 0x1b, 0xa4, 0x76, 0xc7,
+	0x21, 0xa1, 0xfc, 0xf6, 0xc9, 0x9f, 0x6d, 0xea, 0x9e, 0x74, 0x87, 0xe2, 0x53, 0x5a, 0x5c, 0x9e,
+	0xda, 0xcb, 0xbc, 0xef, 0x9f, 0xff, 0x3f, 0x00, 0xf8, 0xca, 0x73, 0xed, 0x66, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -271,6 +532,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.PubKeyRotationCooldown != that1.PubKeyRotationCooldown {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -396,6 +660,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PubKeyRotationCooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PubKeyRotationCooldown):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -424,6 +696,171 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldPubKey != nil {
+		{
+			size, err := m.OldPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthenticatorState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthenticatorState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthenticatorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -478,40 +915,600 @@ func (m *ModuleAccount) Size() (n int) {
 			n += 1 + l + sovAuth(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemoCharacters != 0 {
+		n += 1 + sovAuth(uint64(m.MaxMemoCharacters))
+	}
+	if m.TxSigLimit != 0 {
+		n += 1 + sovAuth(uint64(m.TxSigLimit))
+	}
+	if m.TxSizeCostPerByte != 0 {
+		n += 1 + sovAuth(uint64(m.TxSizeCostPerByte))
+	}
+	if m.SigVerifyCostED25519 != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostED25519))
+	}
+	if m.SigVerifyCostSecp256k1 != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PubKeyRotationCooldown)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *PubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.OldPubKey != nil {
+		l = m.OldPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *AccountAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovAuth(uint64(m.Id))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthenticatorState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovAuth(uint64(m.AuthenticatorId))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func sovAuth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuth(x uint64) (n int) {
+	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			}
+			m.SigVerifyCostED25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			}
+			m.SigVerifyCostSecp256k1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PubKeyRotationCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxMemoCharacters != 0 {
-		n += 1 + sovAuth(uint64(m.MaxMemoCharacters))
-	}
-	if m.TxSigLimit != 0 {
-		n += 1 + sovAuth(uint64(m.TxSigLimit))
-	}
-	if m.TxSizeCostPerByte != 0 {
-		n += 1 + sovAuth(uint64(m.TxSizeCostPerByte))
-	}
-	if m.SigVerifyCostED25519 != 0 {
-		n += 1 + sovAuth(uint64(m.SigVerifyCostED25519))
-	}
-	if m.SigVerifyCostSecp256k1 != 0 {
-		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAuth(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuth(x uint64) (n int) {
-	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+func (m *PubKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -534,10 +1531,10 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PubKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -574,7 +1571,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -601,18 +1598,18 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubKey == nil {
-				m.PubKey = &types.Any{}
+			if m.OldPubKey == nil {
+				m.OldPubKey = &types.Any{}
 			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
 			}
-			m.AccountNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -622,16 +1619,33 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &types.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Sequence = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -641,11 +1655,44 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -667,7 +1714,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -690,17 +1737,17 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountAuthenticator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -710,31 +1757,46 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -762,13 +1824,13 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -778,23 +1840,25 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -817,7 +1881,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AuthenticatorState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,17 +1904,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthenticatorState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthenticatorState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -860,16 +1924,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
 			}
-			m.TxSigLimit = 0
+			m.AuthenticatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -879,16 +1956,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.TxSizeCostPerByte = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -898,16 +1975,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.SigVerifyCostED25519 = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -917,30 +2009,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.SigVerifyCostSecp256k1 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
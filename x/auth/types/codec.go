@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 )
 
@@ -15,6 +17,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*AccountI)(nil), nil)
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/BaseAccount", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
+	cdc.RegisterConcrete(&MsgRotatePubKey{}, "cosmos-sdk/MsgRotatePubKey", nil)
+	cdc.RegisterConcrete(&MsgAddAuthenticator{}, "cosmos-sdk/MsgAddAuthenticator", nil)
+	cdc.RegisterConcrete(&MsgRemoveAuthenticator{}, "cosmos-sdk/MsgRemoveAuthenticator", nil)
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -35,6 +40,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BaseAccount{},
 		&ModuleAccount{},
 	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRotatePubKey{},
+		&MsgAddAuthenticator{},
+		&MsgRemoveAuthenticator{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
@@ -0,0 +1,16 @@
+package types
+
+// auth module event types
+const (
+	EventTypeRotatePubKey        = "rotate_pub_key"
+	EventTypeAddAuthenticator    = "add_authenticator"
+	EventTypeRemoveAuthenticator = "remove_authenticator"
+
+	AttributeKeyAddress           = "address"
+	AttributeKeyOldPubKey         = "old_pub_key"
+	AttributeKeyNewPubKey         = "new_pub_key"
+	AttributeKeyAuthenticatorID   = "authenticator_id"
+	AttributeKeyAuthenticatorType = "authenticator_type"
+
+	AttributeValueCategory = ModuleName
+)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -38,6 +39,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, rotation := range g.PubKeyRotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -61,16 +67,120 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	_, err := NewGenesisAccountsValidator(data)
+	return err
+}
+
+// GenesisAccountsValidator validates genesis accounts one at a time, so that
+// the accounts streamed from a split genesis directory can be validated without
+// holding them all in memory.
+type GenesisAccountsValidator struct {
+	lastPubKeys map[string]cryptotypes.PubKey
+	seen        map[string]bool
+}
+
+// NewGenesisAccountsValidator validates the genesis state, and returns a
+// validator of the accounts streamed along with it, which are checked against
+// the accounts and the pubkey rotations of the genesis state.
+func NewGenesisAccountsValidator(data GenesisState) (*GenesisAccountsValidator, error) {
 	if err := data.Params.Validate(); err != nil {
-		return err
+		return nil, err
 	}
 
 	genAccs, err := UnpackAccounts(data.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidatePubKeyRotations(data.PubKeyRotations); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateAuthenticators(data.Authenticators, data.AuthenticatorStates); err != nil {
+		return nil, err
+	}
+
+	v := &GenesisAccountsValidator{
+		lastPubKeys: make(map[string]cryptotypes.PubKey, len(data.PubKeyRotations)),
+		seen:        make(map[string]bool, len(genAccs)),
+	}
+	for _, r := range data.PubKeyRotations {
+		v.lastPubKeys[r.Address] = r.GetNewPubKeyValue()
+	}
+
+	for _, acc := range genAccs {
+		if err := v.Validate(acc); err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+// Validate validates a genesis account, and checks that it is not a duplicate
+// of an account validated before.
+func (v *GenesisAccountsValidator) Validate(acc GenesisAccount) error {
+	addrStr := acc.GetAddress().String()
+	if v.seen[addrStr] {
+		return fmt.Errorf("duplicate account found in genesis state; address: %s", addrStr)
+	}
+	v.seen[addrStr] = true
+
+	acc, err := withoutRotatedPubKey(acc, v.lastPubKeys)
 	if err != nil {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	// check account specific validation
+	if err := acc.Validate(); err != nil {
+		return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
+	}
+
+	return nil
+}
+
+// ValidatePubKeyRotations validates the pubkey rotations of the genesis, which
+// must be in chronological order for each account.
+func ValidatePubKeyRotations(rotations []PubKeyRotation) error {
+	last := make(map[string]PubKeyRotation, len(rotations))
+	for _, r := range rotations {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if prev, ok := last[r.Address]; ok && (r.Height < prev.Height || r.Time.Before(prev.Time)) {
+			return fmt.Errorf("pubkey rotations of %s are not in chronological order", r.Address)
+		}
+		last[r.Address] = r
+	}
+
+	return nil
+}
+
+// withoutRotatedPubKey checks that the pubkey of a rotated account is the one
+// set by its last rotation, and returns a copy of the account without pubkey,
+// since the address of a rotated pubkey doesn't match the address of its
+// account. Other accounts are returned as is.
+func withoutRotatedPubKey(acc GenesisAccount, lastPubKeys map[string]cryptotypes.PubKey) (GenesisAccount, error) {
+	addrStr := acc.GetAddress().String()
+	pubKey, ok := lastPubKeys[addrStr]
+	if !ok {
+		return acc, nil
+	}
+
+	if acc.GetPubKey() == nil || !pubKey.Equals(acc.GetPubKey()) {
+		return nil, fmt.Errorf("pubkey of account %s is not the one set by its last rotation", addrStr)
+	}
+
+	cpy, ok := proto.Clone(acc).(GenesisAccount)
+	if !ok {
+		return nil, fmt.Errorf("cannot copy account %s", addrStr)
+	}
+	if err := cpy.SetPubKey(nil); err != nil {
+		return nil, fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
+	}
+
+	return cpy, nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -84,20 +194,10 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
-	addrMap := make(map[string]bool, len(accounts))
-
+	v := &GenesisAccountsValidator{seen: make(map[string]bool, len(accounts))}
 	for _, acc := range accounts {
-		// check for duplicated accounts
-		addrStr := acc.GetAddress().String()
-		if _, ok := addrMap[addrStr]; ok {
-			return fmt.Errorf("duplicate account found in genesis state; address: %s", addrStr)
-		}
-
-		addrMap[addrStr] = true
-
-		// check account specific validation
-		if err := acc.Validate(); err != nil {
-			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
+		if err := v.Validate(acc); err != nil {
+			return err
 		}
 	}
 	return nil
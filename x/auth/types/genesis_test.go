@@ -3,12 +3,14 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -50,6 +52,24 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestGenesisAccountsValidator(t *testing.T) {
+	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
+
+	genState := types.DefaultGenesisState()
+	genAccs, err := types.PackAccounts(types.GenesisAccounts{acc1})
+	require.NoError(t, err)
+	genState.Accounts = genAccs
+
+	v, err := types.NewGenesisAccountsValidator(*genState)
+	require.NoError(t, err)
+
+	// the streamed accounts must not duplicate the accounts of the genesis state
+	require.NoError(t, v.Validate(acc2))
+	require.Error(t, v.Validate(acc1))
+	require.Error(t, v.Validate(acc2))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
@@ -130,3 +150,82 @@ func TestPackAccountsAny(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGenesisPubKeyRotations(t *testing.T) {
+	addr := sdk.AccAddress(pk1.Address())
+	rotation, err := types.NewPubKeyRotation(addr, pk1, pk2, 1, time.Unix(0, 0))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		pubKey    cryptotypes.PubKey
+		rotations []types.PubKeyRotation
+		expErr    bool
+	}{
+		{"not rotated", pk1, nil, false},
+		{"not rotated, pubkey of another address", pk2, nil, true},
+		{"rotated", pk2, []types.PubKeyRotation{rotation}, false},
+		{"rotated, not the last pubkey", pk1, []types.PubKeyRotation{rotation}, true},
+		{"rotated, no pubkey", nil, []types.PubKeyRotation{rotation}, true},
+		{"rotations not in chronological order", pk2, []types.PubKeyRotation{rotation, {
+			Address: rotation.Address, OldPubKey: rotation.NewPubKey, NewPubKey: rotation.NewPubKey, Height: 0,
+		}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			acc := types.NewBaseAccount(addr, tc.pubKey, 0, 0)
+			accounts, err := types.PackAccounts(types.GenesisAccounts{acc})
+			require.NoError(t, err)
+
+			genState := types.DefaultGenesisState()
+			genState.Accounts = accounts
+			genState.PubKeyRotations = tc.rotations
+
+			err = types.ValidateGenesis(*genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				// the account itself is left unchanged
+				require.Equal(t, tc.pubKey, acc.GetPubKey())
+			}
+		})
+	}
+}
+
+func TestValidateGenesisAuthenticators(t *testing.T) {
+	addr1, addr2 := sdk.AccAddress(pk1.Address()), sdk.AccAddress(pk2.Address())
+	authenticator := types.NewAccountAuthenticator(addr1, 1, "SignatureKey", []byte("{}"))
+	state := types.AuthenticatorState{Address: addr1.String(), AuthenticatorId: 1, Key: []byte("key"), Value: []byte("value")}
+
+	testCases := []struct {
+		name           string
+		authenticators []types.AccountAuthenticator
+		states         []types.AuthenticatorState
+		expErr         bool
+	}{
+		{"valid", []types.AccountAuthenticator{authenticator, types.NewAccountAuthenticator(addr2, 2, "SignatureKey", nil)}, []types.AuthenticatorState{state}, false},
+		{"invalid address", []types.AccountAuthenticator{{Address: "invalid", Id: 1, Type: "SignatureKey"}}, nil, true},
+		{"empty type", []types.AccountAuthenticator{types.NewAccountAuthenticator(addr1, 1, "", nil)}, nil, true},
+		{"duplicate id", []types.AccountAuthenticator{authenticator, types.NewAccountAuthenticator(addr2, 1, "SignatureKey", nil)}, nil, true},
+		{"state of unknown authenticator", nil, []types.AuthenticatorState{state}, true},
+		{"state of authenticator of another account", []types.AccountAuthenticator{types.NewAccountAuthenticator(addr2, 1, "SignatureKey", nil)}, []types.AuthenticatorState{state}, true},
+		{"empty state key", []types.AccountAuthenticator{authenticator}, []types.AuthenticatorState{{Address: addr1.String(), AuthenticatorId: 1}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.Authenticators = tc.authenticators
+			genState.AuthenticatorStates = tc.states
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
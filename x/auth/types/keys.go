@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -16,12 +17,27 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
 )
 
 var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// PubKeyRotationKeyPrefix prefix for the pubkey rotations of the accounts
+	PubKeyRotationKeyPrefix = []byte{0x02}
+
+	// AuthenticatorKeyPrefix prefix for the authenticators of the accounts
+	AuthenticatorKeyPrefix = []byte{0x03}
+
+	// AuthenticatorStateKeyPrefix prefix for the state of the authenticators
+	AuthenticatorStateKeyPrefix = []byte{0x04}
+
+	// NextAuthenticatorIDKey key for the id of the next authenticator
+	NextAuthenticatorIDKey = []byte{0x05}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +46,33 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// PubKeyRotationsStoreKey returns the prefix of the keys of the pubkey
+// rotations of an account
+func PubKeyRotationsStoreKey(addr sdk.AccAddress) []byte {
+	return append(PubKeyRotationKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// PubKeyRotationStoreKey returns the key of the index-th pubkey rotation of an
+// account
+func PubKeyRotationStoreKey(addr sdk.AccAddress, index uint64) []byte {
+	return append(PubKeyRotationsStoreKey(addr), sdk.Uint64ToBigEndian(index)...)
+}
+
+// AuthenticatorsStoreKey returns the prefix of the keys of the authenticators
+// of an account
+func AuthenticatorsStoreKey(addr sdk.AccAddress) []byte {
+	return append(AuthenticatorKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// AuthenticatorStoreKey returns the key of an authenticator of an account
+func AuthenticatorStoreKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(AuthenticatorsStoreKey(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// AuthenticatorStateStoreKey returns the prefix of the keys of the state of an
+// authenticator of an account
+func AuthenticatorStateStoreKey(addr sdk.AccAddress, id uint64) []byte {
+	key := append(AuthenticatorStateKeyPrefix, address.MustLengthPrefix(addr)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
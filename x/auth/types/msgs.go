@@ -0,0 +1,198 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// auth message types
+const (
+	TypeMsgRotatePubKey        = "rotate_pub_key"
+	TypeMsgAddAuthenticator    = "add_authenticator"
+	TypeMsgRemoveAuthenticator = "remove_authenticator"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRotatePubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotatePubKey)(nil)
+	_ sdk.Msg                            = &MsgAddAuthenticator{}
+	_ sdk.Msg                            = &MsgRemoveAuthenticator{}
+)
+
+// NewMsgRotatePubKey returns a reference to a new MsgRotatePubKey. newPubKeySig
+// is the signature of the bytes returned by PubKeyRotationSignBytes by newPubKey.
+//
+//nolint:interfacer
+func NewMsgRotatePubKey(addr sdk.AccAddress, newPubKey cryptotypes.PubKey, newPubKeySig signing.SignatureData) (*MsgRotatePubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBz, err := signing.SignatureDataToProto(newPubKeySig).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{
+		Address:            addr.String(),
+		NewPubKey:          pkAny,
+		NewPubKeySignature: sigBz,
+	}, nil
+}
+
+// Route returns the message route for a MsgRotatePubKey.
+func (msg MsgRotatePubKey) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRotatePubKey.
+func (msg MsgRotatePubKey) Type() string { return TypeMsgRotatePubKey }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRotatePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "new pubkey cannot be empty")
+	}
+
+	if _, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %T, got %T", (cryptotypes.PubKey)(nil), msg.NewPubKey.GetCachedValue())
+	}
+
+	if len(msg.NewPubKeySignature) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "new pubkey signature cannot be empty")
+	}
+
+	if _, err := msg.GetNewPubKeySignatureData(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid new pubkey signature: %s", err)
+	}
+
+	return nil
+}
+
+// GetNewPubKeySignatureData returns the signature of the new pubkey.
+func (msg MsgRotatePubKey) GetNewPubKeySignatureData() (signing.SignatureData, error) {
+	var sig signing.SignatureDescriptor_Data
+	if err := sig.Unmarshal(msg.NewPubKeySignature); err != nil {
+		return nil, err
+	}
+	if sig.Sum == nil {
+		return nil, fmt.Errorf("empty signature data")
+	}
+
+	return signing.SignatureDataFromProto(&sig), nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgRotatePubKey.
+func (msg MsgRotatePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRotatePubKey.
+func (msg MsgRotatePubKey) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
+
+// NewMsgAddAuthenticator returns a reference to a new MsgAddAuthenticator.
+//
+//nolint:interfacer
+func NewMsgAddAuthenticator(addr sdk.AccAddress, authenticatorType string, config []byte) *MsgAddAuthenticator {
+	return &MsgAddAuthenticator{
+		Address:           addr.String(),
+		AuthenticatorType: authenticatorType,
+		Config:            config,
+	}
+}
+
+// Route returns the message route for a MsgAddAuthenticator.
+func (msg MsgAddAuthenticator) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAddAuthenticator.
+func (msg MsgAddAuthenticator) Type() string { return TypeMsgAddAuthenticator }
+
+// ValidateBasic Implements Msg.
+func (msg MsgAddAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	if strings.TrimSpace(msg.AuthenticatorType) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "authenticator type cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgAddAuthenticator.
+func (msg MsgAddAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgAddAuthenticator.
+func (msg MsgAddAuthenticator) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveAuthenticator returns a reference to a new MsgRemoveAuthenticator.
+//
+//nolint:interfacer
+func NewMsgRemoveAuthenticator(addr sdk.AccAddress, id uint64) *MsgRemoveAuthenticator {
+	return &MsgRemoveAuthenticator{
+		Address: addr.String(),
+		Id:      id,
+	}
+}
+
+// Route returns the message route for a MsgRemoveAuthenticator.
+func (msg MsgRemoveAuthenticator) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRemoveAuthenticator.
+func (msg MsgRemoveAuthenticator) Type() string { return TypeMsgRemoveAuthenticator }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRemoveAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgRemoveAuthenticator.
+func (msg MsgRemoveAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveAuthenticator.
+func (msg MsgRemoveAuthenticator) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
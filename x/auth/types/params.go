@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -15,6 +16,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	DefaultPubKeyRotationCooldown = time.Hour * 24
 )
 
 // Parameter keys
@@ -24,6 +27,7 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyPubKeyRotationCooldown = []byte("PubKeyRotationCooldown")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -31,6 +35,7 @@ var _ paramtypes.ParamSet = &Params{}
 // NewParams creates a new Params object
 func NewParams(
 	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	pubKeyRotationCooldown time.Duration,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -38,6 +43,7 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		PubKeyRotationCooldown: pubKeyRotationCooldown,
 	}
 }
 
@@ -55,6 +61,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyPubKeyRotationCooldown, &p.PubKeyRotationCooldown, validatePubKeyRotationCooldown),
 	}
 }
 
@@ -66,6 +73,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		PubKeyRotationCooldown: DefaultPubKeyRotationCooldown,
 	}
 }
 
@@ -81,6 +89,25 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBLS12381 returns gas fee of BLS12-381 signature verification.
+// Set by benchmarking current implementation:
+//
+//	BenchmarkVerification/secp256k1              200    419576 ns/op    4184 B/op   85 allocs/op
+//	BenchmarkVerification/bls12381               200   3235663 ns/op   84408 B/op  250 allocs/op
+//	BenchmarkAggregatedVerification/bls12381     200   4967678 ns/op  167856 B/op  764 allocs/op
+//
+// Based on the results above a BLS12-381 verification is 8x slower than a secp256k1 one,
+// and the aggregation of each public key of an aggregated signature costs 0.4x more.
+func (p Params) SigVerifyCostBLS12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 8
+}
+
+// SigVerifyCostBLS12381Aggregation returns gas fee of the aggregation of the public key
+// of a signer of an aggregated BLS12-381 signature.
+func (p Params) SigVerifyCostBLS12381Aggregation() uint64 {
+	return p.SigVerifyCostSecp256k1 * 2 / 5
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -152,6 +179,19 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validatePubKeyRotationCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("pub key rotation cooldown must not be negative: %s", v)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -169,6 +209,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validatePubKeyRotationCooldown(p.PubKeyRotationCooldown); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VerifyProofsOfPossession verifies the proofs of possession of the keys of the
+// aggregated multisigs of pubKey, which may be nested in other multisigs. It must
+// be called before setting the pubkey of an account.
+func VerifyProofsOfPossession(meter sdk.GasMeter, pubKey cryptotypes.PubKey, params Params) error {
+	switch pubKey := pubKey.(type) {
+	case *bls12381.MultisigPubKey:
+		meter.ConsumeGas(uint64(len(pubKey.PubKeys))*params.SigVerifyCostBLS12381(), "verify: bls12381 proofs of possession")
+		return pubKey.VerifyProofsOfPossession()
+
+	case multisig.PubKey:
+		for _, pk := range pubKey.GetPubKeys() {
+			if err := VerifyProofsOfPossession(meter, pk, params); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ codectypes.UnpackInterfacesMessage = PubKeyRotation{}
+
+// NewPubKeyRotation returns a new PubKeyRotation.
+//
+//nolint:interfacer
+func NewPubKeyRotation(addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, time time.Time) (PubKeyRotation, error) {
+	oldAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+	newAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+
+	return PubKeyRotation{
+		Address:   addr.String(),
+		OldPubKey: oldAny,
+		NewPubKey: newAny,
+		Height:    height,
+		Time:      time,
+	}, nil
+}
+
+// GetOldPubKeyValue returns the pubkey replaced by the rotation.
+func (r PubKeyRotation) GetOldPubKeyValue() cryptotypes.PubKey {
+	pk, _ := r.OldPubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// GetNewPubKeyValue returns the pubkey set by the rotation.
+func (r PubKeyRotation) GetNewPubKeyValue() cryptotypes.PubKey {
+	pk, _ := r.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// Validate performs a basic validation of the rotation.
+func (r PubKeyRotation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return err
+	}
+	if r.GetOldPubKeyValue() == nil {
+		return fmt.Errorf("missing old pubkey of rotation of %s", r.Address)
+	}
+	if r.GetNewPubKeyValue() == nil {
+		return fmt.Errorf("missing new pubkey of rotation of %s", r.Address)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("negative height of rotation of %s: %d", r.Address, r.Height)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r PubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldPubKey, &pubKey); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(r.NewPubKey, &pubKey)
+}
+
+// PubKeyRotationSignBytes returns the bytes signed by the new pubkey of a
+// MsgRotatePubKey to prove its possession. They are bound to the chain, the
+// account and its current pubkey, so that the signature cannot be used for
+// another rotation.
+//
+//nolint:interfacer
+func PubKeyRotationSignBytes(chainID string, addr sdk.AccAddress, oldPubKey cryptotypes.PubKey) []byte {
+	bz, err := json.Marshal(struct {
+		ChainID   string `json:"chain_id"`
+		Address   string `json:"address"`
+		OldPubKey []byte `json:"old_pub_key"`
+	}{chainID, addr.String(), oldPubKey.Bytes()})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// VerifyPubKeyRotationSignature verifies the signature of signBytes by pubKey,
+// which is a multisignature if pubKey is a multisig, consuming the gas of the
+// verification as the ante handler does for the signatures of a tx.
+func VerifyPubKeyRotationSignature(meter sdk.GasMeter, pubKey cryptotypes.PubKey, signBytes []byte, sig signing.SignatureData, params Params) error {
+	switch sig := sig.(type) {
+	case *signing.SingleSignatureData:
+		switch pubKey.(type) {
+		case *secp256r1.PubKey, *webauthn.PubKey:
+			meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "verify: new pubkey signature")
+		case *bls12381.PubKey:
+			meter.ConsumeGas(params.SigVerifyCostBLS12381(), "verify: new pubkey signature")
+		default:
+			meter.ConsumeGas(params.SigVerifyCostSecp256k1, "verify: new pubkey signature")
+		}
+
+		if !pubKey.VerifySignature(signBytes, sig.Signature) {
+			return fmt.Errorf("signature verification failed")
+		}
+		return nil
+
+	case *signing.MultiSignatureData:
+		multiPK, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
+		}
+		if sig.BitArray == nil || len(multiPK.GetPubKeys()) != sig.BitArray.Count() {
+			return fmt.Errorf("bit array size is incorrect")
+		}
+
+		if _, ok := multiPK.(*bls12381.MultisigPubKey); ok {
+			// a single signature aggregates the signatures of the signers
+			signers := uint64(sig.BitArray.NumTrueBitsBefore(sig.BitArray.Count()))
+			meter.ConsumeGas(signers*params.SigVerifyCostBLS12381Aggregation(), "verify: new pubkey signature aggregation")
+			meter.ConsumeGas(params.SigVerifyCostBLS12381(), "verify: new pubkey signature")
+		} else {
+			meter.ConsumeGas(uint64(len(sig.Signatures))*params.SigVerifyCostSecp256k1, "verify: new pubkey signature")
+		}
+
+		return multiPK.VerifyMultisignature(func(signing.SignMode) ([]byte, error) { return signBytes, nil }, sig)
+
+	default:
+		return fmt.Errorf("unexpected signature data type %T", sig)
+	}
+}
@@ -395,6 +395,228 @@ func (m *QueryModuleAccountByNameResponse) GetAccount() *types.Any {
 	return nil
 }
 
+// QueryPubKeyHistoryRequest is the request type for the Query/PubKeyHistory RPC method.
+type QueryPubKeyHistoryRequest struct {
+	// address defines the address of the account to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryRequest) Reset()         { *m = QueryPubKeyHistoryRequest{} }
+func (m *QueryPubKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryRequest) ProtoMessage()    {}
+func (*QueryPubKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{8}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyHistoryResponse is the response type for the Query/PubKeyHistory RPC method.
+type QueryPubKeyHistoryResponse struct {
+	// rotations are the rotations of the public key of the account.
+	Rotations []PubKeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryResponse) Reset()         { *m = QueryPubKeyHistoryResponse{} }
+func (m *QueryPubKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryResponse) ProtoMessage()    {}
+func (*QueryPubKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{9}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryResponse) GetRotations() []PubKeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
+func (m *QueryPubKeyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountAuthenticatorsRequest is the request type for the
+// Query/AccountAuthenticators RPC method.
+type QueryAccountAuthenticatorsRequest struct {
+	// address defines the address of the account to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountAuthenticatorsRequest) Reset()         { *m = QueryAccountAuthenticatorsRequest{} }
+func (m *QueryAccountAuthenticatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAuthenticatorsRequest) ProtoMessage()    {}
+func (*QueryAccountAuthenticatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{10}
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAuthenticatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAuthenticatorsRequest.Merge(m, src)
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAuthenticatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAuthenticatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountAuthenticatorsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountAuthenticatorsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAccountAuthenticatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountAuthenticatorsResponse is the response type for the
+// Query/AccountAuthenticators RPC method.
+type QueryAccountAuthenticatorsResponse struct {
+	// authenticators are the authenticators attached to the account.
+	Authenticators []AccountAuthenticator `protobuf:"bytes,1,rep,name=authenticators,proto3" json:"authenticators"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountAuthenticatorsResponse) Reset()         { *m = QueryAccountAuthenticatorsResponse{} }
+func (m *QueryAccountAuthenticatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAuthenticatorsResponse) ProtoMessage()    {}
+func (*QueryAccountAuthenticatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{11}
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAuthenticatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAuthenticatorsResponse.Merge(m, src)
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAuthenticatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAuthenticatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountAuthenticatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountAuthenticatorsResponse) GetAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+func (m *QueryAccountAuthenticatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -404,52 +626,66 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.auth.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryModuleAccountByNameRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameRequest")
 	proto.RegisterType((*QueryModuleAccountByNameResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameResponse")
+	proto.RegisterType((*QueryPubKeyHistoryRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryRequest")
+	proto.RegisterType((*QueryPubKeyHistoryResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryResponse")
+	proto.RegisterType((*QueryAccountAuthenticatorsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountAuthenticatorsRequest")
+	proto.RegisterType((*QueryAccountAuthenticatorsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAuthenticatorsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x77, 0xfb, 0xeb, 0x2f, 0x89, 0x53, 0xf1, 0x30, 0x89, 0x10, 0xb7, 0x76, 0x37, 0xac,
-	0x68, 0x92, 0x4a, 0x66, 0x69, 0x6a, 0x0f, 0x15, 0x11, 0x1a, 0x41, 0xf1, 0xa0, 0xc4, 0xe0, 0xc9,
-	0x83, 0x65, 0x92, 0x4c, 0xb7, 0xc1, 0xee, 0xce, 0x36, 0xb3, 0x2b, 0x06, 0x29, 0x88, 0xa7, 0xde,
-	0x14, 0x7c, 0x03, 0x79, 0x11, 0x05, 0xdf, 0x42, 0xe9, 0xa9, 0xe0, 0xc5, 0x93, 0x68, 0xe2, 0xc1,
-	0x97, 0x21, 0x99, 0x79, 0x36, 0x69, 0x64, 0x63, 0xe2, 0x69, 0xe7, 0xcf, 0xf3, 0x7d, 0xbe, 0x9f,
-	0xe7, 0x99, 0x67, 0x91, 0xd5, 0xe2, 0xc2, 0xe3, 0xc2, 0xa1, 0x51, 0xb8, 0xef, 0xbc, 0xde, 0x68,
-	0xb2, 0x90, 0x6e, 0x38, 0x87, 0x11, 0xeb, 0xf6, 0x48, 0xd0, 0xe5, 0x21, 0xc7, 0x59, 0x15, 0x40,
-	0x46, 0x01, 0x04, 0x02, 0x8c, 0x75, 0x50, 0x35, 0xa9, 0x60, 0x2a, 0x7a, 0xac, 0x0d, 0xa8, 0xdb,
-	0xf1, 0x69, 0xd8, 0xe1, 0xbe, 0x4a, 0x60, 0xe4, 0x5c, 0xee, 0x72, 0xb9, 0x74, 0x46, 0x2b, 0x38,
-	0xbd, 0xe6, 0x72, 0xee, 0x1e, 0x30, 0x47, 0xee, 0x9a, 0xd1, 0x9e, 0x43, 0x7d, 0x70, 0x34, 0xae,
-	0xc3, 0x15, 0x0d, 0x3a, 0x0e, 0xf5, 0x7d, 0x1e, 0xca, 0x6c, 0x02, 0x6e, 0xcd, 0x24, 0x60, 0x09,
-	0x07, 0x89, 0xd5, 0xfd, 0xae, 0x72, 0x04, 0x78, 0xb9, 0xb1, 0x5f, 0xa2, 0xdc, 0xb3, 0x11, 0xeb,
-	0x4e, 0xab, 0xc5, 0x23, 0x3f, 0x14, 0x0d, 0x76, 0x18, 0x31, 0x11, 0xe2, 0x87, 0x08, 0x4d, 0xa8,
-	0xf3, 0x7a, 0x41, 0x2f, 0xad, 0x54, 0x6f, 0x11, 0x90, 0x8e, 0x4a, 0x24, 0xaa, 0x21, 0xe0, 0x46,
-	0xea, 0xd4, 0x65, 0xa0, 0x6d, 0x5c, 0x50, 0xda, 0x7d, 0x1d, 0x5d, 0xfd, 0xc3, 0x40, 0x04, 0xdc,
-	0x17, 0x0c, 0xdf, 0x47, 0x19, 0x0a, 0x67, 0x79, 0xbd, 0xf0, 0x5f, 0x69, 0xa5, 0x9a, 0x23, 0xaa,
-	0x4a, 0x12, 0x37, 0x80, 0xec, 0xf8, 0xbd, 0xda, 0xe5, 0xb3, 0x93, 0x4a, 0x06, 0xd4, 0x8f, 0x1b,
-	0x63, 0x0d, 0x7e, 0x34, 0x45, 0xb8, 0x24, 0x09, 0x8b, 0x73, 0x09, 0x95, 0xf9, 0x14, 0xe2, 0x36,
-	0xca, 0x5e, 0x24, 0x8c, 0x3b, 0x90, 0x47, 0x69, 0xda, 0x6e, 0x77, 0x99, 0x10, 0xb2, 0xfc, 0x4b,
-	0x8d, 0x78, 0x7b, 0x37, 0x73, 0xdc, 0xb7, 0xb4, 0x5f, 0x7d, 0x4b, 0xb3, 0x9f, 0x4f, 0x77, 0x6f,
-	0x5c, 0xdb, 0x3d, 0x94, 0x06, 0x4e, 0x68, 0xdd, 0x22, 0xa5, 0xc5, 0x12, 0x3b, 0x87, 0xb0, 0xcc,
-	0x5a, 0xa7, 0x5d, 0xea, 0xc5, 0x2f, 0x62, 0xd7, 0x01, 0x33, 0x3e, 0x05, 0xab, 0x6d, 0x94, 0x0a,
-	0xe4, 0x09, 0x38, 0xad, 0x92, 0x84, 0xe1, 0x24, 0x4a, 0x54, 0x5b, 0x3e, 0xfd, 0x66, 0x69, 0x0d,
-	0x10, 0xd8, 0x5b, 0xc8, 0x92, 0x19, 0x9f, 0xf0, 0x76, 0x74, 0xc0, 0x80, 0xa3, 0xd6, 0x7b, 0x4a,
-	0xbd, 0xf8, 0x29, 0x31, 0x46, 0xcb, 0x3e, 0xf5, 0x18, 0x74, 0x40, 0xae, 0xed, 0x3d, 0x54, 0x98,
-	0x2d, 0x03, 0xaa, 0xda, 0x62, 0x0d, 0xc0, 0x67, 0x27, 0x95, 0x2b, 0x53, 0x79, 0x26, 0x6d, 0xa8,
-	0xfe, 0x58, 0x46, 0xff, 0x4b, 0x23, 0x7c, 0xac, 0xa3, 0xb8, 0x4d, 0x02, 0x97, 0x13, 0x0b, 0x4c,
-	0x1a, 0x62, 0x63, 0x7d, 0x91, 0x50, 0x45, 0x6c, 0xdf, 0x7c, 0xff, 0xe5, 0xe7, 0xa7, 0x25, 0x0b,
-	0xaf, 0x39, 0x89, 0x3f, 0x53, 0xec, 0xfe, 0x41, 0x47, 0x69, 0xd0, 0xe2, 0xd2, 0xdc, 0xf4, 0x31,
-	0x48, 0x79, 0x81, 0x48, 0xe0, 0x70, 0x24, 0x47, 0x19, 0x17, 0xff, 0xca, 0xe1, 0xbc, 0x85, 0x61,
-	0x3c, 0xc2, 0xef, 0x74, 0x94, 0x52, 0xcf, 0x8b, 0x8b, 0xb3, 0x6d, 0xa6, 0x66, 0xc9, 0x28, 0xcd,
-	0x0f, 0x04, 0x9c, 0x1b, 0x12, 0x67, 0x0d, 0xaf, 0x26, 0xe2, 0xa8, 0x41, 0xc2, 0x9f, 0x75, 0x94,
-	0x4d, 0x98, 0x06, 0x7c, 0x67, 0xb6, 0xcd, 0xec, 0x99, 0x33, 0xb6, 0xfe, 0x51, 0x05, 0xa4, 0x9b,
-	0x92, 0xb4, 0x82, 0x6f, 0x27, 0x92, 0x7a, 0x52, 0xb9, 0x3b, 0xe9, 0xdf, 0x68, 0x94, 0x8f, 0x6a,
-	0x0f, 0x4e, 0x07, 0xa6, 0x7e, 0x3e, 0x30, 0xf5, 0xef, 0x03, 0x53, 0xff, 0x38, 0x34, 0xb5, 0xf3,
-	0xa1, 0xa9, 0x7d, 0x1d, 0x9a, 0xda, 0x8b, 0xb2, 0xdb, 0x09, 0xf7, 0xa3, 0x26, 0x69, 0x71, 0x2f,
-	0x4e, 0xa8, 0x3e, 0x15, 0xd1, 0x7e, 0xe5, 0xbc, 0x51, 0xd9, 0xc3, 0x5e, 0xc0, 0x44, 0x33, 0x25,
-	0x67, 0x7a, 0xf3, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xde, 0x85, 0xd2, 0xba, 0x38, 0x06, 0x00,
-	0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xd1, 0x4a, 0x1b, 0x4d,
+	0x14, 0xce, 0xf8, 0xfb, 0x1b, 0x1d, 0xff, 0xdf, 0x8b, 0x49, 0x04, 0x5d, 0x6b, 0x62, 0x57, 0x5a,
+	0x13, 0x4b, 0x76, 0x51, 0xab, 0xd6, 0x52, 0x2c, 0xa6, 0xa0, 0x2d, 0xa5, 0xc5, 0x86, 0x42, 0xa1,
+	0x17, 0x0d, 0x93, 0x64, 0x4c, 0x82, 0x66, 0x27, 0x66, 0x76, 0x4b, 0x43, 0x11, 0x4a, 0xa1, 0xe0,
+	0x5d, 0x0b, 0x7d, 0x01, 0x9f, 0xa0, 0x50, 0x10, 0xfa, 0x06, 0x45, 0xbc, 0x12, 0x7a, 0xd3, 0xab,
+	0x22, 0xda, 0x8b, 0x3e, 0x46, 0xc9, 0xcc, 0x59, 0xcd, 0xca, 0xc4, 0xc4, 0x22, 0xbd, 0xca, 0xce,
+	0xcc, 0xf9, 0xce, 0xf7, 0x9d, 0x6f, 0xce, 0x9c, 0xe0, 0x78, 0x9e, 0x8b, 0x0a, 0x17, 0x36, 0xf5,
+	0xdc, 0x92, 0xfd, 0x72, 0x2a, 0xc7, 0x5c, 0x3a, 0x65, 0x6f, 0x7a, 0xac, 0x56, 0xb7, 0xaa, 0x35,
+	0xee, 0x72, 0x12, 0x51, 0x01, 0x56, 0x23, 0xc0, 0x82, 0x00, 0x63, 0x12, 0x50, 0x39, 0x2a, 0x98,
+	0x8a, 0x3e, 0xc1, 0x56, 0x69, 0xb1, 0xec, 0x50, 0xb7, 0xcc, 0x1d, 0x95, 0xc0, 0x88, 0x16, 0x79,
+	0x91, 0xcb, 0x4f, 0xbb, 0xf1, 0x05, 0xbb, 0xc3, 0x45, 0xce, 0x8b, 0x1b, 0xcc, 0x96, 0xab, 0x9c,
+	0xb7, 0x66, 0x53, 0x07, 0x18, 0x8d, 0x2b, 0x70, 0x44, 0xab, 0x65, 0x9b, 0x3a, 0x0e, 0x77, 0x65,
+	0x36, 0x01, 0xa7, 0x31, 0x9d, 0xe0, 0xc6, 0xc2, 0x4f, 0xac, 0xce, 0xb3, 0x8a, 0x51, 0x2d, 0xd4,
+	0x91, 0xf9, 0x02, 0x47, 0x9f, 0x34, 0xb4, 0x2e, 0xe5, 0xf3, 0xdc, 0x73, 0x5c, 0x91, 0x61, 0x9b,
+	0x1e, 0x13, 0x2e, 0x59, 0xc6, 0xf8, 0x54, 0xf5, 0x10, 0x1a, 0x43, 0x89, 0xfe, 0xe9, 0xeb, 0x16,
+	0x40, 0x1b, 0x25, 0x5a, 0xca, 0x10, 0x60, 0xb3, 0x56, 0x69, 0x91, 0x01, 0x36, 0xd3, 0x84, 0x34,
+	0x77, 0x10, 0x1e, 0x3c, 0x43, 0x20, 0xaa, 0xdc, 0x11, 0x8c, 0x2c, 0xe2, 0x5e, 0x0a, 0x7b, 0x43,
+	0x68, 0xec, 0x9f, 0x44, 0xff, 0x74, 0xd4, 0x52, 0x55, 0x5a, 0xbe, 0x01, 0xd6, 0x92, 0x53, 0x4f,
+	0xff, 0xb7, 0xbf, 0x9b, 0xea, 0x05, 0xf4, 0x83, 0xcc, 0x09, 0x86, 0xac, 0x04, 0x14, 0x76, 0x49,
+	0x85, 0x13, 0x6d, 0x15, 0x2a, 0xf2, 0x80, 0xc4, 0x05, 0x1c, 0x69, 0x56, 0xe8, 0x3b, 0x30, 0x84,
+	0xc3, 0xb4, 0x50, 0xa8, 0x31, 0x21, 0x64, 0xf9, 0x7d, 0x19, 0x7f, 0x79, 0xbb, 0x77, 0x7b, 0x27,
+	0x1e, 0xfa, 0xb5, 0x13, 0x0f, 0x99, 0x4f, 0x83, 0xee, 0x9d, 0xd4, 0x76, 0x07, 0x87, 0x41, 0x27,
+	0x58, 0xd7, 0x49, 0x69, 0x3e, 0xc4, 0x8c, 0x62, 0x22, 0xb3, 0xae, 0xd2, 0x1a, 0xad, 0xf8, 0x37,
+	0x62, 0xae, 0xe2, 0x48, 0x60, 0x17, 0xa8, 0x16, 0x70, 0x4f, 0x55, 0xee, 0x00, 0xd3, 0x88, 0xa5,
+	0x69, 0x4e, 0x4b, 0x81, 0xd2, 0xdd, 0x7b, 0x3f, 0xe2, 0xa1, 0x0c, 0x00, 0xcc, 0x59, 0x1c, 0x97,
+	0x19, 0x1f, 0xf1, 0x82, 0xb7, 0xc1, 0x40, 0x47, 0xba, 0xfe, 0x98, 0x56, 0xfc, 0xab, 0x24, 0x04,
+	0x77, 0x3b, 0xb4, 0xc2, 0xc0, 0x01, 0xf9, 0x6d, 0xae, 0xe1, 0xb1, 0xd6, 0x30, 0x50, 0x95, 0xee,
+	0xcc, 0x00, 0xb2, 0xbf, 0x9b, 0x1a, 0x08, 0xe4, 0x69, 0xb2, 0x61, 0x0b, 0x0f, 0xab, 0x82, 0xbd,
+	0xdc, 0x43, 0x56, 0xbf, 0x5f, 0x16, 0x2e, 0xaf, 0xd5, 0xdb, 0xde, 0x0e, 0x59, 0xd6, 0xf4, 0xc5,
+	0x9f, 0x74, 0xee, 0x27, 0x84, 0x0d, 0x1d, 0x3f, 0x54, 0xb8, 0x82, 0xfb, 0x6a, 0xfe, 0x33, 0x84,
+	0xfe, 0x1d, 0xd7, 0x5b, 0x2f, 0xe1, 0x19, 0x88, 0x85, 0x2b, 0x38, 0xc5, 0x5e, 0x5e, 0x1f, 0xbf,
+	0x43, 0xf8, 0x6a, 0x73, 0x37, 0x2e, 0x79, 0x6e, 0x89, 0x39, 0x6e, 0x39, 0x4f, 0x5d, 0x5e, 0x13,
+	0x7f, 0xcf, 0xb8, 0xaf, 0x08, 0x9b, 0xe7, 0xe9, 0x00, 0x03, 0x9f, 0xe1, 0x01, 0x1a, 0x38, 0x01,
+	0x17, 0x93, 0x5a, 0x17, 0x75, 0xb9, 0xc0, 0xcb, 0x33, 0x69, 0x2e, 0xcd, 0xd0, 0xe9, 0xc3, 0x30,
+	0xfe, 0x57, 0x16, 0x42, 0xb6, 0x11, 0xf6, 0xdf, 0xa9, 0x20, 0x7a, 0x81, 0xba, 0x29, 0x6a, 0x4c,
+	0x76, 0x12, 0xaa, 0x98, 0xcd, 0x6b, 0x6f, 0xbf, 0xfd, 0xfc, 0xd8, 0x15, 0x27, 0xa3, 0xb6, 0x76,
+	0x9a, 0xfb, 0xec, 0xef, 0x11, 0x0e, 0x03, 0x96, 0x24, 0xda, 0xa6, 0xf7, 0x85, 0x24, 0x3b, 0x88,
+	0x04, 0x1d, 0xb6, 0xd4, 0x91, 0x24, 0x13, 0xe7, 0xea, 0xb0, 0x5f, 0x43, 0xdb, 0x6c, 0x91, 0x37,
+	0x08, 0xf7, 0xa8, 0xf9, 0x42, 0x26, 0x5a, 0xd3, 0x04, 0x86, 0x99, 0x91, 0x68, 0x1f, 0x08, 0x72,
+	0xc6, 0xa5, 0x9c, 0x51, 0x32, 0xa2, 0x95, 0xa3, 0x26, 0x19, 0xf9, 0x82, 0x70, 0x44, 0x33, 0x8e,
+	0xc8, 0xcd, 0xd6, 0x34, 0xad, 0x87, 0x9e, 0x31, 0x7b, 0x41, 0x14, 0x28, 0x9d, 0x91, 0x4a, 0x53,
+	0xe4, 0x86, 0x56, 0x69, 0x45, 0x22, 0xb3, 0xa7, 0xfe, 0x35, 0x66, 0xe9, 0x16, 0xf9, 0x8c, 0xf0,
+	0xff, 0x81, 0x01, 0x43, 0xac, 0x73, 0xac, 0xd1, 0x4c, 0x42, 0xc3, 0xee, 0x38, 0x1e, 0x74, 0xde,
+	0x95, 0x3a, 0x17, 0xc8, 0x7c, 0x87, 0x17, 0x6c, 0x57, 0xbd, 0x5c, 0x76, 0x9d, 0xd5, 0xb3, 0x25,
+	0x50, 0xb8, 0x87, 0xf0, 0xa0, 0xf6, 0x6d, 0x93, 0xb9, 0xb6, 0x6d, 0xa6, 0x1d, 0x4a, 0xc6, 0xfc,
+	0x85, 0x71, 0x50, 0xcb, 0xa2, 0xac, 0xe5, 0x16, 0x99, 0xeb, 0xb4, 0x96, 0xe0, 0xac, 0x48, 0xdf,
+	0xdb, 0x3b, 0x8a, 0xa1, 0x83, 0xa3, 0x18, 0x3a, 0x3c, 0x8a, 0xa1, 0x0f, 0xc7, 0xb1, 0xd0, 0xc1,
+	0x71, 0x2c, 0xf4, 0xfd, 0x38, 0x16, 0x7a, 0x9e, 0x2c, 0x96, 0xdd, 0x92, 0x97, 0xb3, 0xf2, 0xbc,
+	0xe2, 0xe7, 0x56, 0x3f, 0x29, 0x51, 0x58, 0xb7, 0x5f, 0x29, 0x22, 0xb7, 0x5e, 0x65, 0x22, 0xd7,
+	0x23, 0xff, 0xd3, 0x66, 0x7e, 0x0f, 0x00, 0x72, 0xd8, 0x67, 0xb2, 0x38, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +710,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error)
+	// PubKeyHistory returns the rotations of the public key of an account, from
+	// the oldest to the latest.
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
+	// AccountAuthenticators returns the authenticators attached to an account.
+	AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error)
 }
 
 type queryClient struct {
@@ -520,6 +761,24 @@ func (c *queryClient) ModuleAccountByName(ctx context.Context, in *QueryModuleAc
 	return out, nil
 }
 
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error) {
+	out := new(QueryAccountAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountAuthenticators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts
@@ -532,6 +791,11 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
 	ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error)
+	// PubKeyHistory returns the rotations of the public key of an account, from
+	// the oldest to the latest.
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
+	// AccountAuthenticators returns the authenticators attached to an account.
+	AccountAuthenticators(context.Context, *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -550,6 +814,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) ModuleAccountByName(ctx context.Context, req *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountByName not implemented")
 }
+func (*UnimplementedQueryServer) PubKeyHistory(ctx context.Context, req *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
+func (*UnimplementedQueryServer) AccountAuthenticators(ctx context.Context, req *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAuthenticators not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -627,6 +897,42 @@ func _Query_ModuleAccountByName_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountAuthenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAuthenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountAuthenticators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAuthenticators(ctx, req.(*QueryAccountAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -647,6 +953,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleAccountByName",
 			Handler:    _Query_ModuleAccountByName_Handler,
 		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
+		{
+			MethodName: "AccountAuthenticators",
+			Handler:    _Query_AccountAuthenticators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -922,60 +1236,242 @@ func (m *QueryModuleAccountByNameResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPubKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryPubKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPubKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPubKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAuthenticatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAuthenticatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAuthenticatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAuthenticatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAuthenticatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAuthenticatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryAccountResponse) Size() (n int) {
@@ -1037,6 +1533,78 @@ func (m *QueryModuleAccountByNameResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPubKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAuthenticatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAuthenticatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1718,6 +2286,482 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPubKeyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, PubKeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountAuthenticatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountAuthenticatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
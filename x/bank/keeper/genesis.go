@@ -4,29 +4,59 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+// GenesisBalancesCollection is the name of the collection holding the account
+// balances in a split genesis directory.
+const GenesisBalancesCollection = "balances"
+
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := k.initGenesis(ctx, genState, nil); err != nil {
+		panic(err)
+	}
+}
+
+// InitGenesisStream initializes the bank module's state from a given genesis
+// state, and the account balances streamed from stream.
+func (k BaseKeeper) InitGenesisStream(ctx sdk.Context, genState *types.GenesisState, stream *module.GenesisStream) error {
+	return k.initGenesis(ctx, genState, stream)
+}
+
+func (k BaseKeeper) initGenesis(ctx sdk.Context, genState *types.GenesisState, stream *module.GenesisStream) error {
 	k.SetParams(ctx, genState.Params)
 
 	totalSupply := sdk.Coins{}
+	initBalance := func(balance types.Balance) error {
+		if err := k.initBalances(ctx, balance.GetAddress(), balance.Coins); err != nil {
+			return fmt.Errorf("error on setting balances %w", err)
+		}
+
+		totalSupply = totalSupply.Add(balance.Coins...)
+		return nil
+	}
 
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
 	for _, balance := range genState.Balances {
-		addr := balance.GetAddress()
-
-		if err := k.initBalances(ctx, addr, balance.Coins); err != nil {
-			panic(fmt.Errorf("error on setting balances %w", err))
+		if err := initBalance(balance); err != nil {
+			return err
 		}
+	}
 
-		totalSupply = totalSupply.Add(balance.Coins...)
+	if stream != nil {
+		var balance types.Balance
+		if err := stream.Read(GenesisBalancesCollection, &balance, func() error {
+			return initBalance(balance)
+		}); err != nil {
+			return err
+		}
 	}
 
 	if !genState.Supply.Empty() && !genState.Supply.IsEqual(totalSupply) {
-		panic(fmt.Errorf("genesis supply is incorrect, expected %v, got %v", genState.Supply, totalSupply))
+		return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", genState.Supply, totalSupply)
 	}
 
 	for _, supply := range totalSupply {
@@ -36,19 +66,70 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	return nil
 }
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetAccountsBalances(ctx),
+		k.exportTotalSupply(ctx),
+		k.GetAllDenomMetaData(ctx),
+	)
+}
+
+// ExportGenesisStream writes the account balances to stream, one account at a
+// time, and returns the rest of the bank module's genesis state.
+func (k BaseKeeper) ExportGenesisStream(ctx sdk.Context, stream *module.GenesisStream) (*types.GenesisState, error) {
+	var (
+		balance types.Balance
+		addr    sdk.AccAddress
+		err     error
+	)
+
+	// balances are stored by address, so all the coins of an account are
+	// iterated over consecutively.
+	k.IterateAllBalances(ctx, func(a sdk.AccAddress, coin sdk.Coin) bool {
+		if addr.Equals(a) {
+			balance.Coins = balance.Coins.Add(coin)
+			return false
+		}
+
+		if addr != nil {
+			if err = stream.Write(GenesisBalancesCollection, &balance); err != nil {
+				return true
+			}
+		}
+
+		addr = a
+		balance = types.Balance{Address: a.String(), Coins: sdk.NewCoins(coin)}
+		return false
+	})
 	if err != nil {
-		panic(fmt.Errorf("unable to fetch total supply %v", err))
+		return nil, err
+	}
+
+	if addr != nil {
+		if err := stream.Write(GenesisBalancesCollection, &balance); err != nil {
+			return nil, err
+		}
 	}
 
 	return types.NewGenesisState(
 		k.GetParams(ctx),
-		k.GetAccountsBalances(ctx),
-		totalSupply,
+		[]types.Balance{},
+		k.exportTotalSupply(ctx),
 		k.GetAllDenomMetaData(ctx),
-	)
+	), nil
+}
+
+func (k BaseKeeper) exportTotalSupply(ctx sdk.Context) sdk.Coins {
+	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch total supply %v", err))
+	}
+
+	return totalSupply
 }
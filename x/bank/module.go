@@ -31,6 +31,8 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+
+	_ module.AppModuleGenesisStream = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -66,6 +68,22 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 	return data.Validate()
 }
 
+// ValidateGenesisStream performs genesis state validation for the bank module,
+// along with the account balances streamed from a split genesis directory.
+func (AppModuleBasic) ValidateGenesisStream(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage, stream *module.GenesisStream) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return data.ValidateStream(func(cb func(types.Balance) error) error {
+		var balance types.Balance
+		return stream.Read(keeper.GenesisBalancesCollection, &balance, func() error {
+			return cb(balance)
+		})
+	})
+}
+
 // RegisterRESTRoutes registers the REST routes for the bank module.
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
 	rest.RegisterHandlers(clientCtx, rtr)
@@ -160,6 +178,29 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// InitGenesisStream performs genesis initialization for the bank module from a
+// split genesis directory, where account balances are streamed. It returns no
+// validator updates.
+func (am AppModule) InitGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage, stream *module.GenesisStream) ([]abci.ValidatorUpdate, error) {
+	var genesisState types.GenesisState
+	if err := cdc.UnmarshalJSON(data, &genesisState); err != nil {
+		return nil, err
+	}
+
+	return []abci.ValidatorUpdate{}, am.keeper.InitGenesisStream(ctx, &genesisState, stream)
+}
+
+// ExportGenesisStream streams the account balances to stream and returns the
+// rest of the bank module's exported genesis state as raw bytes.
+func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, stream *module.GenesisStream) (json.RawMessage, error) {
+	gs, err := am.keeper.ExportGenesisStream(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSON(gs)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
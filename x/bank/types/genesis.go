@@ -11,6 +11,13 @@ import (
 // Validate performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func (gs GenesisState) Validate() error {
+	return gs.ValidateStream(nil)
+}
+
+// ValidateStream validates the genesis state as Validate does, along with the
+// balances streamed from a split genesis directory, which readBalances passes
+// to its callback one at a time. readBalances may be nil.
+func (gs GenesisState) ValidateStream(readBalances func(cb func(Balance) error) error) error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
@@ -20,7 +27,7 @@ func (gs GenesisState) Validate() error {
 
 	totalSupply := sdk.Coins{}
 
-	for _, balance := range gs.Balances {
+	validateBalance := func(balance Balance) error {
 		if seenBalances[balance.Address] {
 			return fmt.Errorf("duplicate balance for address %s", balance.Address)
 		}
@@ -32,6 +39,19 @@ func (gs GenesisState) Validate() error {
 		seenBalances[balance.Address] = true
 
 		totalSupply = totalSupply.Add(balance.Coins...)
+		return nil
+	}
+
+	for _, balance := range gs.Balances {
+		if err := validateBalance(balance); err != nil {
+			return err
+		}
+	}
+
+	if readBalances != nil {
+		if err := readBalances(validateBalance); err != nil {
+			return err
+		}
 	}
 
 	for _, metadata := range gs.DenomMetadata {
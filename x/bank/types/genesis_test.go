@@ -155,3 +155,35 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisStateValidateStream(t *testing.T) {
+	balance := Balance{
+		Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+		Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+	}
+	other := Balance{
+		Address: "cosmos15v50ymp6n5dn73erkqtmq0u8adpl8d3ujv2e74",
+		Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 2)},
+	}
+	stream := func(balances ...Balance) func(func(Balance) error) error {
+		return func(cb func(Balance) error) error {
+			for _, b := range balances {
+				if err := cb(b); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	gs := GenesisState{
+		Params:   DefaultParams(),
+		Balances: []Balance{balance},
+		Supply:   sdk.Coins{sdk.NewInt64Coin("uatom", 3)},
+	}
+	require.NoError(t, gs.ValidateStream(stream(other)))
+	// the streamed balances count towards the supply
+	require.Error(t, gs.ValidateStream(stream()))
+	// and must not duplicate the other balances
+	require.Error(t, gs.ValidateStream(stream(balance)))
+}
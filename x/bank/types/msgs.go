@@ -191,3 +191,27 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+// SpentCoins returns the coins spent by spender in msg if it is a MsgSend or a
+// MsgMultiSend, or false otherwise.
+func SpentCoins(msg sdk.Msg, spender sdk.AccAddress) (sdk.Coins, bool) {
+	switch msg := msg.(type) {
+	case *MsgSend:
+		if msg.FromAddress != spender.String() {
+			return nil, true
+		}
+		return msg.Amount, true
+
+	case *MsgMultiSend:
+		var spent sdk.Coins
+		for _, in := range msg.Inputs {
+			if in.Address == spender.String() {
+				spent = spent.Add(in.Coins...)
+			}
+		}
+		return spent, true
+
+	default:
+		return nil, false
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -276,3 +277,28 @@ func TestMsgSendSigners(t *testing.T) {
 
 	require.Equal(t, signers, tx.GetSigners())
 }
+
+func TestSpentCoins(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input111111111111111"))
+	addr2 := sdk.AccAddress([]byte("input222222222222222"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	spent, ok := SpentCoins(NewMsgSend(addr1, addr2, coins), addr1)
+	require.True(t, ok)
+	require.Equal(t, coins, spent)
+
+	spent, ok = SpentCoins(NewMsgSend(addr1, addr2, coins), addr2)
+	require.True(t, ok)
+	require.True(t, spent.IsZero())
+
+	msg := NewMsgMultiSend(
+		[]Input{NewInput(addr1, coins), NewInput(addr2, coins), NewInput(addr1, coins)},
+		[]Output{NewOutput(addr2, coins.Add(coins...).Add(coins...))},
+	)
+	spent, ok = SpentCoins(msg, addr1)
+	require.True(t, ok)
+	require.Equal(t, coins.Add(coins...), spent)
+
+	_, ok = SpentCoins(testdata.NewTestMsg(addr1), addr1)
+	require.False(t, ok)
+}
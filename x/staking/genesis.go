@@ -9,6 +9,7 @@ import (
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -63,18 +64,7 @@ func InitGenesis(
 	}
 
 	for _, delegation := range data.Delegations {
-		delegatorAddress := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
-
-		// Call the before-creation hook if not exported
-		if !data.Exported {
-			keeper.BeforeDelegationCreated(ctx, delegatorAddress, delegation.GetValidatorAddr())
-		}
-
-		keeper.SetDelegation(ctx, delegation)
-		// Call the after-modification hook if not exported
-		if !data.Exported {
-			keeper.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
-		}
+		initDelegation(ctx, keeper, delegation, data.Exported)
 	}
 
 	for _, ubd := range data.UnbondingDelegations {
@@ -153,10 +143,65 @@ func InitGenesis(
 	return res
 }
 
+// GenesisDelegationsCollection is the name of the collection holding the
+// delegations in a split genesis directory.
+const GenesisDelegationsCollection = "delegations"
+
+// InitGenesisStream initializes the staking state as InitGenesis does, and
+// additionally sets the delegations streamed from stream.
+func InitGenesisStream(
+	ctx sdk.Context, keeper keeper.Keeper, accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper, data *types.GenesisState, stream *module.GenesisStream,
+) ([]abci.ValidatorUpdate, error) {
+	res := InitGenesis(ctx, keeper, accountKeeper, bankKeeper, data)
+
+	// delegations do not take part in the validator set and pool balance
+	// checks of InitGenesis, so they can be set afterwards.
+	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
+
+	var delegation types.Delegation
+	err := stream.Read(GenesisDelegationsCollection, &delegation, func() error {
+		if _, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress); err != nil {
+			return err
+		}
+
+		initDelegation(ctx, keeper, delegation, data.Exported)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func initDelegation(ctx sdk.Context, keeper keeper.Keeper, delegation types.Delegation, exported bool) {
+	delegatorAddress := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+
+	// Call the before-creation hook if not exported
+	if !exported {
+		keeper.BeforeDelegationCreated(ctx, delegatorAddress, delegation.GetValidatorAddr())
+	}
+
+	keeper.SetDelegation(ctx, delegation)
+	// Call the after-modification hook if not exported
+	if !exported {
+		keeper.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
+	}
+}
+
 // ExportGenesis returns a GenesisState for a given context and keeper. The
 // GenesisState will contain the pool, params, validators, and bonds found in
 // the keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	gs := exportGenesis(ctx, keeper)
+	gs.Delegations = keeper.GetAllDelegations(ctx)
+
+	return gs
+}
+
+// exportGenesis returns the staking GenesisState without the delegations.
+func exportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	var unbondingDelegations []types.UnbondingDelegation
 
 	keeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) (stop bool) {
@@ -183,13 +228,30 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		LastTotalPower:       keeper.GetLastTotalPower(ctx),
 		LastValidatorPowers:  lastValidatorPowers,
 		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
 	}
 }
 
+// ExportGenesisStream writes the delegations to stream, one at a time, and
+// returns the rest of the staking GenesisState.
+func ExportGenesisStream(ctx sdk.Context, keeper keeper.Keeper, stream *module.GenesisStream) (*types.GenesisState, error) {
+	var err error
+	keeper.IterateAllDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		err = stream.Write(GenesisDelegationsCollection, &delegation)
+		return err != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs := exportGenesis(ctx, keeper)
+	gs.Delegations = []types.Delegation{}
+
+	return gs, nil
+}
+
 // WriteValidators returns a slice of bonded genesis validators.
 func WriteValidators(ctx sdk.Context, keeper keeper.Keeper) (vals []tmtypes.GenesisValidator, err error) {
 	keeper.IterateLastValidators(ctx, func(_ int64, validator types.ValidatorI) (stop bool) {
@@ -225,6 +287,29 @@ func ValidateGenesis(data *types.GenesisState) error {
 	return data.Params.Validate()
 }
 
+// ValidateGenesisStream validates the staking genesis state as ValidateGenesis
+// does, along with the delegations streamed from stream.
+func ValidateGenesisStream(data *types.GenesisState, stream *module.GenesisStream) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+
+	var delegation types.Delegation
+	return stream.Read(GenesisDelegationsCollection, &delegation, func() error {
+		if _, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress); err != nil {
+			return err
+		}
+		if delegation.Shares.IsNil() || !delegation.Shares.IsPositive() {
+			return fmt.Errorf("delegation of %s to %s has non positive shares", delegation.DelegatorAddress, delegation.ValidatorAddress)
+		}
+
+		return nil
+	})
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -29,6 +29,8 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+
+	_ module.AppModuleGenesisStream = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the staking module.
@@ -69,6 +71,17 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	return ValidateGenesis(&data)
 }
 
+// ValidateGenesisStream performs genesis state validation for the staking
+// module, along with the delegations streamed from a split genesis directory.
+func (AppModuleBasic) ValidateGenesisStream(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage, stream *module.GenesisStream) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return ValidateGenesisStream(&data, stream)
+}
+
 // RegisterRESTRoutes registers the REST routes for the staking module.
 func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
 	rest.RegisterHandlers(clientCtx, rtr)
@@ -160,6 +173,29 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// InitGenesisStream performs genesis initialization for the staking module
+// from a split genesis directory, where delegations are streamed. It returns
+// the validator updates of InitGenesis.
+func (am AppModule) InitGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage, stream *module.GenesisStream) ([]abci.ValidatorUpdate, error) {
+	var genesisState types.GenesisState
+	if err := cdc.UnmarshalJSON(data, &genesisState); err != nil {
+		return nil, err
+	}
+
+	return InitGenesisStream(ctx, am.keeper, am.accountKeeper, am.bankKeeper, &genesisState, stream)
+}
+
+// ExportGenesisStream streams the delegations to stream and returns the rest
+// of the staking module's exported genesis state as raw bytes.
+func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, stream *module.GenesisStream) (json.RawMessage, error) {
+	gs, err := ExportGenesisStream(ctx, am.keeper, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSON(gs)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 